@@ -3,7 +3,7 @@ package cli
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,6 +22,10 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdPermissions(),
+		GetCmdQuotas(),
+		GetCmdChannelStats(),
+		GetCmdAllowedQueries(),
 	)
 
 	return queryCmd
@@ -54,6 +58,123 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdPermissions returns the command handler for query permissions querying.
+func GetCmdPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "permissions",
+		Short:   "Query the query permissions of the host channels and counterparty clients",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s permissions", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Permissions(cmd.Context(), &types.QueryPermissionsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuotas returns the command handler for query quotas querying.
+func GetCmdQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quotas",
+		Short:   "Query the query quotas of the host channels",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s quotas", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Quotas(cmd.Context(), &types.QueryQuotasRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChannelStats returns the command handler for querying the accounting of the queries of a host channel, or of
+// all host channels if no channel is given.
+func GetCmdChannelStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stats [channel-id]",
+		Short:   "Query the accounting of the queries received on the host channels in the current and previous epochs",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query %s stats channel-0", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.AllChannelStats(cmd.Context(), &types.QueryAllChannelStatsRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.ChannelStats(cmd.Context(), &types.QueryChannelStatsRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllowedQueries returns the command handler for querying the allowlist entries and whether they resolve.
+func GetCmdAllowedQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed-queries",
+		Short:   "Query the entries of the allow_queries param and whether they resolve to query paths of the host",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s allowed-queries", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowedQueries(cmd.Context(), &types.QueryAllowedQueriesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands
 func NewTxCmd() *cobra.Command {
 	return nil
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/controller/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for interchain query controller chains
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID string,
+	_ string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.ControllerPortID {
+		return "", errors.Wrapf(porttypes.ErrInvalidPort, "expected %s, got %s", types.ControllerPortID, portID)
+	}
+	if counterparty.PortId != types.PortID {
+		return "", errors.Wrapf(porttypes.ErrInvalidPort, "expected counterparty port %s, got %s", types.PortID, counterparty.PortId)
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+
+	if version != types.Version {
+		return "", errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errors.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_ string,
+	_ string,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_ string,
+	_ string,
+) error {
+	// Ensure channels cannot be closed by users
+	return errors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im IBCModule) OnRecvPacket(
+	_ sdk.Context,
+	_ string,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	err := errors.Wrap(types.ErrInvalidChannelFlow, "cannot receive packet on controller chain")
+	return channeltypes.NewErrorAcknowledgement(err)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	return im.keeper.OnTimeoutPacket(ctx, packet)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"strconv"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitQuerySentEvent emits an event signalling that a query was sent on the controller channel
+func EmitQuerySentEvent(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeQuerySent,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+}
+
+// EmitQueryResultEvent emits an event signalling the acknowledgement of a query, including the error the host
+// chain acknowledged it with, if any
+func EmitQueryResultEvent(ctx sdk.Context, query icqtypes.PendingQuery, ackErr string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeQueryResult,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, query.ChannelId),
+			sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(query.Sequence, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeySuccess, strconv.FormatBool(ackErr == "")),
+			sdk.NewAttribute(icqtypes.AttributeKeyAckError, ackErr),
+		),
+	)
+}
+
+// EmitQueryTimeoutEvent emits an event signalling the timeout of a query
+func EmitQueryTimeoutEvent(ctx sdk.Context, query icqtypes.PendingQuery) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeQueryTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, query.ChannelId),
+			sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(query.Sequence, 10)),
+		),
+	)
+}
+
+// EmitCallbackErrorEvent emits an event signalling that the callback of a query failed
+func EmitCallbackErrorEvent(ctx sdk.Context, query icqtypes.PendingQuery, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeCallbackError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, query.ChannelId),
+			sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(query.Sequence, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyAckError, err.Error()),
+		),
+	)
+}
+
+// EmitRecurringQueryRegisteredEvent emits an event signalling the registration of a recurring query
+func EmitRecurringQueryRegisteredEvent(ctx sdk.Context, query icqtypes.RecurringQuery) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeRecurringQueryRegistered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyRecurringQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, query.ChannelId),
+		),
+	)
+}
+
+// EmitRecurringQueryCancelledEvent emits an event signalling that the owner of a recurring query cancelled it
+func EmitRecurringQueryCancelledEvent(ctx sdk.Context, query icqtypes.RecurringQuery) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeRecurringQueryCancelled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyRecurringQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyOwner, query.Owner),
+		),
+	)
+}
+
+// EmitRecurringQuerySendErrorEvent emits an event signalling that a run of a recurring query could not be sent
+func EmitRecurringQuerySendErrorEvent(ctx sdk.Context, query icqtypes.RecurringQuery, run uint64, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeRecurringQuerySendError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ControllerModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyRecurringQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyRecurringQueryRun, strconv.FormatUint(run, 10)),
+			sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, query.ChannelId),
+			sdk.NewAttribute(icqtypes.AttributeKeyAckError, err.Error()),
+		),
+	)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the params, pending queries and recurring queries of the icq controller.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.ControllerGenesisState) {
+	for _, query := range state.PendingQueries {
+		k.SetPendingQuery(ctx, query)
+	}
+
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		panic(fmt.Sprintf("could not set controller params: %v", err))
+	}
+
+	for _, query := range state.RecurringQueries {
+		k.SetRecurringQuery(ctx, query)
+	}
+	for _, result := range state.RecurringQueryResults {
+		k.SetRecurringQueryResult(ctx, result)
+	}
+	if state.NextRecurringQueryId != 0 {
+		k.SetNextRecurringQueryID(ctx, state.NextRecurringQueryId)
+	}
+}
+
+// ExportGenesis exports the params, pending queries and recurring queries of the icq controller into its genesis
+// state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.ControllerGenesisState {
+	return &types.ControllerGenesisState{
+		PendingQueries:        k.GetAllPendingQueries(ctx),
+		Params:                k.GetParams(ctx),
+		RecurringQueries:      k.GetAllRecurringQueries(ctx),
+		RecurringQueryResults: k.GetAllRecurringQueryResults(ctx),
+		NextRecurringQueryId:  k.GetNextRecurringQueryID(ctx),
+	}
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.SetupTest()
+
+	genesisState := types.ControllerGenesisState{
+		PendingQueries: []types.PendingQuery{
+			{
+				ChannelId:        "channel-0",
+				Sequence:         1,
+				Requests:         []abcitypes.RequestQuery{{Path: "path/to/query1"}},
+				TimeoutTimestamp: 100,
+			},
+		},
+	}
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	controllerKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
+
+	query, found := controllerKeeper.GetPendingQuery(suite.chainA.GetContext(), "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PendingQueries[0], query)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisRecurringQueries() {
+	suite.SetupTest()
+
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupControllerPath(path))
+
+	ctx := suite.chainA.GetContext()
+	genesisState := types.ControllerGenesisState{
+		Params: types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit},
+		RecurringQueries: []types.RecurringQuery{
+			{
+				Id:              3,
+				Owner:           suite.chainA.SenderAccount.GetAddress().String(),
+				ChannelId:       path.EndpointA.ChannelID,
+				Requests:        suite.newAllBalancesRequests(),
+				Interval:        10,
+				MaxRuns:         5,
+				RelativeTimeout: queryTimeout,
+				Runs:            1,
+				NextRunHeight:   ctx.BlockHeight(),
+				Deposit:         recurringQueryDeposit,
+			},
+		},
+		RecurringQueryResults: []types.RecurringQueryResult{{RecurringQueryId: 3, Run: 1, Sequence: 1}},
+		NextRecurringQueryId:  4,
+	}
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	controllerKeeper.InitGenesis(ctx, genesisState)
+
+	exported := controllerKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genesisState.Params, exported.Params)
+	suite.Require().Equal(genesisState.RecurringQueries, exported.RecurringQueries)
+	suite.Require().Equal(genesisState.RecurringQueryResults, exported.RecurringQueryResults)
+	suite.Require().Equal(uint64(4), exported.NextRecurringQueryId)
+
+	// the imported query is scheduled
+	controllerKeeper.SendDueRecurringQueries(ctx)
+	pendingQueries := controllerKeeper.GetAllPendingQueries(ctx)
+	suite.Require().Len(pendingQueries, 1)
+	suite.Require().Equal(uint64(2), pendingQueries[0].RecurringQueryRun)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.SetupTest()
+
+	genesisState := simapp.GetSimApp(suite.chainA).ICQControllerKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Empty(genesisState.GetPendingQueries())
+	suite.Require().Empty(genesisState.GetRecurringQueries())
+	suite.Require().Equal(uint64(1), genesisState.NextRecurringQueryId)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the IBC interchain query controller keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+	bankKeeper    types.BankKeeper
+
+	callbacksRouter *types.QueryCallbacksRouter
+
+	// the address capable of executing a MsgUpdateControllerParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new interchain query controller Keeper instance. The deposits of the recurring queries are
+// escrowed in the controller module account
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper, authority string,
+) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetCallbacksRouter sets the router passing the result of each query to the callbacks of the module which sent
+// it. It must be called before the keeper is passed to the IBC module
+func (k *Keeper) SetCallbacksRouter(router *types.QueryCallbacksRouter) *Keeper {
+	if k.callbacksRouter != nil {
+		panic("cannot set interchain query callbacks router twice")
+	}
+
+	k.callbacksRouter = router
+	return k
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", ibcexported.ModuleName, types.ControllerModuleName))
+}
+
+// GetAppVersion calls the ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// SetPendingQuery stores a query waiting for its acknowledgement or timeout
+func (k Keeper) SetPendingQuery(ctx sdk.Context, query types.PendingQuery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingQueryKey(query.ChannelId, query.Sequence), k.cdc.MustMarshal(&query))
+}
+
+// GetPendingQuery returns the query sent on the channel with the sequence, if it is still pending
+func (k Keeper) GetPendingQuery(ctx sdk.Context, channelID string, sequence uint64) (types.PendingQuery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingQueryKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingQuery{}, false
+	}
+
+	var query types.PendingQuery
+	k.cdc.MustUnmarshal(bz, &query)
+	return query, true
+}
+
+// DeletePendingQuery removes the query sent on the channel with the sequence from the pending queries
+func (k Keeper) DeletePendingQuery(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingQueryKey(channelID, sequence))
+}
+
+// GetAllPendingQueries returns the pending queries of all channels
+func (k Keeper) GetAllPendingQueries(ctx sdk.Context) []types.PendingQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingQueryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var queries []types.PendingQuery
+	for ; iterator.Valid(); iterator.Next() {
+		var query types.PendingQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+		queries = append(queries, query)
+	}
+	return queries
+}
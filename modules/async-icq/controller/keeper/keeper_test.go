@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// NewControllerPath creates a path from the controller port of chainA to the host port of chainB
+func NewControllerPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.ControllerPortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+// SetupControllerPath invokes the channel handshake handlers, starting from the controller
+func SetupControllerPath(path *ibctesting.Path) error {
+	if err := path.EndpointA.ChanOpenInit(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
+// newAllBalancesRequests returns the requests querying the balances of the sender account of chainB
+func (suite *KeeperTestSuite) newAllBalancesRequests() []abcitypes.RequestQuery {
+	q := banktypes.QueryAllBalancesRequest{
+		Address: suite.chainB.SenderAccount.GetAddress().String(),
+	}
+	return []abcitypes.RequestQuery{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+			Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+		},
+	}
+}
+
+// relayQuery relays the packet of the pending query to chainB, returning the acknowledgement written by the host.
+// The client of chainB on chainA is not updated, so that it can be updated to the height at which the packet was received
+func (suite *KeeperTestSuite) relayQuery(path *ibctesting.Path, query types.PendingQuery) (channeltypes.Packet, []byte) {
+	data, err := types.SerializeCosmosQuery(query.Requests)
+	suite.Require().NoError(err)
+	packetData := types.InterchainQueryPacketData{Data: data}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		query.Sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		query.TimeoutTimestamp,
+	)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	res, err := suite.chainB.SendMsgs(channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet, ack
+}
+
+// callbacksModule is the module the test queries are sent with, whose callbacks are the callbacksRecorder
+const callbacksModule = "querier"
+
+// callbacksRecorder records the results of the queries it receives
+type callbacksRecorder struct {
+	responses map[uint64][]abcitypes.ResponseQuery
+	errors    map[uint64]string
+	timeouts  []uint64
+
+	// fail makes every callback return an error
+	fail bool
+}
+
+func newCallbacksRecorder() *callbacksRecorder {
+	return &callbacksRecorder{
+		responses: make(map[uint64][]abcitypes.ResponseQuery),
+		errors:    make(map[uint64]string),
+	}
+}
+
+func (r *callbacksRecorder) OnQueryResponse(_ sdk.Context, query types.PendingQuery, responses []abcitypes.ResponseQuery) error {
+	if r.fail {
+		return errors.New("callback failed")
+	}
+	r.responses[query.Sequence] = responses
+	return nil
+}
+
+func (r *callbacksRecorder) OnQueryError(_ sdk.Context, query types.PendingQuery, ackErr string) error {
+	if r.fail {
+		return errors.New("callback failed")
+	}
+	r.errors[query.Sequence] = ackErr
+	return nil
+}
+
+func (r *callbacksRecorder) OnQueryTimeout(_ sdk.Context, query types.PendingQuery) error {
+	if r.fail {
+		return errors.New("callback failed")
+	}
+	r.timeouts = append(r.timeouts, query.Sequence)
+	return nil
+}
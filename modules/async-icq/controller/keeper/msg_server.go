@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ControllerMsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.ControllerMsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.ControllerMsgServer = msgServer{}
+
+func (ms msgServer) RegisterRecurringQuery(goCtx context.Context, req *types.MsgRegisterRecurringQuery) (*types.MsgRegisterRecurringQueryResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid owner address")
+	}
+
+	// The queries registered by accounts are not sent on behalf of any module, so their results are only recorded
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := ms.Keeper.RegisterRecurringQuery(ctx, "", owner, req.ChannelId, req.Requests, req.Interval, req.MaxRuns, req.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterRecurringQueryResponse{Id: id}, nil
+}
+
+func (ms msgServer) CancelRecurringQuery(goCtx context.Context, req *types.MsgCancelRecurringQuery) (*types.MsgCancelRecurringQueryResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid owner address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.CancelRecurringQuery(ctx, owner, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelRecurringQueryResponse{}, nil
+}
+
+func (ms msgServer) UpdateControllerParams(goCtx context.Context, req *types.MsgUpdateControllerParams) (*types.MsgUpdateControllerParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateControllerParamsResponse{}, nil
+}
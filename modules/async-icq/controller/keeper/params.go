@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetParams sets the controller parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.ControllerParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ControllerParamsKey, bz)
+	return nil
+}
+
+// GetParams returns the current controller parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.ControllerParams {
+	var p types.ControllerParams
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ControllerParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
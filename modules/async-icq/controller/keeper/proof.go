@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// VerifyStoreQuery verifies the proof of the response of the host chain to a raw store query sent on the channel,
+// against the light client of the host chain. The client must have a consensus state at the height following the
+// response height, so it may have to be updated to that height before the response can be verified
+func (k Keeper) VerifyStoreQuery(ctx sdk.Context, channelID string, req abci.RequestQuery, resp abci.ResponseQuery) error {
+	storeName, ok := types.ParseStoreQueryPath(req.Path)
+	if !ok {
+		return errors.Wrapf(types.ErrInvalidQuery, "not a store query path: %s", req.Path)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, types.ControllerPortID, channelID)
+	if !found {
+		return errors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", types.ControllerPortID, channelID)
+	}
+	connection, err := k.channelKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return errors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection ID (%s)", channel.ConnectionHops[0])
+	}
+
+	clientID := connection.ClientId
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, clientID)
+	if latestHeight.IsZero() {
+		return errors.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", clientID)
+	}
+
+	// the app hash committing to the state at the response height is in the header of the next block
+	height := clienttypes.NewHeight(latestHeight.GetRevisionNumber(), uint64(resp.Height)+1)
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height)
+	if !found {
+		return errors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client ID (%s) height (%s)", clientID, height)
+	}
+	rooted, ok := consensusState.(interface{ GetRoot() ibcexported.Root })
+	if !ok {
+		return errors.Wrapf(types.ErrInvalidProof, "consensus state %T has no commitment root", consensusState)
+	}
+
+	return types.VerifyStoreQueryResponse(rooted.GetRoot(), storeName, req.Data, resp)
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/controller"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// balanceStoreKey returns the key under which the bank module stores the balance of the denom of the address
+func balanceStoreKey(addr sdk.AccAddress, denom string) []byte {
+	codec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, codec, collections.Join(addr, denom))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// sendStoreQuery sends the raw store query from chainA to chainB and returns the response of chainB, after updating
+// the light client of chainB on chainA to the height following the response height
+func (suite *KeeperTestSuite) sendStoreQuery(path *ibctesting.Path, req abcitypes.RequestQuery) abcitypes.ResponseQuery {
+	params := types.DefaultParams()
+	params.AllowStoreQueries = []string{banktypes.StoreKey}
+	suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	recorder := newCallbacksRecorder()
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ibcModule := controller.NewIBCModule(*controllerKeeper.SetCallbacksRouter(types.NewQueryCallbacksRouter().AddRoute(callbacksModule, recorder)))
+
+	sequence, err := controllerKeeper.SendQuery(suite.chainA.GetContext(), callbacksModule, path.EndpointA.ChannelID, []abcitypes.RequestQuery{req}, queryTimeout)
+	suite.Require().NoError(err)
+	query, found := controllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+
+	packet, ack := suite.relayQuery(path, query)
+	suite.Require().NoError(ibcModule.OnAcknowledgementPacket(suite.chainA.GetContext(), types.Version, packet, ack, nil))
+	suite.Require().Len(recorder.responses[sequence], 1)
+
+	// the packet was received in the last block of chainB, whose header commits to the state the query was run against
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	return recorder.responses[sequence][0]
+}
+
+func (suite *KeeperTestSuite) TestVerifyStoreQuery() {
+	var (
+		req  abcitypes.RequestQuery
+		resp abcitypes.ResponseQuery
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"tampered value",
+			func() {
+				resp.Value = []byte("tampered")
+			},
+			false,
+		},
+		{
+			"proof of another key",
+			func() {
+				req.Data = balanceStoreKey(suite.chainB.SenderAccount.GetAddress(), "other")
+			},
+			false,
+		},
+		{
+			"no proof",
+			func() {
+				resp.ProofOps = nil
+			},
+			false,
+		},
+		{
+			"no consensus state at the height following the response height",
+			func() {
+				resp.Height += 10
+			},
+			false,
+		},
+		{
+			"not a store query",
+			func() {
+				req.Path = "/cosmos.bank.v1beta1.Query/AllBalances"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewControllerPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerPath(path)
+			suite.Require().NoError(err)
+
+			req = abcitypes.RequestQuery{
+				Path:  types.NewStoreQueryPath(banktypes.StoreKey),
+				Data:  balanceStoreKey(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom),
+				Prove: true,
+			}
+			resp = suite.sendStoreQuery(path, req)
+			suite.Require().NotEmpty(resp.Value)
+
+			tc.malleate() // malleate mutates test data
+
+			err = simapp.GetSimApp(suite.chainA).ICQControllerKeeper.VerifyStoreQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, req, resp)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyStoreQueryAbsence() {
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupControllerPath(path)
+	suite.Require().NoError(err)
+
+	req := abcitypes.RequestQuery{
+		Path:  types.NewStoreQueryPath(banktypes.StoreKey),
+		Data:  balanceStoreKey(suite.chainB.SenderAccount.GetAddress(), "absent"),
+		Prove: true,
+	}
+	resp := suite.sendStoreQuery(path, req)
+	suite.Require().Empty(resp.Value)
+
+	err = simapp.GetSimApp(suite.chainA).ICQControllerKeeper.VerifyStoreQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, req, resp)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/internal/cachectx"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// RegisterRecurringQuery registers the query requests to be sent on the controller channel every interval blocks,
+// starting at the next block, until they have been sent maxRuns times. Each run times out relativeTimeout
+// nanoseconds after the block time it is sent at. The requests and the registrations of the owner are bounded by the
+// params, and the recurring query deposit of the params, growing with the size of the requests, is escrowed from the
+// owner, which gets the deposit back when it cancels the registration. The results of the runs are passed to the
+// QueryCallbacks of the module, on behalf of the owner like the sender of SendQueryFrom. If the module is empty, as
+// for the registrations with MsgRegisterRecurringQuery, the results are only recorded
+func (k Keeper) RegisterRecurringQuery(ctx sdk.Context, module string, owner sdk.AccAddress, channelID string, reqs []abci.RequestQuery, interval, maxRuns, relativeTimeout uint64) (uint64, error) {
+	if owner.Empty() {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "owner cannot be empty")
+	}
+	if err := types.ValidateRecurringQuerySchedule(channelID, reqs, interval, maxRuns, relativeTimeout); err != nil {
+		return 0, err
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.ValidateRecurringQueryRequests(reqs); err != nil {
+		return 0, err
+	}
+	if count := k.GetRecurringQueryCount(ctx, owner.String()); params.MaxRecurringQueriesPerOwner != 0 && count >= params.MaxRecurringQueriesPerOwner {
+		return 0, errors.Wrapf(types.ErrRecurringQueryLimit, "owner has %d recurring queries, max is %d", count, params.MaxRecurringQueriesPerOwner)
+	}
+	if err := k.validateChannel(ctx, channelID); err != nil {
+		return 0, err
+	}
+
+	deposit := params.RecurringQueryDepositFor(reqs)
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ControllerModuleName, deposit); err != nil {
+			return 0, errors.Wrap(err, "could not escrow recurring query deposit")
+		}
+	}
+
+	id := k.GetNextRecurringQueryID(ctx)
+	k.SetNextRecurringQueryID(ctx, id+1)
+
+	query := types.RecurringQuery{
+		Id:              id,
+		Owner:           owner.String(),
+		ChannelId:       channelID,
+		Requests:        reqs,
+		Interval:        interval,
+		MaxRuns:         maxRuns,
+		RelativeTimeout: relativeTimeout,
+		NextRunHeight:   ctx.BlockHeight() + 1,
+		Deposit:         deposit,
+		Module:          module,
+	}
+	k.SetRecurringQuery(ctx, query)
+	EmitRecurringQueryRegisteredEvent(ctx, query)
+
+	return id, nil
+}
+
+// CancelRecurringQuery removes the recurring query and its latest result, and refunds its deposit to the owner.
+// The runs still pending are acknowledged or time out as usual, but their results are not recorded anymore
+func (k Keeper) CancelRecurringQuery(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	query, found := k.GetRecurringQuery(ctx, id)
+	if !found {
+		return errors.Wrapf(types.ErrRecurringQueryNotFound, "recurring query %d", id)
+	}
+	if query.Owner != owner.String() {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "recurring query %d is owned by %s", id, query.Owner)
+	}
+
+	k.DeleteRecurringQuery(ctx, id)
+	if !query.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ControllerModuleName, owner, query.Deposit); err != nil {
+			return errors.Wrap(err, "could not refund recurring query deposit")
+		}
+	}
+	EmitRecurringQueryCancelledEvent(ctx, query)
+
+	return nil
+}
+
+// SendDueRecurringQueries sends the recurring queries whose next run is due at the current height. A run which
+// cannot be sent, e.g. because its channel was closed, counts towards the max runs of the query, and its error is
+// recorded as the latest result of the query. At most max recurring queries per block runs are sent: the runs due
+// beyond it stay scheduled, and are sent first in the next blocks, their next runs being scheduled from the height
+// they are actually sent at
+func (k Keeper) SendDueRecurringQueries(ctx sdk.Context) {
+	maxRuns := k.GetParams(ctx).MaxRecurringQueriesPerBlock
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RecurringQueryScheduleKeyPrefix, types.RecurringQueryScheduleHeightPrefix(ctx.BlockHeight()+1))
+
+	// collect the due queries first, as running them updates the schedule
+	var ids []uint64
+	for ; iterator.Valid() && (maxRuns == 0 || uint64(len(ids)) < maxRuns); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		query, found := k.GetRecurringQuery(ctx, id)
+		if !found {
+			continue
+		}
+		k.runRecurringQuery(ctx, query)
+	}
+}
+
+// runRecurringQuery sends the next run of the recurring query and schedules the one after, if any
+func (k Keeper) runRecurringQuery(ctx sdk.Context, query types.RecurringQuery) {
+	query.Runs++
+	run := query.Runs
+	if query.HasRunsLeft() {
+		query.NextRunHeight = ctx.BlockHeight() + int64(query.Interval)
+	}
+	k.SetRecurringQuery(ctx, query)
+
+	owner := sdk.MustAccAddressFromBech32(query.Owner)
+	err := cachectx.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		_, err := k.sendQuery(ctx, query.Module, owner, query.ChannelId, query.Requests, query.RelativeTimeout, query.Id, run)
+		return err
+	})
+	if err != nil {
+		k.SetRecurringQueryResult(ctx, types.RecurringQueryResult{
+			RecurringQueryId: query.Id,
+			Run:              run,
+			Height:           ctx.BlockHeight(),
+			Error:            err.Error(),
+		})
+		EmitRecurringQuerySendErrorEvent(ctx, query, run, err)
+	}
+}
+
+// recordRecurringQueryResult records the result of the query as the latest result of the recurring query it is a
+// run of, unless the query is not recurring, its registration was cancelled, or a later run already has a result
+func (k Keeper) recordRecurringQueryResult(ctx sdk.Context, query types.PendingQuery, responses []abci.ResponseQuery, queryErr string) {
+	if query.RecurringQueryId == 0 {
+		return
+	}
+	if _, found := k.GetRecurringQuery(ctx, query.RecurringQueryId); !found {
+		return
+	}
+	if latest, found := k.GetRecurringQueryResult(ctx, query.RecurringQueryId); found && latest.Run > query.RecurringQueryRun {
+		return
+	}
+
+	k.SetRecurringQueryResult(ctx, types.RecurringQueryResult{
+		RecurringQueryId: query.RecurringQueryId,
+		Run:              query.RecurringQueryRun,
+		Sequence:         query.Sequence,
+		Height:           ctx.BlockHeight(),
+		Responses:        responses,
+		Error:            queryErr,
+	})
+}
+
+// SetRecurringQuery stores the recurring query, and schedules its next run if it has runs left
+func (k Keeper) SetRecurringQuery(ctx sdk.Context, query types.RecurringQuery) {
+	store := ctx.KVStore(k.storeKey)
+	previous, found := k.GetRecurringQuery(ctx, query.Id)
+	if found && previous.HasRunsLeft() {
+		store.Delete(types.RecurringQueryScheduleKey(previous.NextRunHeight, previous.Id))
+	}
+	if !found {
+		k.setRecurringQueryCount(ctx, query.Owner, k.GetRecurringQueryCount(ctx, query.Owner)+1)
+	} else if previous.Owner != query.Owner {
+		k.setRecurringQueryCount(ctx, previous.Owner, k.GetRecurringQueryCount(ctx, previous.Owner)-1)
+		k.setRecurringQueryCount(ctx, query.Owner, k.GetRecurringQueryCount(ctx, query.Owner)+1)
+	}
+
+	store.Set(types.RecurringQueryKey(query.Id), k.cdc.MustMarshal(&query))
+	if query.HasRunsLeft() {
+		store.Set(types.RecurringQueryScheduleKey(query.NextRunHeight, query.Id), []byte{0x01})
+	}
+}
+
+// GetRecurringQuery returns the recurring query with the ID, if it is registered
+func (k Keeper) GetRecurringQuery(ctx sdk.Context, id uint64) (types.RecurringQuery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecurringQueryKey(id))
+	if bz == nil {
+		return types.RecurringQuery{}, false
+	}
+
+	var query types.RecurringQuery
+	k.cdc.MustUnmarshal(bz, &query)
+	return query, true
+}
+
+// DeleteRecurringQuery removes the recurring query with the ID, its schedule and its latest result
+func (k Keeper) DeleteRecurringQuery(ctx sdk.Context, id uint64) {
+	query, found := k.GetRecurringQuery(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if query.HasRunsLeft() {
+		store.Delete(types.RecurringQueryScheduleKey(query.NextRunHeight, query.Id))
+	}
+	store.Delete(types.RecurringQueryKey(id))
+	store.Delete(types.RecurringQueryResultKey(id))
+	k.setRecurringQueryCount(ctx, query.Owner, k.GetRecurringQueryCount(ctx, query.Owner)-1)
+}
+
+// GetRecurringQueryCount returns the number of recurring queries registered by the owner
+func (k Keeper) GetRecurringQueryCount(ctx sdk.Context, owner string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecurringQueryOwnerCountKey(owner))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRecurringQueryCount sets the number of recurring queries registered by the owner, removing it once it is 0
+func (k Keeper) setRecurringQueryCount(ctx sdk.Context, owner string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.RecurringQueryOwnerCountKey(owner))
+		return
+	}
+	store.Set(types.RecurringQueryOwnerCountKey(owner), sdk.Uint64ToBigEndian(count))
+}
+
+// GetAllRecurringQueries returns all the registered recurring queries
+func (k Keeper) GetAllRecurringQueries(ctx sdk.Context) []types.RecurringQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecurringQueryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var queries []types.RecurringQuery
+	for ; iterator.Valid(); iterator.Next() {
+		var query types.RecurringQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+		queries = append(queries, query)
+	}
+	return queries
+}
+
+// SetRecurringQueryResult stores the latest result of a recurring query
+func (k Keeper) SetRecurringQueryResult(ctx sdk.Context, result types.RecurringQueryResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecurringQueryResultKey(result.RecurringQueryId), k.cdc.MustMarshal(&result))
+}
+
+// GetRecurringQueryResult returns the latest result of the recurring query with the ID, if any of its runs has
+// completed
+func (k Keeper) GetRecurringQueryResult(ctx sdk.Context, id uint64) (types.RecurringQueryResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecurringQueryResultKey(id))
+	if bz == nil {
+		return types.RecurringQueryResult{}, false
+	}
+
+	var result types.RecurringQueryResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// GetAllRecurringQueryResults returns the latest results of all the recurring queries
+func (k Keeper) GetAllRecurringQueryResults(ctx sdk.Context) []types.RecurringQueryResult {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecurringQueryResultKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var results []types.RecurringQueryResult
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.RecurringQueryResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// SetNextRecurringQueryID sets the ID of the next registered recurring query
+func (k Keeper) SetNextRecurringQueryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextRecurringQueryIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextRecurringQueryID returns the ID of the next registered recurring query. IDs start at 1
+func (k Keeper) GetNextRecurringQueryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextRecurringQueryIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,565 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/controller"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/controller/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var recurringQueryDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+// setupRecurringQueries opens a controller channel and sets the recurring query deposit of chainA
+func (suite *KeeperTestSuite) setupRecurringQueries() *ibctesting.Path {
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupControllerPath(path))
+
+	params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit}
+	suite.Require().NoError(simapp.GetSimApp(suite.chainA).ICQControllerKeeper.SetParams(suite.chainA.GetContext(), params))
+
+	return path
+}
+
+// escrowedDeposits returns the balance of the controller module account of chainA
+func (suite *KeeperTestSuite) escrowedDeposits() sdk.Coins {
+	addr := authtypes.NewModuleAddress(types.ControllerModuleName)
+	return simapp.GetSimApp(suite.chainA).BankKeeper.GetAllBalances(suite.chainA.GetContext(), addr)
+}
+
+func (suite *KeeperTestSuite) TestRegisterRecurringQuery() {
+	var (
+		path      *ibctesting.Path
+		owner     sdk.AccAddress
+		channelID string
+		reqs      []abcitypes.RequestQuery
+		interval  uint64
+		maxRuns   uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"owner is empty",
+			func() {
+				owner = nil
+			},
+			false,
+		},
+		{
+			"requests are empty",
+			func() {
+				reqs = nil
+			},
+			false,
+		},
+		{
+			"interval is 0",
+			func() {
+				interval = 0
+			},
+			false,
+		},
+		{
+			"interval is too large",
+			func() {
+				interval = types.MaxRecurringQueryInterval + 1
+			},
+			false,
+		},
+		{
+			"max runs is 0",
+			func() {
+				maxRuns = 0
+			},
+			false,
+		},
+		{
+			"channel does not exist",
+			func() {
+				channelID = "channel-100"
+			},
+			false,
+		},
+		{
+			"channel is not open",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+			},
+			false,
+		},
+		{
+			"too many requests",
+			func() {
+				params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit, MaxRecurringQueryRequests: 1}
+				suite.Require().NoError(simapp.GetSimApp(suite.chainA).ICQControllerKeeper.SetParams(suite.chainA.GetContext(), params))
+				reqs = append(reqs, reqs...)
+			},
+			false,
+		},
+		{
+			"requests too large",
+			func() {
+				params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit, MaxRecurringQueryBytes: 1}
+				suite.Require().NoError(simapp.GetSimApp(suite.chainA).ICQControllerKeeper.SetParams(suite.chainA.GetContext(), params))
+			},
+			false,
+		},
+		{
+			"owner cannot pay the deposit",
+			func() {
+				owner = sdk.AccAddress("unfunded-owner")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupRecurringQueries()
+			owner = suite.chainA.SenderAccount.GetAddress()
+			channelID = path.EndpointA.ChannelID
+			reqs = suite.newAllBalancesRequests()
+			interval = 5
+			maxRuns = 3
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+			id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, owner, channelID, reqs, interval, maxRuns, queryTimeout)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), id)
+				suite.Require().Equal(uint64(2), controllerKeeper.GetNextRecurringQueryID(ctx))
+
+				query, found := controllerKeeper.GetRecurringQuery(ctx, id)
+				suite.Require().True(found)
+				suite.Require().Equal(types.RecurringQuery{
+					Id:              id,
+					Owner:           owner.String(),
+					ChannelId:       channelID,
+					Requests:        reqs,
+					Interval:        interval,
+					MaxRuns:         maxRuns,
+					RelativeTimeout: queryTimeout,
+					NextRunHeight:   ctx.BlockHeight() + 1,
+					Deposit:         recurringQueryDeposit,
+					Module:          callbacksModule,
+				}, query)
+				suite.Require().Equal(recurringQueryDeposit, suite.escrowedDeposits())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(controllerKeeper.GetAllRecurringQueries(ctx))
+				suite.Require().True(suite.escrowedDeposits().IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecurringQueryByteDeposit() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ctx := suite.chainA.GetContext()
+	byteDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10)))
+	params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit, RecurringQueryByteDeposit: byteDeposit}
+	suite.Require().NoError(controllerKeeper.SetParams(ctx, params))
+
+	reqs := suite.newAllBalancesRequests()
+	id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, suite.chainA.SenderAccount.GetAddress(), path.EndpointA.ChannelID, reqs, 10, 1, queryTimeout)
+	suite.Require().NoError(err)
+
+	// the deposit grows with the size of the requests
+	size := types.RecurringQueryRequestsSize(reqs)
+	suite.Require().NotZero(size)
+	expDeposit := recurringQueryDeposit.Add(byteDeposit.MulInt(sdkmath.NewIntFromUint64(size))...)
+	query, found := controllerKeeper.GetRecurringQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(expDeposit, query.Deposit)
+	suite.Require().Equal(expDeposit, suite.escrowedDeposits())
+}
+
+func (suite *KeeperTestSuite) TestRecurringQueryOwnerLimit() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ctx := suite.chainA.GetContext()
+	params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit, MaxRecurringQueriesPerOwner: 2}
+	suite.Require().NoError(controllerKeeper.SetParams(ctx, params))
+
+	owner := suite.chainA.SenderAccount.GetAddress()
+	other := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	register := func(owner sdk.AccAddress) (uint64, error) {
+		return controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, owner, path.EndpointA.ChannelID, suite.newAllBalancesRequests(), 10, 1, queryTimeout)
+	}
+
+	id, err := register(owner)
+	suite.Require().NoError(err)
+	_, err = register(owner)
+	suite.Require().NoError(err)
+	_, err = register(owner)
+	suite.Require().ErrorIs(err, types.ErrRecurringQueryLimit)
+	suite.Require().Equal(uint64(2), controllerKeeper.GetRecurringQueryCount(ctx, owner.String()))
+
+	// the limit applies to each owner
+	_, err = register(other)
+	suite.Require().NoError(err)
+
+	// cancelling a registration frees a slot
+	suite.Require().NoError(controllerKeeper.CancelRecurringQuery(ctx, owner, id))
+	suite.Require().Equal(uint64(1), controllerKeeper.GetRecurringQueryCount(ctx, owner.String()))
+	_, err = register(owner)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSendDueRecurringQueriesPerBlockLimit() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ctx := suite.chainA.GetContext()
+	params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit, MaxRecurringQueriesPerBlock: 2}
+	suite.Require().NoError(controllerKeeper.SetParams(ctx, params))
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, suite.chainA.SenderAccount.GetAddress(), path.EndpointA.ChannelID, suite.newAllBalancesRequests(), 10, 2, queryTimeout)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+
+	// the first two due queries are sent at the next block, and the third one is carried over to the block after
+	height := ctx.BlockHeight() + 1
+	controllerKeeper.SendDueRecurringQueries(ctx.WithBlockHeight(height))
+	suite.Require().Len(controllerKeeper.GetAllPendingQueries(ctx), 2)
+
+	controllerKeeper.SendDueRecurringQueries(ctx.WithBlockHeight(height + 1))
+	suite.Require().Len(controllerKeeper.GetAllPendingQueries(ctx), 3)
+
+	expNextRunHeights := []int64{height + 10, height + 10, height + 11}
+	for i, id := range ids {
+		query, found := controllerKeeper.GetRecurringQuery(ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(1), query.Runs)
+		suite.Require().Equal(expNextRunHeights[i], query.NextRunHeight)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendDueRecurringQueries() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	owner := suite.chainA.SenderAccount.GetAddress()
+	reqs := suite.newAllBalancesRequests()
+
+	ctx := suite.chainA.GetContext()
+	height := ctx.BlockHeight()
+	id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, owner, path.EndpointA.ChannelID, reqs, 2, 2, queryTimeout)
+	suite.Require().NoError(err)
+
+	// the first run is sent at the next block, the second one interval blocks later, and none after max runs
+	expRuns := []uint64{0, 1, 1, 2, 2, 2}
+	for i, expRun := range expRuns {
+		ctx := suite.chainA.GetContext().WithBlockHeight(height + int64(i))
+		controllerKeeper.SendDueRecurringQueries(ctx)
+
+		query, found := controllerKeeper.GetRecurringQuery(ctx, id)
+		suite.Require().True(found)
+		suite.Require().Equal(expRun, query.Runs, "height %d", ctx.BlockHeight())
+		suite.Require().Len(controllerKeeper.GetAllPendingQueries(ctx), int(expRun))
+	}
+
+	pendingQueries := controllerKeeper.GetAllPendingQueries(ctx)
+	for i, pendingQuery := range pendingQueries {
+		suite.Require().Equal(id, pendingQuery.RecurringQueryId)
+		suite.Require().Equal(uint64(i+1), pendingQuery.RecurringQueryRun)
+		suite.Require().Equal(owner.String(), pendingQuery.Sender)
+		suite.Require().Equal(reqs, pendingQuery.Requests)
+	}
+
+	query, _ := controllerKeeper.GetRecurringQuery(ctx, id)
+	suite.Require().False(query.HasRunsLeft())
+}
+
+func (suite *KeeperTestSuite) TestRecurringQueryBeginBlock() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	id, err := controllerKeeper.RegisterRecurringQuery(suite.chainA.GetContext(), callbacksModule, suite.chainA.SenderAccount.GetAddress(), path.EndpointA.ChannelID, suite.newAllBalancesRequests(), 100, 1, queryTimeout)
+	suite.Require().NoError(err)
+
+	// the query is registered in the block being built, so that it is due in the one after
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().Empty(controllerKeeper.GetAllPendingQueries(suite.chainA.GetContext()))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	pendingQueries := controllerKeeper.GetAllPendingQueries(suite.chainA.GetContext())
+	suite.Require().Len(pendingQueries, 1)
+	suite.Require().Equal(id, pendingQueries[0].RecurringQueryId)
+}
+
+func (suite *KeeperTestSuite) TestRecurringQuerySendError() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ctx := suite.chainA.GetContext()
+	id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, suite.chainA.SenderAccount.GetAddress(), path.EndpointA.ChannelID, suite.newAllBalancesRequests(), 1, 2, queryTimeout)
+	suite.Require().NoError(err)
+
+	// close the channel without committing a block, which would already send the query
+	channelKeeper := simapp.GetSimApp(suite.chainA).IBCKeeper.ChannelKeeper
+	channel := path.EndpointA.GetChannel()
+	channel.State = channeltypes.CLOSED
+	channelKeeper.SetChannel(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	controllerKeeper.SendDueRecurringQueries(ctx)
+	suite.Require().Empty(controllerKeeper.GetAllPendingQueries(ctx))
+
+	query, found := controllerKeeper.GetRecurringQuery(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), query.Runs)
+	suite.Require().Equal(ctx.BlockHeight()+1, query.NextRunHeight)
+
+	result, found := controllerKeeper.GetRecurringQueryResult(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), result.Run)
+	suite.Require().Equal(ctx.BlockHeight(), result.Height)
+	suite.Require().NotEmpty(result.Error)
+
+	found = false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRecurringQuerySendError {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestRecurringQueryResult() {
+	var (
+		path     *ibctesting.Path
+		recorder *callbacksRecorder
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// complete acknowledges or times out the packet of the run
+		complete func(ibcModule controller.IBCModule, query types.PendingQuery) error
+		check    func(result types.RecurringQueryResult, found bool)
+	}{
+		{
+			"host responds to the query",
+			func() {
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			},
+			func(ibcModule controller.IBCModule, query types.PendingQuery) error {
+				packet, ack := suite.relayQuery(path, query)
+				return ibcModule.OnAcknowledgementPacket(suite.chainA.GetContext(), types.Version, packet, ack, nil)
+			},
+			func(result types.RecurringQueryResult, found bool) {
+				suite.Require().True(found)
+				suite.Require().Len(result.Responses, 1)
+				suite.Require().Empty(result.Error)
+			},
+		},
+		{
+			"host acknowledges the query with an error", // NOTE: do not update params to explicitly force the error
+			func() {},
+			func(ibcModule controller.IBCModule, query types.PendingQuery) error {
+				packet, ack := suite.relayQuery(path, query)
+				return ibcModule.OnAcknowledgementPacket(suite.chainA.GetContext(), types.Version, packet, ack, nil)
+			},
+			func(result types.RecurringQueryResult, found bool) {
+				suite.Require().True(found)
+				suite.Require().Empty(result.Responses)
+				suite.Require().NotEmpty(result.Error)
+			},
+		},
+		{
+			"query times out",
+			func() {},
+			func(ibcModule controller.IBCModule, query types.PendingQuery) error {
+				return ibcModule.OnTimeoutPacket(suite.chainA.GetContext(), types.Version, suite.queryPacket(path, query), nil)
+			},
+			func(result types.RecurringQueryResult, found bool) {
+				suite.Require().True(found)
+				suite.Require().Empty(result.Responses)
+				suite.Require().NotEmpty(result.Error)
+				suite.Require().Equal(recorder.timeouts, []uint64{result.Sequence})
+			},
+		},
+		{
+			"result of a later run is not overwritten",
+			func() {
+				controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+				controllerKeeper.SetRecurringQueryResult(suite.chainA.GetContext(), types.RecurringQueryResult{RecurringQueryId: 1, Run: 2})
+			},
+			func(ibcModule controller.IBCModule, query types.PendingQuery) error {
+				return ibcModule.OnTimeoutPacket(suite.chainA.GetContext(), types.Version, suite.queryPacket(path, query), nil)
+			},
+			func(result types.RecurringQueryResult, found bool) {
+				suite.Require().True(found)
+				suite.Require().Equal(types.RecurringQueryResult{RecurringQueryId: 1, Run: 2}, result)
+			},
+		},
+		{
+			"result of a cancelled registration is not recorded",
+			func() {
+				controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+				suite.Require().NoError(controllerKeeper.CancelRecurringQuery(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), 1))
+			},
+			func(ibcModule controller.IBCModule, query types.PendingQuery) error {
+				return ibcModule.OnTimeoutPacket(suite.chainA.GetContext(), types.Version, suite.queryPacket(path, query), nil)
+			},
+			func(_ types.RecurringQueryResult, found bool) {
+				suite.Require().False(found)
+				suite.Require().Len(recorder.timeouts, 1, "callbacks still receive the result")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupRecurringQueries()
+			recorder = newCallbacksRecorder()
+
+			controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+			ibcModule := controller.NewIBCModule(*controllerKeeper.SetCallbacksRouter(types.NewQueryCallbacksRouter().AddRoute(callbacksModule, recorder)))
+
+			ctx := suite.chainA.GetContext()
+			id, err := controllerKeeper.RegisterRecurringQuery(ctx, callbacksModule, suite.chainA.SenderAccount.GetAddress(), path.EndpointA.ChannelID, suite.newAllBalancesRequests(), 100, 2, queryTimeout)
+			suite.Require().NoError(err)
+			controllerKeeper.SendDueRecurringQueries(suite.chainA.GetContext().WithBlockHeight(ctx.BlockHeight() + 1))
+
+			pendingQueries := controllerKeeper.GetAllPendingQueries(suite.chainA.GetContext())
+			suite.Require().Len(pendingQueries, 1)
+
+			tc.malleate() // malleate mutates test data
+
+			suite.Require().NoError(tc.complete(ibcModule, pendingQueries[0]))
+
+			result, found := controllerKeeper.GetRecurringQueryResult(suite.chainA.GetContext(), id)
+			if found && result.Sequence != 0 { // the result was recorded from the run
+				suite.Require().Equal(id, result.RecurringQueryId)
+				suite.Require().Equal(uint64(1), result.Run)
+				suite.Require().Equal(pendingQueries[0].Sequence, result.Sequence)
+			}
+			tc.check(result, found)
+		})
+	}
+}
+
+// queryPacket returns the packet sent for the pending query, without data
+func (suite *KeeperTestSuite) queryPacket(path *ibctesting.Path, query types.PendingQuery) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		nil,
+		query.Sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		query.TimeoutTimestamp,
+	)
+}
+
+func (suite *KeeperTestSuite) TestCancelRecurringQuery() {
+	path := suite.setupRecurringQueries()
+
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	msgServer := keeper.NewMsgServerImpl(controllerKeeper)
+	owner := suite.chainA.SenderAccount.GetAddress()
+	other := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	ctx := suite.chainA.GetContext()
+
+	res, err := msgServer.RegisterRecurringQuery(ctx, &types.MsgRegisterRecurringQuery{
+		Owner:           owner.String(),
+		ChannelId:       path.EndpointA.ChannelID,
+		Requests:        suite.newAllBalancesRequests(),
+		Interval:        10,
+		MaxRuns:         5,
+		RelativeTimeout: queryTimeout,
+	})
+	suite.Require().NoError(err)
+	controllerKeeper.SetRecurringQueryResult(ctx, types.RecurringQueryResult{RecurringQueryId: res.Id, Run: 1})
+
+	balance := simapp.GetSimApp(suite.chainA).BankKeeper.GetAllBalances(ctx, owner)
+
+	_, err = msgServer.CancelRecurringQuery(ctx, &types.MsgCancelRecurringQuery{Owner: other.String(), Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.CancelRecurringQuery(ctx, &types.MsgCancelRecurringQuery{Owner: owner.String(), Id: res.Id})
+	suite.Require().NoError(err)
+
+	_, found := controllerKeeper.GetRecurringQuery(ctx, res.Id)
+	suite.Require().False(found)
+	_, found = controllerKeeper.GetRecurringQueryResult(ctx, res.Id)
+	suite.Require().False(found)
+	suite.Require().True(suite.escrowedDeposits().IsZero())
+	suite.Require().Equal(balance.Add(recurringQueryDeposit...), simapp.GetSimApp(suite.chainA).BankKeeper.GetAllBalances(ctx, owner))
+
+	// the cancelled query is not scheduled anymore
+	controllerKeeper.SendDueRecurringQueries(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	suite.Require().Empty(controllerKeeper.GetAllPendingQueries(ctx))
+
+	_, err = msgServer.CancelRecurringQuery(ctx, &types.MsgCancelRecurringQuery{Owner: owner.String(), Id: res.Id})
+	suite.Require().ErrorIs(err, types.ErrRecurringQueryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestUpdateControllerParams() {
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	msgServer := keeper.NewMsgServerImpl(controllerKeeper)
+	params := types.ControllerParams{RecurringQueryDeposit: recurringQueryDeposit}
+	ctx := suite.chainA.GetContext()
+
+	_, err := msgServer.UpdateControllerParams(ctx, &types.MsgUpdateControllerParams{
+		Authority: suite.chainA.SenderAccount.GetAddress().String(),
+		Params:    params,
+	})
+	suite.Require().Error(err, "invalid authority")
+
+	_, err = msgServer.UpdateControllerParams(ctx, &types.MsgUpdateControllerParams{
+		Authority: controllerKeeper.GetAuthority(),
+		Params:    types.ControllerParams{RecurringQueryDeposit: sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.OneInt()}}},
+	})
+	suite.Require().Error(err, "invalid deposit")
+
+	_, err = msgServer.UpdateControllerParams(ctx, &types.MsgUpdateControllerParams{
+		Authority: controllerKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, controllerKeeper.GetParams(ctx))
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/internal/cachectx"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// SendQuery sends the query requests of the module to the host chain of the controller channel, returning the
+// sequence of the packet. The query times out relativeTimeout nanoseconds after the current block time. Its result
+// is passed to the QueryCallbacks of the module when the query is acknowledged or times out
+func (k Keeper) SendQuery(ctx sdk.Context, module, channelID string, reqs []abci.RequestQuery, relativeTimeout uint64) (uint64, error) {
+	return k.SendQueryFrom(ctx, module, nil, channelID, reqs, relativeTimeout)
+}
+
+// SendQueryFrom sends the query requests like SendQuery, on behalf of the sender. The sender is stored with the
+// pending query, so that the QueryCallbacks of the module can pass the result of the query to it, e.g. to the
+// contract which sent the query
+func (k Keeper) SendQueryFrom(ctx sdk.Context, module string, sender sdk.AccAddress, channelID string, reqs []abci.RequestQuery, relativeTimeout uint64) (uint64, error) {
+	if module == "" {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "module cannot be empty")
+	}
+	return k.sendQuery(ctx, module, sender, channelID, reqs, relativeTimeout, 0, 0)
+}
+
+// sendQuery sends the query requests of the module on behalf of the sender, recording the run of the recurring
+// query the query is, if any
+func (k Keeper) sendQuery(ctx sdk.Context, module string, sender sdk.AccAddress, channelID string, reqs []abci.RequestQuery, relativeTimeout, recurringQueryID, recurringQueryRun uint64) (uint64, error) {
+	if len(reqs) == 0 {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "requests cannot be empty")
+	}
+	for _, req := range reqs {
+		if req.Path == "" {
+			return 0, errors.Wrap(types.ErrInvalidQuery, "request path cannot be empty")
+		}
+	}
+	if relativeTimeout == 0 {
+		return 0, errors.Wrap(types.ErrInvalidQuery, "timeout cannot be 0")
+	}
+
+	if err := k.validateChannel(ctx, channelID); err != nil {
+		return 0, err
+	}
+
+	data, err := types.SerializeCosmosQuery(reqs)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not serialize reqs into cosmos query")
+	}
+	packetData := types.InterchainQueryPacketData{
+		Data: data,
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, types.ControllerPortID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	query := types.PendingQuery{
+		ChannelId:         channelID,
+		Sequence:          sequence,
+		Requests:          reqs,
+		TimeoutTimestamp:  timeoutTimestamp,
+		RecurringQueryId:  recurringQueryID,
+		RecurringQueryRun: recurringQueryRun,
+		Module:            module,
+	}
+	if !sender.Empty() {
+		query.Sender = sender.String()
+	}
+	k.SetPendingQuery(ctx, query)
+	EmitQuerySentEvent(ctx, channelID, sequence)
+
+	return sequence, nil
+}
+
+// validateChannel checks that the controller channel exists and is open
+func (k Keeper) validateChannel(ctx sdk.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, types.ControllerPortID, channelID)
+	if !found {
+		return errors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", types.ControllerPortID, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errors.Wrapf(channeltypes.ErrInvalidChannelState, "channel %s is not open", channelID)
+	}
+	return nil
+}
+
+// OnAcknowledgementPacket removes the query from the pending queries, and passes the responses of the host chain,
+// or the error it acknowledged the query with, to the QueryCallbacks
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	query, found := k.GetPendingQuery(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return errors.Wrapf(types.ErrPendingQueryNotFound, "channel %s, sequence %d", packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errors.Wrapf(types.ErrInvalidAck, "cannot unmarshal ICQ packet acknowledgement: %v", err)
+	}
+
+	k.DeletePendingQuery(ctx, query.ChannelId, query.Sequence)
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		cosmosResponse, err := types.DeserializeCosmosResponseFromAck(resp.Result)
+		if err != nil {
+			// The host chain acknowledged the query with something else than query responses
+			k.onQueryError(ctx, query, err.Error())
+			return nil
+		}
+		k.onQueryResponse(ctx, query, cosmosResponse.Responses)
+	case *channeltypes.Acknowledgement_Error:
+		k.onQueryError(ctx, query, resp.Error)
+	}
+	return nil
+}
+
+// OnTimeoutPacket removes the query from the pending queries and notifies the QueryCallbacks of its timeout
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	query, found := k.GetPendingQuery(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return errors.Wrapf(types.ErrPendingQueryNotFound, "channel %s, sequence %d", packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	k.DeletePendingQuery(ctx, query.ChannelId, query.Sequence)
+	EmitQueryTimeoutEvent(ctx, query)
+	k.recordRecurringQueryResult(ctx, query, nil, "query timed out")
+
+	k.runCallback(ctx, query, func(ctx sdk.Context, callbacks types.QueryCallbacks) error {
+		return callbacks.OnQueryTimeout(ctx, query)
+	})
+	return nil
+}
+
+func (k Keeper) onQueryResponse(ctx sdk.Context, query types.PendingQuery, responses []abci.ResponseQuery) {
+	EmitQueryResultEvent(ctx, query, "")
+	k.recordRecurringQueryResult(ctx, query, responses, "")
+
+	k.runCallback(ctx, query, func(ctx sdk.Context, callbacks types.QueryCallbacks) error {
+		return callbacks.OnQueryResponse(ctx, query, responses)
+	})
+}
+
+func (k Keeper) onQueryError(ctx sdk.Context, query types.PendingQuery, ackErr string) {
+	EmitQueryResultEvent(ctx, query, ackErr)
+	k.recordRecurringQueryResult(ctx, query, nil, ackErr)
+
+	k.runCallback(ctx, query, func(ctx sdk.Context, callbacks types.QueryCallbacks) error {
+		return callbacks.OnQueryError(ctx, query, ackErr)
+	})
+}
+
+// runCallback runs the callback with the QueryCallbacks of the module which sent the query only, if it has any. The
+// callback runs in its own cached context, whose state changes are dropped if the callback fails, so that a failing
+// callback cannot prevent the acknowledgement or timeout of the query. Running out of gas still aborts the
+// transaction
+func (k Keeper) runCallback(ctx sdk.Context, query types.PendingQuery, callback func(ctx sdk.Context, callbacks types.QueryCallbacks) error) {
+	if k.callbacksRouter == nil || query.Module == "" {
+		return
+	}
+	callbacks, found := k.callbacksRouter.GetRoute(query.Module)
+	if !found {
+		return
+	}
+
+	if err := cachectx.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return callback(ctx, callbacks)
+	}); err != nil {
+		EmitCallbackErrorEvent(ctx, query, err)
+	}
+}
@@ -0,0 +1,332 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/controller"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var queryTimeout = uint64(time.Minute.Nanoseconds())
+
+func (suite *KeeperTestSuite) TestSendQuery() {
+	var (
+		path      *ibctesting.Path
+		module    string
+		channelID string
+		reqs      []abcitypes.RequestQuery
+		timeout   uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"module is empty",
+			func() {
+				module = ""
+			},
+			false,
+		},
+		{
+			"requests are empty",
+			func() {
+				reqs = nil
+			},
+			false,
+		},
+		{
+			"request path is empty",
+			func() {
+				reqs[0].Path = ""
+			},
+			false,
+		},
+		{
+			"timeout is 0",
+			func() {
+				timeout = 0
+			},
+			false,
+		},
+		{
+			"channel does not exist",
+			func() {
+				channelID = "channel-100"
+			},
+			false,
+		},
+		{
+			"channel is not open",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewControllerPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerPath(path)
+			suite.Require().NoError(err)
+
+			module = callbacksModule
+			channelID = path.EndpointA.ChannelID
+			reqs = suite.newAllBalancesRequests()
+			timeout = queryTimeout
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+			sequence, err := controllerKeeper.SendQuery(ctx, module, channelID, reqs, timeout)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+
+				query, found := controllerKeeper.GetPendingQuery(ctx, channelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(reqs, query.Requests)
+				suite.Require().Equal(uint64(ctx.BlockTime().UnixNano())+timeout, query.TimeoutTimestamp)
+				suite.Require().Equal(module, query.Module)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(controllerKeeper.GetAllPendingQueries(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendQueryFrom() {
+	suite.SetupTest()
+
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupControllerPath(path))
+
+	ctx := suite.chainA.GetContext()
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	sequence, err := controllerKeeper.SendQueryFrom(ctx, callbacksModule, sender, path.EndpointA.ChannelID, suite.newAllBalancesRequests(), queryTimeout)
+	suite.Require().NoError(err)
+
+	query, found := controllerKeeper.GetPendingQuery(ctx, path.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(sender.String(), query.Sender)
+
+	// queries sent by modules have no sender
+	sequence, err = controllerKeeper.SendQuery(ctx, callbacksModule, path.EndpointA.ChannelID, suite.newAllBalancesRequests(), queryTimeout)
+	suite.Require().NoError(err)
+
+	query, found = controllerKeeper.GetPendingQuery(ctx, path.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Empty(query.Sender)
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path     *ibctesting.Path
+		recorder *callbacksRecorder
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		check    func(ctx sdk.Context, sequence uint64)
+	}{
+		{
+			"host responds to the query",
+			func() {
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			},
+			func(_ sdk.Context, sequence uint64) {
+				suite.Require().Len(recorder.responses[sequence], 1)
+				suite.Require().Empty(recorder.errors)
+
+				var resp banktypes.QueryAllBalancesResponse
+				err := simapp.GetSimApp(suite.chainA).AppCodec().Unmarshal(recorder.responses[sequence][0].Value, &resp)
+				suite.Require().NoError(err)
+				suite.Require().False(resp.Balances.IsZero())
+			},
+		},
+		{
+			"host acknowledges the query with an error", // NOTE: do not update params to explicitly force the error
+			func() {},
+			func(_ sdk.Context, sequence uint64) {
+				suite.Require().Empty(recorder.responses)
+				suite.Require().Contains(recorder.errors, sequence)
+			},
+		},
+		{
+			"failing callback does not fail the acknowledgement",
+			func() {
+				recorder.fail = true
+			},
+			func(ctx sdk.Context, _ uint64) {
+				suite.Require().Empty(recorder.responses)
+				suite.Require().Empty(recorder.errors)
+
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeCallbackError {
+						found = true
+					}
+				}
+				suite.Require().True(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewControllerPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupControllerPath(path)
+			suite.Require().NoError(err)
+
+			recorder = newCallbacksRecorder()
+			tc.malleate() // malleate mutates test data
+
+			controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+			ibcModule := controller.NewIBCModule(*controllerKeeper.SetCallbacksRouter(types.NewQueryCallbacksRouter().AddRoute(callbacksModule, recorder)))
+
+			reqs := suite.newAllBalancesRequests()
+			sequence, err := controllerKeeper.SendQuery(suite.chainA.GetContext(), callbacksModule, path.EndpointA.ChannelID, reqs, queryTimeout)
+			suite.Require().NoError(err)
+
+			query, found := controllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, sequence)
+			suite.Require().True(found)
+
+			packet, ack := suite.relayQuery(path, query)
+
+			ctx := suite.chainA.GetContext()
+			err = ibcModule.OnAcknowledgementPacket(ctx, types.Version, packet, ack, nil)
+			suite.Require().NoError(err)
+
+			_, found = controllerKeeper.GetPendingQuery(ctx, path.EndpointA.ChannelID, sequence)
+			suite.Require().False(found)
+
+			tc.check(ctx, sequence)
+
+			// the query is no longer pending
+			err = ibcModule.OnAcknowledgementPacket(suite.chainA.GetContext(), types.Version, packet, ack, nil)
+			suite.Require().ErrorIs(err, types.ErrPendingQueryNotFound)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupControllerPath(path)
+	suite.Require().NoError(err)
+
+	recorder := newCallbacksRecorder()
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	ibcModule := controller.NewIBCModule(*controllerKeeper.SetCallbacksRouter(types.NewQueryCallbacksRouter().AddRoute(callbacksModule, recorder)))
+
+	sequence, err := controllerKeeper.SendQuery(suite.chainA.GetContext(), callbacksModule, path.EndpointA.ChannelID, suite.newAllBalancesRequests(), queryTimeout)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(
+		nil,
+		sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		0,
+	)
+
+	err = ibcModule.OnTimeoutPacket(suite.chainA.GetContext(), types.Version, packet, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{sequence}, recorder.timeouts)
+
+	_, found := controllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, sequence)
+	suite.Require().False(found)
+
+	err = ibcModule.OnTimeoutPacket(suite.chainA.GetContext(), types.Version, packet, nil)
+	suite.Require().ErrorIs(err, types.ErrPendingQueryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCallbacksRoutedToSendingModule() {
+	path := NewControllerPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupControllerPath(path))
+
+	recorder := newCallbacksRecorder()
+	otherRecorder := newCallbacksRecorder()
+	controllerKeeper := simapp.GetSimApp(suite.chainA).ICQControllerKeeper
+	router := types.NewQueryCallbacksRouter().
+		AddRoute(callbacksModule, recorder).
+		AddRoute("other", otherRecorder)
+	ibcModule := controller.NewIBCModule(*controllerKeeper.SetCallbacksRouter(router))
+
+	timeoutQuery := func(module string) uint64 {
+		ctx := suite.chainA.GetContext()
+		sequence, err := controllerKeeper.SendQuery(ctx, module, path.EndpointA.ChannelID, suite.newAllBalancesRequests(), queryTimeout)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(
+			nil,
+			sequence,
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			clienttypes.ZeroHeight(),
+			0,
+		)
+		suite.Require().NoError(ibcModule.OnTimeoutPacket(ctx, types.Version, packet, nil))
+		return sequence
+	}
+
+	// only the callbacks of the module which sent the query receive its result
+	sequence := timeoutQuery(callbacksModule)
+	suite.Require().Equal([]uint64{sequence}, recorder.timeouts)
+	suite.Require().Empty(otherRecorder.timeouts)
+
+	// the results of the queries of modules without callbacks are dropped
+	timeoutQuery("unrouted")
+	suite.Require().Equal([]uint64{sequence}, recorder.timeouts)
+	suite.Require().Empty(otherRecorder.timeouts)
+
+	// a module cannot register its callbacks twice
+	suite.Require().Panics(func() {
+		router.AddRoute(callbacksModule, newCallbacksRecorder())
+	})
+}
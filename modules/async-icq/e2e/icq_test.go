@@ -17,9 +17,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 
-	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
-	ibccore "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	ibccore "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 )
 
 func hostEncoding() *moduletestutil.TestEncodingConfig {
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ResolvesQueryPattern returns true if the allowlist entry matches at least one query path registered in the gRPC
+// query router. Deny entries are resolved like the entries allowing a path
+func (k Keeper) ResolvesQueryPattern(entry string) bool {
+	pattern := strings.TrimPrefix(entry, types.DenyQueryPrefix)
+	if !strings.HasSuffix(pattern, types.ServiceWildcardSuffix) && !strings.HasSuffix(pattern, types.PackageWildcardSuffix) {
+		return k.queryRouter.Route(pattern) != nil
+	}
+
+	// the router cannot list its routes, so the wildcards are matched against the methods of the registered services
+	resolves := false
+	proto.HybridResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				path := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())
+				if types.ContainsQueryPath([]string{pattern}, path) && k.queryRouter.Route(path) != nil {
+					resolves = true
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return resolves
+}
+
+// GetAllowedQueries returns the entries of the allow_queries param, with whether they resolve to registered query
+// paths
+func (k Keeper) GetAllowedQueries(ctx sdk.Context) []types.AllowedQuery {
+	allowQueries := k.GetAllowQueries(ctx)
+
+	allowed := make([]types.AllowedQuery, len(allowQueries))
+	for i, entry := range allowQueries {
+		allowed[i] = types.AllowedQuery{
+			Query:    entry,
+			Resolves: k.ResolvesQueryPattern(entry),
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+)
+
+func (suite *KeeperTestSuite) TestResolvesQueryPattern() {
+	suite.SetupTest()
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+
+	testCases := []struct {
+		entry    string
+		resolves bool
+	}{
+		{allBalancesPath, true},
+		{"!" + allBalancesPath, true},
+		{"/cosmos.bank.v1beta1.Query/*", true},
+		{"/cosmos.bank.*", true},
+		{"/icq.v1.Query/Params", true},
+		{"/cosmos.bank.v1beta1.Query/Unknown", false},
+		{"/cosmos.bank.v1beta1.Msg/Send", false},
+		{"/cosmos.unknown.v1beta1.Query/*", false},
+		{"/unknown.*", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.resolves, icqKeeper.ResolvesQueryPattern(tc.entry), tc.entry)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAllowedQueries() {
+	suite.SetupTest()
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	msgServer := keeper.NewMsgServerImpl(icqKeeper)
+	authority := icqKeeper.GetAuthority()
+	ctx := suite.chainB.GetContext()
+
+	params := types.NewParams(true, []string{allBalancesPath})
+	params.MaxRequestsPerPacket = 5
+	suite.Require().NoError(icqKeeper.SetParams(ctx, params))
+
+	_, err := msgServer.AddAllowedQueries(ctx, &types.MsgAddAllowedQueries{
+		Authority:    suite.chainB.SenderAccount.GetAddress().String(),
+		AllowQueries: []string{stakingParamsPath},
+	})
+	suite.Require().Error(err, "invalid authority")
+
+	_, err = msgServer.AddAllowedQueries(ctx, &types.MsgAddAllowedQueries{
+		Authority:    authority,
+		AllowQueries: []string{stakingParamsPath, "/cosmos.bank.v1beta1.Query/Unknown"},
+	})
+	suite.Require().ErrorIs(err, types.ErrQueryNotRegistered)
+	suite.Require().Equal([]string{allBalancesPath}, icqKeeper.GetAllowQueries(ctx), "no entry is added if one does not resolve")
+
+	_, err = msgServer.AddAllowedQueries(ctx, &types.MsgAddAllowedQueries{
+		Authority:    authority,
+		AllowQueries: []string{stakingParamsPath, stakingParamsPath},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidQuery, "duplicate entries")
+
+	_, err = msgServer.AddAllowedQueries(ctx, &types.MsgAddAllowedQueries{
+		Authority:    authority,
+		AllowQueries: []string{allBalancesPath, stakingParamsPath, "!/cosmos.bank.v1beta1.Query/DenomOwners"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{allBalancesPath, stakingParamsPath, "!/cosmos.bank.v1beta1.Query/DenomOwners"}, icqKeeper.GetAllowQueries(ctx))
+	suite.Require().Equal(uint64(5), icqKeeper.GetParams(ctx).MaxRequestsPerPacket, "the other params are kept")
+
+	_, err = msgServer.RemoveAllowedQueries(ctx, &types.MsgRemoveAllowedQueries{
+		Authority:    authority,
+		AllowQueries: []string{allBalancesPath, "/cosmos.bank.v1beta1.Query/Balance"},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidQuery, "entry not in the allowlist")
+
+	_, err = msgServer.RemoveAllowedQueries(ctx, &types.MsgRemoveAllowedQueries{
+		Authority:    authority,
+		AllowQueries: []string{allBalancesPath, "!/cosmos.bank.v1beta1.Query/DenomOwners"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{stakingParamsPath}, icqKeeper.GetAllowQueries(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowedQueries() {
+	suite.SetupTest()
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	ctx := suite.chainB.GetContext()
+
+	// entries set with the params are not checked, and stop resolving when a module is removed from the host
+	params := types.NewParams(true, []string{allBalancesPath, "/cosmos.removed.v1.Query/*"})
+	suite.Require().NoError(icqKeeper.SetParams(ctx, params))
+
+	res, err := icqKeeper.AllowedQueries(ctx, &types.QueryAllowedQueriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AllowedQuery{
+		{Query: allBalancesPath, Resolves: true},
+		{Query: "/cosmos.removed.v1.Query/*", Resolves: false},
+	}, res.AllowedQueries)
+
+	// entries which do not resolve can still be removed
+	_, err = keeper.NewMsgServerImpl(icqKeeper).RemoveAllowedQueries(ctx, &types.MsgRemoveAllowedQueries{
+		Authority:    icqKeeper.GetAuthority(),
+		AllowQueries: []string{"/cosmos.removed.v1.Query/*"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{allBalancesPath}, icqKeeper.GetAllowQueries(ctx))
+}
@@ -1,11 +1,11 @@
 package keeper
 
 import (
-	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 // EmitWriteErrorAcknowledgementEvent emits an event signalling an error acknowledgement and including the error details
@@ -19,3 +19,14 @@ func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI
 		),
 	)
 }
+
+// EmitWriteSuccessAcknowledgementEvent emits an event signalling a successful acknowledgement of the queries
+func EmitWriteSuccessAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypePacketSuccess,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ModuleName),
+			sdk.NewAttribute(icqtypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+		),
+	)
+}
@@ -3,35 +3,46 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InitGenesis initializes the icq state and binds to PortID.
+// InitGenesis initializes the icq state, including the host port.
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	k.SetPort(ctx, state.HostPort)
 
-	// Only try to bind to port if it is not already bound, since we may already own
-	// port capability from capability InitGenesis
-	if !k.IsBound(ctx, state.HostPort) {
-		// transfer module binds to the transfer port on InitChain
-		// and claims the returned capability
-		err := k.BindPort(ctx, state.HostPort)
-		if err != nil {
-			panic(fmt.Sprintf("could not claim port capability: %v", err))
-		}
-	}
-
 	if err := k.SetParams(ctx, state.Params); err != nil {
 		panic(fmt.Sprintf("could not set params: %v", err))
 	}
+
+	for _, permissions := range state.ChannelPermissions {
+		k.SetChannelQueryPermissions(ctx, permissions.ChannelId, permissions.Permissions)
+	}
+	for _, permissions := range state.ClientPermissions {
+		k.SetClientQueryPermissions(ctx, permissions.ClientId, permissions.Permissions)
+	}
+
+	for _, quota := range state.ChannelQuotas {
+		k.SetChannelQueryQuota(ctx, quota.ChannelId, quota.Quota)
+	}
+	for _, stats := range state.ChannelStats {
+		k.SetChannelQueryStats(ctx, stats)
+	}
+	for _, stats := range state.SharedStats {
+		k.SetSharedQueryStats(ctx, stats)
+	}
 }
 
-// ExportGenesis exports icq module's portID and denom trace info into its genesis state.
+// ExportGenesis exports icq module's portID, params, query permissions, query quotas and query accounting into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		HostPort: k.GetPort(ctx),
-		Params:   k.GetParams(ctx),
+		HostPort:           k.GetPort(ctx),
+		Params:             k.GetParams(ctx),
+		ChannelPermissions: k.GetAllChannelQueryPermissions(ctx),
+		ClientPermissions:  k.GetAllClientQueryPermissions(ctx),
+		ChannelQuotas:      k.GetAllChannelQueryQuotas(ctx),
+		ChannelStats:       k.GetAllChannelQueryStats(ctx),
+		SharedStats:        k.GetAllSharedQueryStats(ctx),
 	}
 }
@@ -1,8 +1,8 @@
 package keeper_test
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 )
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
@@ -35,6 +35,47 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(expParams, params)
 }
 
+func (suite *KeeperTestSuite) TestGenesisQueryPermissions() {
+	suite.SetupTest()
+
+	genesisState := *types.DefaultGenesis()
+	genesisState.ChannelPermissions = []types.ChannelQueryPermissions{
+		{ChannelId: "channel-0", Permissions: types.NewQueryPermissions([]string{"/cosmos.bank.v1beta1.Query/*"}, nil)},
+	}
+	genesisState.ClientPermissions = []types.ClientQueryPermissions{
+		{ClientId: "07-tendermint-0", Permissions: types.NewQueryPermissions([]string{"/cosmos.staking.*"}, []string{"staking"})},
+	}
+
+	simapp.GetSimApp(suite.chainA).ICQKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
+
+	exported := simapp.GetSimApp(suite.chainA).ICQKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState.ChannelPermissions, exported.ChannelPermissions)
+	suite.Require().Equal(genesisState.ClientPermissions, exported.ClientPermissions)
+}
+
+func (suite *KeeperTestSuite) TestGenesisQueryQuotas() {
+	suite.SetupTest()
+
+	genesisState := *types.DefaultGenesis()
+	genesisState.ChannelQuotas = []types.ChannelQueryQuota{
+		{ChannelId: "channel-0", Quota: types.NewQueryQuota(10, 1_000_000)},
+	}
+	genesisState.ChannelStats = []types.ChannelQueryStats{
+		{ChannelId: "channel-0", Epoch: 1, QueriesServed: 3, GasConsumed: 50_000},
+		{ChannelId: "channel-0", Epoch: 2, QueriesServed: 1, GasConsumed: 10_000},
+	}
+	genesisState.SharedStats = []types.SharedQueryStats{
+		{Epoch: 2, QueriesServed: 5, GasConsumed: 80_000},
+	}
+
+	simapp.GetSimApp(suite.chainA).ICQKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
+
+	exported := simapp.GetSimApp(suite.chainA).ICQKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState.ChannelQuotas, exported.ChannelQuotas)
+	suite.Require().Equal(genesisState.ChannelStats, exported.ChannelStats)
+	suite.Require().Equal(genesisState.SharedStats, exported.SharedStats)
+}
+
 func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.SetupTest()
 
@@ -3,9 +3,13 @@ package keeper
 import (
 	"context"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +23,79 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// Permissions implements the Query/Permissions gRPC method
+func (q Keeper) Permissions(c context.Context, _ *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPermissionsResponse{
+		ChannelPermissions: q.GetAllChannelQueryPermissions(ctx),
+		ClientPermissions:  q.GetAllClientQueryPermissions(ctx),
+	}, nil
+}
+
+// Quotas implements the Query/Quotas gRPC method
+func (q Keeper) Quotas(c context.Context, _ *types.QueryQuotasRequest) (*types.QueryQuotasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryQuotasResponse{
+		ChannelQuotas: q.GetAllChannelQueryQuotas(ctx),
+		SharedStats:   q.getCurrentSharedQueryStats(ctx, q.GetParams(ctx)),
+	}, nil
+}
+
+// ChannelStats implements the Query/ChannelStats gRPC method
+func (q Keeper) ChannelStats(c context.Context, req *types.QueryChannelStatsRequest) (*types.QueryChannelStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	res := &types.QueryChannelStatsResponse{
+		Epoch: params.Epoch(ctx.BlockHeight()),
+		Stats: q.getCurrentChannelQueryStats(ctx, params, req.ChannelId),
+	}
+	if res.Epoch > 0 {
+		if stats, found := q.GetChannelQueryStats(ctx, req.ChannelId, res.Epoch-1); found {
+			res.PreviousStats = &stats
+		}
+	}
+	if quota, found := q.GetChannelQueryQuota(ctx, req.ChannelId); found {
+		res.Quota = &quota
+	}
+
+	return res, nil
+}
+
+// AllChannelStats implements the Query/AllChannelStats gRPC method
+func (q Keeper) AllChannelStats(c context.Context, _ *types.QueryAllChannelStatsRequest) (*types.QueryAllChannelStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch := q.GetCurrentEpoch(ctx)
+
+	// the accounting of the channels which received no query since the previous epoch is still in state
+	var channelStats []types.ChannelQueryStats
+	for _, stats := range q.GetAllChannelQueryStats(ctx) {
+		if stats.Epoch+1 >= epoch {
+			channelStats = append(channelStats, stats)
+		}
+	}
+
+	return &types.QueryAllChannelStatsResponse{
+		Epoch:        epoch,
+		ChannelStats: channelStats,
+	}, nil
+}
+
+// AllowedQueries implements the Query/AllowedQueries gRPC method
+func (q Keeper) AllowedQueries(c context.Context, _ *types.QueryAllowedQueriesRequest) (*types.QueryAllowedQueriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllowedQueriesResponse{
+		AllowedQueries: q.GetAllowedQueries(ctx),
+	}, nil
+}
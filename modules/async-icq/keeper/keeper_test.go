@@ -3,12 +3,12 @@ package keeper_test
 import (
 	"testing"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/stretchr/testify/suite"
 
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 var TestPort = "icq-test"
@@ -66,7 +66,7 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
-func (suite *KeeperTestSuite) TestIsBound() {
+func (suite *KeeperTestSuite) TestGetPort() {
 	suite.SetupTest()
 
 	path := NewICQPath(suite.chainA, suite.chainB)
@@ -75,6 +75,6 @@ func (suite *KeeperTestSuite) TestIsBound() {
 	err := SetupICQPath(path)
 	suite.Require().NoError(err)
 
-	isBound := simapp.GetSimApp(suite.chainB).ICQKeeper.IsBound(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
-	suite.Require().True(isBound)
+	port := simapp.GetSimApp(suite.chainB).ICQKeeper.GetPort(suite.chainB.GetContext())
+	suite.Require().Equal(path.EndpointB.ChannelConfig.PortID, port)
 }
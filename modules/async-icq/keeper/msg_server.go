@@ -2,8 +2,9 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/errors"
 
@@ -35,3 +36,130 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) SetQueryPermissions(goCtx context.Context, req *types.MsgSetQueryPermissions) (*types.MsgSetQueryPermissionsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.ChannelId != "" {
+		ms.SetChannelQueryPermissions(ctx, req.ChannelId, req.Permissions)
+	} else {
+		ms.SetClientQueryPermissions(ctx, req.ClientId, req.Permissions)
+	}
+
+	return &types.MsgSetQueryPermissionsResponse{}, nil
+}
+
+func (ms msgServer) DeleteQueryPermissions(goCtx context.Context, req *types.MsgDeleteQueryPermissions) (*types.MsgDeleteQueryPermissionsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.ChannelId != "" {
+		if _, found := ms.GetChannelQueryPermissions(ctx, req.ChannelId); !found {
+			return nil, errors.Wrapf(types.ErrInvalidPermissions, "no query permissions for channel %s", req.ChannelId)
+		}
+		ms.DeleteChannelQueryPermissions(ctx, req.ChannelId)
+	} else {
+		if _, found := ms.GetClientQueryPermissions(ctx, req.ClientId); !found {
+			return nil, errors.Wrapf(types.ErrInvalidPermissions, "no query permissions for client %s", req.ClientId)
+		}
+		ms.DeleteClientQueryPermissions(ctx, req.ClientId)
+	}
+
+	return &types.MsgDeleteQueryPermissionsResponse{}, nil
+}
+
+func (ms msgServer) SetQueryQuota(goCtx context.Context, req *types.MsgSetQueryQuota) (*types.MsgSetQueryQuotaResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.SetChannelQueryQuota(ctx, req.ChannelId, req.Quota)
+
+	return &types.MsgSetQueryQuotaResponse{}, nil
+}
+
+func (ms msgServer) DeleteQueryQuota(goCtx context.Context, req *types.MsgDeleteQueryQuota) (*types.MsgDeleteQueryQuotaResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := ms.GetChannelQueryQuota(ctx, req.ChannelId); !found {
+		return nil, errors.Wrapf(types.ErrInvalidQuota, "no query quota for channel %s", req.ChannelId)
+	}
+	ms.DeleteChannelQueryQuota(ctx, req.ChannelId)
+
+	return &types.MsgDeleteQueryQuotaResponse{}, nil
+}
+
+func (ms msgServer) AddAllowedQueries(goCtx context.Context, req *types.MsgAddAllowedQueries) (*types.MsgAddAllowedQueriesResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range req.AllowQueries {
+		if !ms.ResolvesQueryPattern(entry) {
+			return nil, errors.Wrapf(types.ErrQueryNotRegistered, "%s does not match any query path of the host", entry)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+	for _, entry := range req.AllowQueries {
+		if !slices.Contains(params.AllowQueries, entry) {
+			params.AllowQueries = append(params.AllowQueries, entry)
+		}
+	}
+	if err := ms.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAllowedQueriesResponse{}, nil
+}
+
+func (ms msgServer) RemoveAllowedQueries(goCtx context.Context, req *types.MsgRemoveAllowedQueries) (*types.MsgRemoveAllowedQueriesResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+	for _, entry := range req.AllowQueries {
+		if !slices.Contains(params.AllowQueries, entry) {
+			return nil, errors.Wrapf(types.ErrInvalidQuery, "%s is not in the allow queries", entry)
+		}
+	}
+
+	params.AllowQueries = slices.DeleteFunc(params.AllowQueries, func(entry string) bool {
+		return slices.Contains(req.AllowQueries, entry)
+	})
+	if err := ms.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAllowedQueriesResponse{}, nil
+}
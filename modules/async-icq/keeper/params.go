@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,6 +17,11 @@ func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
 	return k.GetParams(ctx).AllowQueries
 }
 
+// GetAllowStoreQueries retrieves the names of the stores that can be queried with proofs from the params
+func (k Keeper) GetAllowStoreQueries(ctx sdk.Context) []string {
+	return k.GetParams(ctx).AllowStoreQueries
+}
+
 // SetParams sets the module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 	if err := p.Validate(); err != nil {
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetChannelQueryPermissions sets the queries allowed on the host channel
+func (k Keeper) SetChannelQueryPermissions(ctx sdk.Context, channelID string, permissions types.QueryPermissions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelQueryPermissionsKey(channelID), k.cdc.MustMarshal(&permissions))
+}
+
+// GetChannelQueryPermissions returns the queries allowed on the host channel, if they are set
+func (k Keeper) GetChannelQueryPermissions(ctx sdk.Context, channelID string) (types.QueryPermissions, bool) {
+	return k.getQueryPermissions(ctx, types.ChannelQueryPermissionsKey(channelID))
+}
+
+// DeleteChannelQueryPermissions removes the query permissions of the host channel
+func (k Keeper) DeleteChannelQueryPermissions(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelQueryPermissionsKey(channelID))
+}
+
+// GetAllChannelQueryPermissions returns the query permissions of all host channels
+func (k Keeper) GetAllChannelQueryPermissions(ctx sdk.Context) []types.ChannelQueryPermissions {
+	var all []types.ChannelQueryPermissions
+	k.iterateQueryPermissions(ctx, types.ChannelQueryPermissionsKeyPrefix, func(channelID string, permissions types.QueryPermissions) {
+		all = append(all, types.ChannelQueryPermissions{ChannelId: channelID, Permissions: permissions})
+	})
+	return all
+}
+
+// SetClientQueryPermissions sets the queries allowed to the counterparty light client
+func (k Keeper) SetClientQueryPermissions(ctx sdk.Context, clientID string, permissions types.QueryPermissions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientQueryPermissionsKey(clientID), k.cdc.MustMarshal(&permissions))
+}
+
+// GetClientQueryPermissions returns the queries allowed to the counterparty light client, if they are set
+func (k Keeper) GetClientQueryPermissions(ctx sdk.Context, clientID string) (types.QueryPermissions, bool) {
+	return k.getQueryPermissions(ctx, types.ClientQueryPermissionsKey(clientID))
+}
+
+// DeleteClientQueryPermissions removes the query permissions of the counterparty light client
+func (k Keeper) DeleteClientQueryPermissions(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientQueryPermissionsKey(clientID))
+}
+
+// GetAllClientQueryPermissions returns the query permissions of all counterparty light clients
+func (k Keeper) GetAllClientQueryPermissions(ctx sdk.Context) []types.ClientQueryPermissions {
+	var all []types.ClientQueryPermissions
+	k.iterateQueryPermissions(ctx, types.ClientQueryPermissionsKeyPrefix, func(clientID string, permissions types.QueryPermissions) {
+		all = append(all, types.ClientQueryPermissions{ClientId: clientID, Permissions: permissions})
+	})
+	return all
+}
+
+// GetQueryPermissions returns the queries allowed on the host channel: the permissions of the channel if they are
+// set, otherwise the permissions of the light client of the channel if they are set, otherwise the allowlists of the
+// params. Packets received over IBC v2 are identified by the client ID of the counterparty chain instead of a channel
+// ID, so it is used in place of the channel ID for them.
+// The client is looked up by ID, which the authority binds the permissions to: the chain ID of a client is not used,
+// as anyone can create another client with the same chain ID
+func (k Keeper) GetQueryPermissions(ctx sdk.Context, params types.Params, portID, channelID string) types.QueryPermissions {
+	if permissions, found := k.GetChannelQueryPermissions(ctx, channelID); found {
+		return permissions
+	}
+
+	if clientID, found := k.getCounterpartyClientID(ctx, portID, channelID); found {
+		if permissions, found := k.GetClientQueryPermissions(ctx, clientID); found {
+			return permissions
+		}
+	}
+
+	return params.QueryPermissions()
+}
+
+// getCounterpartyClientID returns the ID of the client of the channel, or the channel ID itself if it is the ID of
+// an IBC v2 client
+func (k Keeper) getCounterpartyClientID(ctx sdk.Context, portID, channelID string) (string, bool) {
+	if clientID, _, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID); err == nil {
+		return clientID, true
+	}
+	if _, found := k.clientKeeper.GetClientState(ctx, channelID); found {
+		return channelID, true
+	}
+	return "", false
+}
+
+func (k Keeper) getQueryPermissions(ctx sdk.Context, key []byte) (types.QueryPermissions, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.QueryPermissions{}, false
+	}
+
+	var permissions types.QueryPermissions
+	k.cdc.MustUnmarshal(bz, &permissions)
+	return permissions, true
+}
+
+func (k Keeper) iterateQueryPermissions(ctx sdk.Context, keyPrefix []byte, cb func(id string, permissions types.QueryPermissions)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var permissions types.QueryPermissions
+		k.cdc.MustUnmarshal(iterator.Value(), &permissions)
+		cb(string(iterator.Key()), permissions)
+	}
+}
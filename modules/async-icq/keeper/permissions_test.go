@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const (
+	allBalancesPath   = "/cosmos.bank.v1beta1.Query/AllBalances"
+	stakingParamsPath = "/cosmos.staking.v1beta1.Query/Params"
+)
+
+// newQueryPacket returns a packet of the path querying the query path on chainB
+func (suite *KeeperTestSuite) newQueryPacket(path *ibctesting.Path, queryPath string, sequence uint64) channeltypes.Packet {
+	cdc := simapp.GetSimApp(suite.chainB).AppCodec()
+
+	req := abcitypes.RequestQuery{Path: queryPath}
+	switch queryPath {
+	case allBalancesPath:
+		req.Data = cdc.MustMarshal(&banktypes.QueryAllBalancesRequest{Address: suite.chainB.SenderAccount.GetAddress().String()})
+	case stakingParamsPath:
+		req.Data = cdc.MustMarshal(&stakingtypes.QueryParamsRequest{})
+	}
+
+	data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{req})
+	suite.Require().NoError(err)
+	icqPacketData := types.InterchainQueryPacketData{
+		Data: data,
+	}
+	return channeltypes.NewPacket(
+		icqPacketData.GetBytes(),
+		sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+}
+
+// recvQuery receives on chainB a packet of the path querying the query path
+func (suite *KeeperTestSuite) recvQuery(path *ibctesting.Path, queryPath string) error {
+	_, err := simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(suite.chainB.GetContext(), suite.newQueryPacket(path, queryPath, 1))
+	return err
+}
+
+func (suite *KeeperTestSuite) TestQueryPermissions() {
+	var pathAB, pathCB *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// expected results of the bank and staking queries from chainA and chainC
+		expA, expC [2]bool
+	}{
+		{
+			"params apply without permissions",
+			func() {},
+			[2]bool{true, false},
+			[2]bool{true, false},
+		},
+		{
+			"client permissions replace the params for the channels of the client",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetClientQueryPermissions(suite.chainB.GetContext(), pathAB.EndpointB.ClientID, types.NewQueryPermissions([]string{"/cosmos.staking.v1beta1.Query/*"}, nil))
+			},
+			[2]bool{false, true},
+			[2]bool{true, false},
+		},
+		{
+			"channel permissions replace the client permissions",
+			func() {
+				ctx := suite.chainB.GetContext()
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetClientQueryPermissions(ctx, pathAB.EndpointB.ClientID, types.NewQueryPermissions([]string{"/cosmos.staking.v1beta1.Query/*"}, nil))
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryPermissions(ctx, pathAB.EndpointB.ChannelID, types.NewQueryPermissions([]string{"/cosmos.*", "!/cosmos.staking.*"}, nil))
+			},
+			[2]bool{true, false},
+			[2]bool{true, false},
+		},
+		{
+			"empty channel permissions deny every query",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryPermissions(suite.chainB.GetContext(), pathCB.EndpointB.ChannelID, types.NewQueryPermissions(nil, nil))
+			},
+			[2]bool{true, false},
+			[2]bool{false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			pathAB = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(pathAB)
+			suite.Require().NoError(SetupICQPath(pathAB))
+
+			pathCB = NewICQPath(suite.chainC, suite.chainB)
+			suite.coordinator.SetupConnections(pathCB)
+			suite.Require().NoError(SetupICQPath(pathCB))
+
+			params := types.NewParams(true, []string{allBalancesPath})
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			tc.malleate() // malleate mutates test data
+
+			for i, queryPath := range []string{allBalancesPath, stakingParamsPath} {
+				suite.Require().Equal(tc.expA[i], suite.recvQuery(pathAB, queryPath) == nil, "chainA %s", queryPath)
+				suite.Require().Equal(tc.expC[i], suite.recvQuery(pathCB, queryPath) == nil, "chainC %s", queryPath)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClientQueryPermissionsIgnoreChainID() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	// a second client of chainB tracks a chain with the same chain ID as chainA
+	spoofPath := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(spoofPath)
+	suite.Require().NoError(SetupICQPath(spoofPath))
+	suite.Require().NotEqual(path.EndpointB.ClientID, spoofPath.EndpointB.ClientID)
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	ctx := suite.chainB.GetContext()
+	suite.Require().NoError(icqKeeper.SetParams(ctx, types.NewParams(true, []string{allBalancesPath})))
+	icqKeeper.SetClientQueryPermissions(ctx, path.EndpointB.ClientID, types.NewQueryPermissions([]string{"/cosmos.*"}, nil))
+
+	suite.Require().NoError(suite.recvQuery(path, stakingParamsPath))
+
+	// only the allowlists of the params apply to the channels of the second client
+	suite.Require().NoError(suite.recvQuery(spoofPath, allBalancesPath))
+	suite.Require().Error(suite.recvQuery(spoofPath, stakingParamsPath))
+}
+
+func (suite *KeeperTestSuite) TestMsgQueryPermissions() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	msgServer := keeper.NewMsgServerImpl(icqKeeper)
+	authority := icqKeeper.GetAuthority()
+	permissions := types.NewQueryPermissions([]string{stakingParamsPath}, []string{banktypes.StoreKey})
+	ctx := suite.chainB.GetContext()
+
+	_, err := msgServer.SetQueryPermissions(ctx, &types.MsgSetQueryPermissions{
+		Authority:   suite.chainB.SenderAccount.GetAddress().String(),
+		ChannelId:   path.EndpointB.ChannelID,
+		Permissions: permissions,
+	})
+	suite.Require().Error(err, "invalid authority")
+
+	_, err = msgServer.SetQueryPermissions(ctx, &types.MsgSetQueryPermissions{
+		Authority:   authority,
+		ChannelId:   path.EndpointB.ChannelID,
+		ClientId:    path.EndpointB.ClientID,
+		Permissions: permissions,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidPermissions, "channel and client IDs are set")
+
+	_, err = msgServer.SetQueryPermissions(ctx, &types.MsgSetQueryPermissions{
+		Authority:   authority,
+		ChannelId:   path.EndpointB.ChannelID,
+		Permissions: permissions,
+	})
+	suite.Require().NoError(err)
+	_, err = msgServer.SetQueryPermissions(ctx, &types.MsgSetQueryPermissions{
+		Authority:   authority,
+		ClientId:    path.EndpointB.ClientID,
+		Permissions: permissions,
+	})
+	suite.Require().NoError(err)
+
+	res, err := icqKeeper.Permissions(ctx, &types.QueryPermissionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ChannelQueryPermissions{{ChannelId: path.EndpointB.ChannelID, Permissions: permissions}}, res.ChannelPermissions)
+	suite.Require().Equal([]types.ClientQueryPermissions{{ClientId: path.EndpointB.ClientID, Permissions: permissions}}, res.ClientPermissions)
+
+	_, err = msgServer.DeleteQueryPermissions(ctx, &types.MsgDeleteQueryPermissions{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().NoError(err)
+	_, found := icqKeeper.GetChannelQueryPermissions(ctx, path.EndpointB.ChannelID)
+	suite.Require().False(found)
+
+	_, err = msgServer.DeleteQueryPermissions(ctx, &types.MsgDeleteQueryPermissions{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidPermissions, "no permissions to delete")
+
+	// the permissions of the client apply once the channel permissions are deleted
+	suite.Require().Equal(permissions, icqKeeper.GetQueryPermissions(ctx, icqKeeper.GetParams(ctx), types.PortID, path.EndpointB.ChannelID))
+}
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetChannelQueryQuota sets the query quota of the host channel
+func (k Keeper) SetChannelQueryQuota(ctx sdk.Context, channelID string, quota types.QueryQuota) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelQueryQuotaKey(channelID), k.cdc.MustMarshal(&quota))
+}
+
+// GetChannelQueryQuota returns the query quota of the host channel, if it is set
+func (k Keeper) GetChannelQueryQuota(ctx sdk.Context, channelID string) (types.QueryQuota, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelQueryQuotaKey(channelID))
+	if bz == nil {
+		return types.QueryQuota{}, false
+	}
+
+	var quota types.QueryQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+	return quota, true
+}
+
+// DeleteChannelQueryQuota removes the query quota of the host channel
+func (k Keeper) DeleteChannelQueryQuota(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelQueryQuotaKey(channelID))
+}
+
+// GetAllChannelQueryQuotas returns the query quotas of all host channels
+func (k Keeper) GetAllChannelQueryQuotas(ctx sdk.Context) []types.ChannelQueryQuota {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelQueryQuotaKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var all []types.ChannelQueryQuota
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.QueryQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &quota)
+		all = append(all, types.ChannelQueryQuota{ChannelId: string(iterator.Key()), Quota: quota})
+	}
+	return all
+}
+
+// SetChannelQueryStats sets the accounting of the queries received on the host channel in the epoch of the stats
+func (k Keeper) SetChannelQueryStats(ctx sdk.Context, stats types.ChannelQueryStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelQueryStatsKey(stats.ChannelId, stats.Epoch), k.cdc.MustMarshal(&stats))
+}
+
+// GetChannelQueryStats returns the accounting of the queries received on the host channel in the epoch, if queries
+// were received in it
+func (k Keeper) GetChannelQueryStats(ctx sdk.Context, channelID string, epoch uint64) (types.ChannelQueryStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelQueryStatsKey(channelID, epoch))
+	if bz == nil {
+		return types.ChannelQueryStats{}, false
+	}
+
+	var stats types.ChannelQueryStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// GetAllChannelQueryStats returns the accounting of the queries received on all host channels, in the epochs which
+// are kept in state
+func (k Keeper) GetAllChannelQueryStats(ctx sdk.Context) []types.ChannelQueryStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelQueryStatsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var all []types.ChannelQueryStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ChannelQueryStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		all = append(all, stats)
+	}
+	return all
+}
+
+// SetSharedQueryStats sets the accounting of the queries received on the host channels without a query quota in the
+// epoch of the stats
+func (k Keeper) SetSharedQueryStats(ctx sdk.Context, stats types.SharedQueryStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SharedQueryStatsKey(stats.Epoch), k.cdc.MustMarshal(&stats))
+}
+
+// GetSharedQueryStats returns the accounting of the queries received on the host channels without a query quota in
+// the epoch, if queries were received in it
+func (k Keeper) GetSharedQueryStats(ctx sdk.Context, epoch uint64) (types.SharedQueryStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SharedQueryStatsKey(epoch))
+	if bz == nil {
+		return types.SharedQueryStats{}, false
+	}
+
+	var stats types.SharedQueryStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// GetAllSharedQueryStats returns the accounting of the queries received on the host channels without a query quota,
+// in the epochs which are kept in state
+func (k Keeper) GetAllSharedQueryStats(ctx sdk.Context) []types.SharedQueryStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SharedQueryStatsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var all []types.SharedQueryStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.SharedQueryStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		all = append(all, stats)
+	}
+	return all
+}
+
+// GetCurrentEpoch returns the accounting epoch of the current block
+func (k Keeper) GetCurrentEpoch(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).Epoch(ctx.BlockHeight())
+}
+
+// getCurrentChannelQueryStats returns the accounting of the queries received on the host channel in the current
+// epoch, which is empty if no query was received in it yet
+func (k Keeper) getCurrentChannelQueryStats(ctx sdk.Context, params types.Params, channelID string) types.ChannelQueryStats {
+	epoch := params.Epoch(ctx.BlockHeight())
+	if stats, found := k.GetChannelQueryStats(ctx, channelID, epoch); found {
+		return stats
+	}
+	return types.ChannelQueryStats{ChannelId: channelID, Epoch: epoch}
+}
+
+// getCurrentSharedQueryStats returns the accounting of the queries received on the host channels without a query
+// quota in the current epoch, which is empty if no query was received in it yet
+func (k Keeper) getCurrentSharedQueryStats(ctx sdk.Context, params types.Params) types.SharedQueryStats {
+	epoch := params.Epoch(ctx.BlockHeight())
+	if stats, found := k.GetSharedQueryStats(ctx, epoch); found {
+		return stats
+	}
+	return types.SharedQueryStats{Epoch: epoch}
+}
+
+// recordQuery adds a served query packet and the gas it consumed to the accounting of its epoch. The accounting of
+// the channel is kept for the current and the previous epochs, the older epochs are removed when a new epoch starts
+func (k Keeper) recordQuery(ctx sdk.Context, stats types.ChannelQueryStats, gasConsumed uint64) {
+	if stats.QueriesServed == 0 && stats.Epoch > 0 {
+		k.pruneChannelQueryStats(ctx, stats.ChannelId, stats.Epoch-1)
+	}
+
+	stats.QueriesServed++
+	stats.GasConsumed += gasConsumed
+
+	k.SetChannelQueryStats(ctx, stats)
+}
+
+// recordSharedQuery adds a served query packet of a channel without a query quota and the gas it consumed to the
+// shared accounting of its epoch, which is kept for the current and the previous epochs like the channel accounting
+func (k Keeper) recordSharedQuery(ctx sdk.Context, stats types.SharedQueryStats, gasConsumed uint64) {
+	if stats.QueriesServed == 0 && stats.Epoch > 0 {
+		k.pruneSharedQueryStats(ctx, stats.Epoch-1)
+	}
+
+	stats.QueriesServed++
+	stats.GasConsumed += gasConsumed
+
+	k.SetSharedQueryStats(ctx, stats)
+}
+
+// pruneChannelQueryStats removes the accounting of the queries received on the host channel before the epoch
+func (k Keeper) pruneChannelQueryStats(ctx sdk.Context, channelID string, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelQueryStatsChannelPrefix(channelID))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(epoch))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// pruneSharedQueryStats removes the accounting of the queries received on the host channels without a query quota
+// before the epoch
+func (k Keeper) pruneSharedQueryStats(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SharedQueryStatsKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(epoch))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryQuotas() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// expected results of the queries received in an epoch
+		expPass []bool
+	}{
+		{
+			"no quota",
+			func() {},
+			[]bool{true, true, true},
+		},
+		{
+			"max queries reached",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryQuota(suite.chainB.GetContext(), path.EndpointB.ChannelID, types.NewQueryQuota(2, 0))
+			},
+			[]bool{true, true, false},
+		},
+		{
+			"max gas reached",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryQuota(suite.chainB.GetContext(), path.EndpointB.ChannelID, types.NewQueryQuota(0, 1))
+			},
+			[]bool{true, false, false},
+		},
+		{
+			"shared quota reached by a channel without quota",
+			func() {
+				params := types.NewParams(true, []string{allBalancesPath})
+				params.EpochBlocks = 100
+				params.SharedQuota = types.NewQueryQuota(2, 0)
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			},
+			[]bool{true, true, false},
+		},
+		{
+			"channel quota replaces the shared quota",
+			func() {
+				params := types.NewParams(true, []string{allBalancesPath})
+				params.EpochBlocks = 100
+				params.SharedQuota = types.NewQueryQuota(1, 0)
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryQuota(suite.chainB.GetContext(), path.EndpointB.ChannelID, types.NewQueryQuota(3, 0))
+			},
+			[]bool{true, true, true},
+		},
+		{
+			"quota of another channel",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryQuota(suite.chainB.GetContext(), ibctesting.FirstChannelID+"1", types.NewQueryQuota(1, 0))
+			},
+			[]bool{true, true, true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			suite.Require().NoError(SetupICQPath(path))
+
+			params := types.NewParams(true, []string{allBalancesPath})
+			params.EpochBlocks = 100
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			tc.malleate() // malleate mutates test data
+
+			var served uint64
+			for i, expPass := range tc.expPass {
+				err := suite.recvQuery(path, allBalancesPath)
+				suite.Require().Equal(expPass, err == nil, "query %d: %v", i, err)
+				if expPass {
+					served++
+				}
+			}
+
+			ctx := suite.chainB.GetContext()
+			icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+			stats, found := icqKeeper.GetChannelQueryStats(ctx, path.EndpointB.ChannelID, icqKeeper.GetCurrentEpoch(ctx))
+			suite.Require().True(found)
+			suite.Require().Equal(served, stats.QueriesServed)
+			suite.Require().NotZero(stats.GasConsumed)
+
+			// the quota applies to the queries of an epoch
+			nextEpochCtx := ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.EpochBlocks))
+			_, err := icqKeeper.OnRecvPacket(nextEpochCtx, suite.newQueryPacket(path, allBalancesPath, 1))
+			suite.Require().Equal(tc.expPass[0], err == nil)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelQueryStatsEpochs() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	params := types.NewParams(true, []string{allBalancesPath})
+	params.EpochBlocks = 10
+	suite.Require().NoError(icqKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	ctx := suite.chainB.GetContext().WithBlockHeight(10)
+	packet := suite.newQueryPacket(path, allBalancesPath, 1)
+	for _, height := range []int64{10, 15, 25, 35} {
+		_, err := icqKeeper.OnRecvPacket(ctx.WithBlockHeight(height), packet)
+		suite.Require().NoError(err)
+	}
+
+	// the accounting of the current and previous epochs is kept
+	_, found := icqKeeper.GetChannelQueryStats(ctx, path.EndpointB.ChannelID, 1)
+	suite.Require().False(found)
+	previous, found := icqKeeper.GetChannelQueryStats(ctx, path.EndpointB.ChannelID, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), previous.QueriesServed)
+
+	res, err := icqKeeper.ChannelStats(ctx.WithBlockHeight(35), &types.QueryChannelStatsRequest{ChannelId: path.EndpointB.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Epoch)
+	suite.Require().Equal(uint64(1), res.Stats.QueriesServed)
+	suite.Require().Equal(&previous, res.PreviousStats)
+	suite.Require().Nil(res.Quota)
+
+	// the accounting of epochs older than the previous one is not returned
+	all, err := icqKeeper.AllChannelStats(ctx.WithBlockHeight(50), &types.QueryAllChannelStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), all.Epoch)
+	suite.Require().Empty(all.ChannelStats)
+
+	all, err = icqKeeper.AllChannelStats(ctx.WithBlockHeight(45), &types.QueryAllChannelStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(all.ChannelStats, 1)
+	suite.Require().Equal(uint64(3), all.ChannelStats[0].Epoch)
+}
+
+func (suite *KeeperTestSuite) TestFailedQueryIsAcknowledgedWithError() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	// no query is allowed, so the packet fails on chainB
+	packet := suite.newQueryPacket(path, allBalancesPath, 1)
+	sequence, err := path.EndpointA.SendPacket(packet.TimeoutHeight, 0, packet.Data)
+	suite.Require().NoError(err)
+	packet.Sequence = sequence
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	// core IBC discards the state changes of the failed packet, so it is not counted
+	ctx := suite.chainB.GetContext()
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	_, found := icqKeeper.GetChannelQueryStats(ctx, path.EndpointB.ChannelID, icqKeeper.GetCurrentEpoch(ctx))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSharedQuotaAcrossChannels() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	params := types.NewParams(true, []string{allBalancesPath})
+	params.EpochBlocks = 100
+	params.SharedQuota = types.NewQueryQuota(2, 0)
+	suite.Require().NoError(icqKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	suite.Require().NoError(suite.recvQuery(path, allBalancesPath))
+	suite.Require().NoError(suite.recvQuery(path, allBalancesPath))
+	suite.Require().ErrorIs(suite.recvQuery(path, allBalancesPath), types.ErrQuotaExceeded)
+
+	// opening a new channel does not reset the shared quota
+	newPath := NewICQPath(suite.chainA, suite.chainB)
+	newPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	newPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+	newPath.EndpointA.ClientID = path.EndpointA.ClientID
+	newPath.EndpointB.ClientID = path.EndpointB.ClientID
+	suite.Require().NoError(SetupICQPath(newPath))
+	suite.Require().NotEqual(path.EndpointB.ChannelID, newPath.EndpointB.ChannelID)
+	suite.Require().ErrorIs(suite.recvQuery(newPath, allBalancesPath), types.ErrQuotaExceeded)
+
+	// a channel granted its own quota is not limited by the shared quota
+	ctx := suite.chainB.GetContext()
+	icqKeeper.SetChannelQueryQuota(ctx, newPath.EndpointB.ChannelID, types.NewQueryQuota(1, 0))
+	suite.Require().NoError(suite.recvQuery(newPath, allBalancesPath))
+
+	res, err := icqKeeper.Quotas(ctx, &types.QueryQuotasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(icqKeeper.GetCurrentEpoch(ctx), res.SharedStats.Epoch)
+	suite.Require().Equal(uint64(2), res.SharedStats.QueriesServed)
+	suite.Require().NotZero(res.SharedStats.GasConsumed)
+}
+
+func (suite *KeeperTestSuite) TestMsgQueryQuota() {
+	suite.SetupTest()
+
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.Require().NoError(SetupICQPath(path))
+
+	icqKeeper := simapp.GetSimApp(suite.chainB).ICQKeeper
+	msgServer := keeper.NewMsgServerImpl(icqKeeper)
+	authority := icqKeeper.GetAuthority()
+	quota := types.NewQueryQuota(10, 1_000_000)
+	ctx := suite.chainB.GetContext()
+
+	_, err := msgServer.SetQueryQuota(ctx, &types.MsgSetQueryQuota{
+		Authority: suite.chainB.SenderAccount.GetAddress().String(),
+		ChannelId: path.EndpointB.ChannelID,
+		Quota:     quota,
+	})
+	suite.Require().Error(err, "invalid authority")
+
+	_, err = msgServer.SetQueryQuota(ctx, &types.MsgSetQueryQuota{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidQuota, "quota without limit")
+
+	_, err = msgServer.SetQueryQuota(ctx, &types.MsgSetQueryQuota{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+		Quota:     quota,
+	})
+	suite.Require().NoError(err)
+
+	res, err := icqKeeper.Quotas(ctx, &types.QueryQuotasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ChannelQueryQuota{{ChannelId: path.EndpointB.ChannelID, Quota: quota}}, res.ChannelQuotas)
+
+	statsRes, err := icqKeeper.ChannelStats(ctx, &types.QueryChannelStatsRequest{ChannelId: path.EndpointB.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&quota, statsRes.Quota)
+
+	_, err = msgServer.DeleteQueryQuota(ctx, &types.MsgDeleteQueryQuota{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().NoError(err)
+	_, found := icqKeeper.GetChannelQueryQuota(ctx, path.EndpointB.ChannelID)
+	suite.Require().False(found)
+
+	_, err = msgServer.DeleteQueryQuota(ctx, &types.MsgDeleteQueryQuota{
+		Authority: authority,
+		ChannelId: path.EndpointB.ChannelID,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidQuota, "no quota to delete")
+}
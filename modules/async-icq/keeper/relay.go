@@ -1,21 +1,62 @@
 package keeper
 
 import (
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"slices"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/internal/cachectx"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 )
 
 // OnRecvPacket handles a given interchain queries packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
+// The packet is rejected if the destination channel has reached its query quota for the epoch, or if the channel has
+// no quota and the channels without a quota have reached the shared quota of the params. A served packet and the gas
+// consumed by its queries are added to the accounting of the channel, and to the shared accounting if the channel
+// has no quota. Failed packets are not counted, as core IBC discards the state changes of a packet acknowledged with
+// an error.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	stats := k.getCurrentChannelQueryStats(ctx, params, packet.GetDestChannel())
+	var sharedStats *types.SharedQueryStats
+	if quota, found := k.GetChannelQueryQuota(ctx, packet.GetDestChannel()); found {
+		if err := quota.CheckStats(stats); err != nil {
+			return nil, err
+		}
+	} else if params.SharedQuota.Validate() == nil {
+		shared := k.getCurrentSharedQueryStats(ctx, params)
+		if err := params.SharedQuota.CheckSharedStats(shared); err != nil {
+			return nil, err
+		}
+		sharedStats = &shared
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	response, err := k.handlePacket(ctx, packet, params)
+	if err != nil {
+		return nil, err
+	}
+
+	gasConsumed := ctx.GasMeter().GasConsumed() - gasBefore
+	k.recordQuery(ctx, stats, gasConsumed)
+	if sharedStats != nil {
+		k.recordSharedQuery(ctx, *sharedStats, gasConsumed)
+	}
+
+	return response, nil
+}
+
+func (k Keeper) handlePacket(ctx sdk.Context, packet channeltypes.Packet, params types.Params) ([]byte, error) {
 	var data types.InterchainQueryPacketData
 
 	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
@@ -28,10 +69,16 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, err
 	}
 
+	if params.MaxRequestsPerPacket != 0 && uint64(len(reqs)) > params.MaxRequestsPerPacket {
+		return nil, errors.Wrapf(types.ErrTooManyRequests, "packet has %d requests, max is %d", len(reqs), params.MaxRequestsPerPacket)
+	}
+
+	permissions := k.GetQueryPermissions(ctx, params, packet.GetDestPort(), packet.GetDestChannel())
+
 	// If we panic when executing a query it should be returned as an error.
 	var response []byte
-	err = applyFuncIfNoError(ctx, func(ctx sdk.Context) error {
-		response, err = k.executeQuery(ctx, reqs)
+	err = cachectx.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		response, err = k.executeQueryWithGasBudget(ctx, reqs, params, permissions)
 		return err
 	})
 	if err != nil {
@@ -40,35 +87,54 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 	return response, err
 }
 
-func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery) ([]byte, error) {
+// executeQueryWithGasBudget executes the queries with a gas meter limited to the gas budget of a packet, if it is
+// set. Running out of the budget fails the queries, while the gas they used is still charged to the transaction
+func (k Keeper) executeQueryWithGasBudget(ctx sdk.Context, reqs []abci.RequestQuery, params types.Params, permissions types.QueryPermissions) (bz []byte, err error) {
+	if params.MaxGasPerPacket == 0 {
+		return k.executeQuery(ctx, reqs, params, permissions)
+	}
+
+	gasMeter := storetypes.NewGasMeter(params.MaxGasPerPacket)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain queries")
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			bz, err = nil, errors.Wrapf(types.ErrGasBudgetExceeded, "queries used more than the gas budget of %d", params.MaxGasPerPacket)
+		}
+	}()
+
+	return k.executeQuery(ctx.WithGasMeter(gasMeter), reqs, params, permissions)
+}
+
+func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery, params types.Params, permissions types.QueryPermissions) ([]byte, error) {
+	var responseBytes uint64
 	resps := make([]abci.ResponseQuery, len(reqs))
 	for i, req := range reqs {
-		if err := k.authenticateQuery(ctx, req); err != nil {
+		if err := k.authenticateQuery(ctx, permissions, req); err != nil {
 			return nil, err
 		}
 
-		route := k.queryRouter.Route(req.Path)
-		if route == nil {
-			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "no route found for: %s", req.Path)
-		}
-
-		resp, err := route(ctx, &abci.RequestQuery{
-			Data: req.Data,
-			Path: req.Path,
-		})
-		if err != nil {
-			return nil, err
+		if storeName, ok := types.ParseStoreQueryPath(req.Path); ok {
+			resp, err := k.executeStoreQuery(ctx, storeName, req)
+			if err != nil {
+				return nil, err
+			}
+			resps[i] = resp
+		} else {
+			resp, err := k.executeGRPCQuery(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			resps[i] = resp
 		}
 
-		// Remove non-deterministic fields from response
-		resps[i] = abci.ResponseQuery{
-			// Codespace is not currently part of consensus, but it will probablyy be added in the future
-			// Codespace: resp.Codespace,
-			Code:   resp.Code,
-			Index:  resp.Index,
-			Key:    resp.Key,
-			Value:  resp.Value,
-			Height: resp.Height,
+		responseBytes += uint64(resps[i].Size())
+		if params.MaxResponseBytes != 0 && responseBytes > params.MaxResponseBytes {
+			return nil, errors.Wrapf(types.ErrResponseTooLarge, "responses exceed the max size of %d bytes", params.MaxResponseBytes)
 		}
 	}
 
@@ -87,10 +153,73 @@ func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery) ([]byte,
 	return data, nil
 }
 
-// authenticateQuery ensures the provided query request is in the whitelist.
-func (k Keeper) authenticateQuery(ctx sdk.Context, q abci.RequestQuery) error {
-	allowQueries := k.GetAllowQueries(ctx)
-	if !types.ContainsQueryPath(allowQueries, q.Path) {
+// executeGRPCQuery routes the query to the gRPC query service of its path
+func (k Keeper) executeGRPCQuery(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+	route := k.queryRouter.Route(req.Path)
+	if route == nil {
+		return abci.ResponseQuery{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "no route found for: %s", req.Path)
+	}
+
+	resp, err := route(ctx, &abci.RequestQuery{
+		Data: req.Data,
+		Path: req.Path,
+	})
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	// Remove non-deterministic fields from response
+	return abci.ResponseQuery{
+		// Codespace is not currently part of consensus, but it will probablyy be added in the future
+		// Codespace: resp.Codespace,
+		Code:   resp.Code,
+		Index:  resp.Index,
+		Key:    resp.Key,
+		Value:  resp.Value,
+		Height: resp.Height,
+	}, nil
+}
+
+// executeStoreQuery queries the key of the store at the last committed height, with a proof of the value if it is
+// requested. The committed state is read outside of the gas meter of the context, so the read is charged with the
+// gas costs of a KVStore read
+func (k Keeper) executeStoreQuery(ctx sdk.Context, storeName string, req abci.RequestQuery) (abci.ResponseQuery, error) {
+	if k.storeQuerier == nil {
+		return abci.ResponseQuery{}, errors.Wrap(sdkerrors.ErrUnauthorized, "store queries are not enabled")
+	}
+	if ctx.BlockHeight() <= 1 {
+		return abci.ResponseQuery{}, errors.Wrap(sdkerrors.ErrInvalidRequest, "no committed state to query")
+	}
+
+	resp, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Path:   "/" + storeName + types.StoreQueryPathSuffix,
+		Data:   req.Data,
+		Height: ctx.BlockHeight() - 1,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	gasConfig := storetypes.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(resp.Key)+len(resp.Value)), storetypes.GasReadPerByteDesc)
+
+	return abci.ResponseQuery{
+		Key:      resp.Key,
+		Value:    resp.Value,
+		ProofOps: resp.ProofOps,
+		Height:   resp.Height,
+	}, nil
+}
+
+// authenticateQuery ensures the provided query request is in the whitelist of the query permissions of the channel.
+func (k Keeper) authenticateQuery(ctx sdk.Context, permissions types.QueryPermissions, q abci.RequestQuery) error {
+	if storeName, ok := types.ParseStoreQueryPath(q.Path); ok {
+		return k.authenticateStoreQuery(permissions, storeName, q)
+	}
+
+	if !types.ContainsQueryPath(permissions.AllowQueries, q.Path) {
 		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", q.Path)
 	}
 	if !(q.Height == 0 || q.Height == ctx.BlockHeight()) {
@@ -102,3 +231,19 @@ func (k Keeper) authenticateQuery(ctx sdk.Context, q abci.RequestQuery) error {
 
 	return nil
 }
+
+// authenticateStoreQuery ensures the store of the raw store query is in the whitelist. Store queries are always run
+// at the last committed height, so no height can be requested
+func (k Keeper) authenticateStoreQuery(permissions types.QueryPermissions, storeName string, q abci.RequestQuery) error {
+	if !slices.Contains(permissions.AllowStoreQueries, storeName) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "store query not allowed: %s", storeName)
+	}
+	if q.Height != 0 {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query height not allowed: %d", q.Height)
+	}
+	if len(q.Data) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "store query key cannot be empty")
+	}
+
+	return nil
+}
@@ -3,8 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -15,9 +15,9 @@ import (
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 
-	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
@@ -62,6 +62,60 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			true,
 		},
+		{
+			"icq successfully queries banktypes.AllBalances allowed by a service wildcard",
+			func() {
+				q := banktypes.QueryAllBalancesRequest{
+					Address: suite.chainA.SenderAccount.GetAddress().String(),
+				}
+				reqs := []abcitypes.RequestQuery{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+						Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+					},
+				}
+				data, err := types.SerializeCosmosQuery(reqs)
+				suite.Require().NoError(err)
+
+				icqPacketData := types.InterchainQueryPacketData{
+					Data: data,
+				}
+				packetData = icqPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/*"})
+				if err := simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params); err != nil {
+					panic(err)
+				}
+			},
+			true,
+		},
+		{
+			"unauthorised: query path denied by a deny entry",
+			func() {
+				q := banktypes.QueryAllBalancesRequest{
+					Address: suite.chainA.SenderAccount.GetAddress().String(),
+				}
+				reqs := []abcitypes.RequestQuery{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+						Data: simapp.GetSimApp(suite.chainA).AppCodec().MustMarshal(&q),
+					},
+				}
+				data, err := types.SerializeCosmosQuery(reqs)
+				suite.Require().NoError(err)
+
+				icqPacketData := types.InterchainQueryPacketData{
+					Data: data,
+				}
+				packetData = icqPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.bank.*", "!/cosmos.bank.v1beta1.Query/AllBalances"})
+				if err := simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params); err != nil {
+					panic(err)
+				}
+			},
+			false,
+		},
 		{
 			"cannot unmarshal interchain query packet data",
 			func() {
@@ -239,8 +293,9 @@ func (suite *KeeperTestSuite) TestOutOfGasOnSlowQueries() {
 	)
 
 	ctx := suite.chainB.GetContext()
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(2000))
-	// enough gas for this small query, but not for the larger one. This one should work
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
+	// enough gas for this small query, the lookup of the query permissions of the channel and its query
+	// accounting, but not for the larger one. This one should work
 	_, err = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
 	suite.Require().NoError(err)
 
@@ -275,7 +330,109 @@ func (suite *KeeperTestSuite) TestOutOfGasOnSlowQueries() {
 
 	// and this one should panic
 	suite.Assert().Panics(func() {
-		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(2000))
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(20_000))
 		_, _ = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
 	}, "out of gas")
 }
+
+func (suite *KeeperTestSuite) TestPacketLimits() {
+	var (
+		params types.Params
+		reqs   []abcitypes.RequestQuery
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: within limits",
+			func() {
+				params.MaxRequestsPerPacket = 2
+				params.MaxResponseBytes = 1_000
+				params.MaxGasPerPacket = 1_000_000
+			},
+			nil,
+		},
+		{
+			"too many requests",
+			func() {
+				params.MaxRequestsPerPacket = 1
+			},
+			types.ErrTooManyRequests,
+		},
+		{
+			"responses too large",
+			func() {
+				params.MaxResponseBytes = 10
+			},
+			types.ErrResponseTooLarge,
+		},
+		{
+			"gas budget exceeded",
+			func() {
+				params.MaxGasPerPacket = 10
+			},
+			types.ErrGasBudgetExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICQPath(path)
+			suite.Require().NoError(err)
+
+			q := banktypes.QueryAllBalancesRequest{
+				Address: suite.chainB.SenderAccount.GetAddress().String(),
+			}
+			req := abcitypes.RequestQuery{
+				Path: "/cosmos.bank.v1beta1.Query/AllBalances",
+				Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+			}
+			reqs = []abcitypes.RequestQuery{req, req}
+			params = types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/AllBalances"})
+
+			tc.malleate() // malleate mutates test data
+
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			data, err := types.SerializeCosmosQuery(reqs)
+			suite.Require().NoError(err)
+			icqPacketData := types.InterchainQueryPacketData{
+				Data: data,
+			}
+			packet := channeltypes.NewPacket(
+				icqPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, err = simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			if tc.expErr == types.ErrGasBudgetExceeded {
+				// the gas used until the budget ran out is still charged to the transaction
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.MaxGasPerPacket)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// balanceStoreKey returns the key under which the bank module stores the balance of the denom of the address
+func balanceStoreKey(addr sdk.AccAddress, denom string) []byte {
+	codec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, codec, collections.Join(addr, denom))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func (suite *KeeperTestSuite) TestStoreQuery() {
+	var (
+		path *ibctesting.Path
+		req  abcitypes.RequestQuery
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: query with proof",
+			func() {},
+			true,
+		},
+		{
+			"success: query without proof",
+			func() {
+				req.Prove = false
+			},
+			true,
+		},
+		{
+			"success: absent key",
+			func() {
+				req.Data = balanceStoreKey(suite.chainB.SenderAccount.GetAddress(), "absent")
+			},
+			true,
+		},
+		{
+			"unauthorised: store not allowed",
+			func() {
+				req.Path = types.NewStoreQueryPath("acc")
+			},
+			false,
+		},
+		{
+			"unauthorised: can not perform historical query (i.e. height != 0)",
+			func() {
+				req.Height = 1
+			},
+			false,
+		},
+		{
+			"empty key",
+			func() {
+				req.Data = nil
+			},
+			false,
+		},
+		{
+			"store queries are not enabled",
+			func() {
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetStoreQuerier(nil)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICQPath(path)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.AllowStoreQueries = []string{banktypes.StoreKey}
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			req = abcitypes.RequestQuery{
+				Path:  types.NewStoreQueryPath(banktypes.StoreKey),
+				Data:  balanceStoreKey(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom),
+				Prove: true,
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			data, err := types.SerializeCosmosQuery([]abcitypes.RequestQuery{req})
+			suite.Require().NoError(err)
+			icqPacketData := types.InterchainQueryPacketData{
+				Data: data,
+			}
+			packet := channeltypes.NewPacket(
+				icqPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			ackBz, err := simapp.GetSimApp(suite.chainB).ICQKeeper.OnRecvPacket(ctx, packet)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			resp, err := types.DeserializeCosmosResponseFromAck(ackBz)
+			suite.Require().NoError(err)
+			suite.Require().Len(resp.Responses, 1)
+			suite.Require().Equal(ctx.BlockHeight()-1, resp.Responses[0].Height)
+			suite.Require().Equal(req.Data, resp.Responses[0].Key)
+			suite.Require().Equal(req.Prove, resp.Responses[0].ProofOps != nil)
+		})
+	}
+}
@@ -3,7 +3,7 @@ package main
 import (
 	"os"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
 
 	"cosmossdk.io/log"
 
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DenyQueryPrefix marks an allowlist entry denying the query paths it matches. Deny entries take priority over
+	// the entries allowing a path
+	DenyQueryPrefix = "!"
+	// ServiceWildcardSuffix ends an allowlist entry matching every method of a service, e.g.
+	// "/cosmos.bank.v1beta1.Query/*"
+	ServiceWildcardSuffix = "/*"
+	// PackageWildcardSuffix ends an allowlist entry matching every query path of the package prefix, e.g.
+	// "/cosmos.bank.*"
+	PackageWildcardSuffix = ".*"
+)
+
+// ContainsQueryPath returns true if the path is matched by an entry of allowQueries and by none of its deny entries,
+// otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	allowed := false
+	for _, v := range allowQueries {
+		if pattern, ok := strings.CutPrefix(v, DenyQueryPrefix); ok {
+			if matchQueryPattern(pattern, path) {
+				return false
+			}
+			continue
+		}
+
+		if matchQueryPattern(v, path) {
+			allowed = true
+		}
+	}
+
+	return allowed
+}
+
+// matchQueryPattern returns true if the path matches the allowlist pattern: the exact path, any method of the service
+// for a service wildcard, or any path starting with the package prefix for a package wildcard
+func matchQueryPattern(pattern, path string) bool {
+	switch {
+	case strings.HasSuffix(pattern, ServiceWildcardSuffix):
+		method, ok := strings.CutPrefix(path, strings.TrimSuffix(pattern, "*"))
+		return ok && method != "" && !strings.Contains(method, "/")
+	case strings.HasSuffix(pattern, PackageWildcardSuffix):
+		rest, ok := strings.CutPrefix(path, strings.TrimSuffix(pattern, "*"))
+		return ok && rest != ""
+	default:
+		return pattern == path
+	}
+}
+
+// ValidateQueryPattern checks that the allowlist entry is an exact query path, a service or package wildcard,
+// optionally prefixed with DenyQueryPrefix
+func ValidateQueryPattern(entry string) error {
+	pattern := strings.TrimPrefix(entry, DenyQueryPrefix)
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("query pattern cannot be empty: %q", entry)
+	}
+
+	prefix := pattern
+	switch {
+	case strings.HasSuffix(pattern, ServiceWildcardSuffix):
+		prefix = strings.TrimSuffix(pattern, ServiceWildcardSuffix)
+		if !strings.HasPrefix(prefix, "/") || len(prefix) == 1 || strings.Contains(prefix[1:], "/") {
+			return fmt.Errorf("service wildcard must be of the form /<service>%s: %q", ServiceWildcardSuffix, entry)
+		}
+	case strings.HasSuffix(pattern, PackageWildcardSuffix):
+		prefix = strings.TrimSuffix(pattern, PackageWildcardSuffix)
+		if !strings.HasPrefix(prefix, "/") || len(prefix) == 1 || strings.Contains(prefix[1:], "/") {
+			return fmt.Errorf("package wildcard must be of the form /<package>%s: %q", PackageWildcardSuffix, entry)
+		}
+	}
+
+	if strings.Contains(prefix, "*") {
+		return fmt.Errorf("wildcard is only allowed at the end of a query pattern: %q", entry)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+func (suite *TypesTestSuite) TestContainsQueryPath() {
+	allowQueries := []string{
+		"path/to/query1",
+		"path/to/query2",
+	}
+
+	found := types.ContainsQueryPath(allowQueries, "path/to/query1")
+	suite.Require().True(found)
+
+	found = types.ContainsQueryPath(allowQueries, "path/to/query2")
+	suite.Require().True(found)
+
+	found = types.ContainsQueryPath(allowQueries, "path/to/query3")
+	suite.Require().False(found)
+}
+
+func (suite *TypesTestSuite) TestContainsQueryPathWildcards() {
+	allowQueries := []string{
+		"/cosmos.bank.v1beta1.Query/*",
+		"/cosmos.staking.*",
+		"!/cosmos.bank.v1beta1.Query/DenomOwners",
+		"!/cosmos.staking.v1beta1.Query/*",
+	}
+
+	testCases := []struct {
+		path     string
+		expFound bool
+	}{
+		{"/cosmos.bank.v1beta1.Query/AllBalances", true},
+		{"/cosmos.bank.v1beta1.Query/DenomOwners", false},
+		{"/cosmos.bank.v1beta1.Query/", false},
+		{"/cosmos.bank.v1beta1.Query/Balance/extra", false},
+		{"/cosmos.bank.v1beta1.Msg/Send", false},
+		{"/cosmos.staking.v2.Query/Validators", true},
+		{"/cosmos.staking.v1beta1.Query/Validators", false},
+		{"/cosmos.stakingfoo.v1.Query/Params", false},
+		{"/cosmos.auth.v1beta1.Query/Accounts", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expFound, types.ContainsQueryPath(allowQueries, tc.path), tc.path)
+	}
+
+	// deny entries take priority over an exact allow entry
+	suite.Require().False(types.ContainsQueryPath([]string{"/a.Query/B", "!/a.*"}, "/a.Query/B"))
+}
+
+func (suite *TypesTestSuite) TestValidateQueryPattern() {
+	testCases := []struct {
+		pattern string
+		expPass bool
+	}{
+		{"/cosmos.bank.v1beta1.Query/AllBalances", true},
+		{"path/to/query1", true},
+		{"/cosmos.bank.v1beta1.Query/*", true},
+		{"/cosmos.bank.*", true},
+		{"!/cosmos.bank.v1beta1.Query/DenomOwners", true},
+		{"!/cosmos.bank.v1beta1.Query/*", true},
+		{"!", false},
+		{"! ", false},
+		{"/*", false},
+		{"/.*", false},
+		{"cosmos.bank.v1beta1.Query/*", false},
+		{"/cosmos.bank.v1beta1/Query/*", false},
+		{"/cosmos.bank.v1beta1.Query/.*", false},
+		{"/cosmos.*.Query/*", false},
+		{"/cosmos.bank.v1beta1.Query/All*", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateQueryPattern(tc.pattern)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.pattern)
+		} else {
+			suite.Require().Error(err, tc.pattern)
+		}
+	}
+}
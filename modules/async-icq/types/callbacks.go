@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+)
+
+// QueryCallbacks is implemented by the modules sending queries with the controller keeper to receive their
+// results. The queries are identified by the channel and sequence returned by SendQuery, and are only passed to the
+// callbacks of the module which sent them. An error returned by a callback reverts the state changes of the
+// callback only, so that the query is still removed from the pending queries
+type QueryCallbacks interface {
+	// OnQueryResponse is called when the host chain acknowledges the query with the responses to its requests
+	OnQueryResponse(ctx sdk.Context, query PendingQuery, responses []abcitypes.ResponseQuery) error
+	// OnQueryError is called when the host chain acknowledges the query with an error
+	OnQueryError(ctx sdk.Context, query PendingQuery, ackErr string) error
+	// OnQueryTimeout is called when the query times out before being acknowledged
+	OnQueryTimeout(ctx sdk.Context, query PendingQuery) error
+}
+
+// QueryCallbacksRouter routes the result of each query to the QueryCallbacks of the module which sent it, so that
+// a module only receives the results of its own queries
+type QueryCallbacksRouter struct {
+	routes map[string]QueryCallbacks
+}
+
+// NewQueryCallbacksRouter creates an empty QueryCallbacksRouter
+func NewQueryCallbacksRouter() *QueryCallbacksRouter {
+	return &QueryCallbacksRouter{
+		routes: make(map[string]QueryCallbacks),
+	}
+}
+
+// AddRoute registers the QueryCallbacks of the module. It panics if the module already has QueryCallbacks
+func (r *QueryCallbacksRouter) AddRoute(module string, callbacks QueryCallbacks) *QueryCallbacksRouter {
+	if module == "" {
+		panic("cannot add interchain query callbacks without a module")
+	}
+	if _, found := r.routes[module]; found {
+		panic(fmt.Sprintf("interchain query callbacks already set for module %s", module))
+	}
+
+	r.routes[module] = callbacks
+	return r
+}
+
+// GetRoute returns the QueryCallbacks of the module, if it has any
+func (r *QueryCallbacksRouter) GetRoute(module string) (QueryCallbacks, bool) {
+	callbacks, found := r.routes[module]
+	return callbacks, found
+}
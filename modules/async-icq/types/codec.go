@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,7 +50,40 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetQueryPermissions{},
+		&MsgDeleteQueryPermissions{},
+		&MsgSetQueryQuota{},
+		&MsgDeleteQueryQuota{},
+		&MsgAddAllowedQueries{},
+		&MsgRemoveAllowedQueries{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
+
+// RegisterControllerInterfaces registers the messages of the interchain query controller
+func RegisterControllerInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterRecurringQuery{},
+		&MsgCancelRecurringQuery{},
+		&MsgUpdateControllerParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_ControllerMsg_serviceDesc)
+}
+
+// DeserializeCosmosResponseFromAck decodes the result of the acknowledgement of an interchain query packet into
+// the responses of the host chain, in the order of the requests
+func DeserializeCosmosResponseFromAck(result []byte) (CosmosResponse, error) {
+	var ack InterchainQueryPacketAck
+	if err := ModuleCdc.UnmarshalJSON(result, &ack); err != nil {
+		return CosmosResponse{}, errors.Wrap(err, "cannot unmarshal interchain query packet ack")
+	}
+
+	var resp CosmosResponse
+	if err := ModuleCdc.Unmarshal(ack.Data, &resp); err != nil {
+		return CosmosResponse{}, errors.Wrap(err, "cannot unmarshal cosmos response")
+	}
+	return resp, nil
+}
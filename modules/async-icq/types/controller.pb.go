@@ -0,0 +1,2469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icq/v1/controller.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingQuery is a query sent by the controller that has not been acknowledged or timed out yet.
+type PendingQuery struct {
+	// channel_id is the controller channel the query was sent on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet the query was sent in.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// requests are the ABCI query requests sent to the host chain.
+	Requests []types.RequestQuery `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests"`
+	// timeout_timestamp is the timestamp after which the query times out.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// sender is the address the query was sent on behalf of with SendQueryFrom, empty for the queries sent with
+	// SendQuery.
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recurring_query_id is the ID of the recurring query the query is a run of, 0 for the queries sent directly.
+	RecurringQueryId uint64 `protobuf:"varint,6,opt,name=recurring_query_id,json=recurringQueryId,proto3" json:"recurring_query_id,omitempty"`
+	// recurring_query_run is the number of the run of the recurring query, starting at 1.
+	RecurringQueryRun uint64 `protobuf:"varint,7,opt,name=recurring_query_run,json=recurringQueryRun,proto3" json:"recurring_query_run,omitempty"`
+	// module is the name of the module which sent the query, whose QueryCallbacks receive its result. Empty for the
+	// runs of the recurring queries registered with MsgRegisterRecurringQuery, whose results are only recorded.
+	Module string `protobuf:"bytes,8,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *PendingQuery) Reset()         { *m = PendingQuery{} }
+func (m *PendingQuery) String() string { return proto.CompactTextString(m) }
+func (*PendingQuery) ProtoMessage()    {}
+func (*PendingQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ff9d55a5687192, []int{0}
+}
+func (m *PendingQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingQuery.Merge(m, src)
+}
+func (m *PendingQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingQuery proto.InternalMessageInfo
+
+func (m *PendingQuery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingQuery) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetRequests() []types.RequestQuery {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *PendingQuery) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingQuery) GetRecurringQueryId() uint64 {
+	if m != nil {
+		return m.RecurringQueryId
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetRecurringQueryRun() uint64 {
+	if m != nil {
+		return m.RecurringQueryRun
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// ControllerParams defines the parameters of the interchain query controller.
+type ControllerParams struct {
+	// recurring_query_deposit is the deposit escrowed from the owner of a recurring query when it is registered, and
+	// refunded when the owner cancels the registration.
+	RecurringQueryDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recurring_query_deposit,json=recurringQueryDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recurring_query_deposit"`
+	// recurring_query_byte_deposit is the deposit escrowed per byte of the requests of a recurring query, on top of
+	// recurring_query_deposit, so that the deposit grows with the state taken by the registration.
+	RecurringQueryByteDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=recurring_query_byte_deposit,json=recurringQueryByteDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recurring_query_byte_deposit"`
+	// max_recurring_queries_per_block is the maximum number of recurring query runs sent in a block. The runs due
+	// beyond it are carried over to the next blocks, in the order of their due height and ID. 0 means no limit.
+	MaxRecurringQueriesPerBlock uint64 `protobuf:"varint,3,opt,name=max_recurring_queries_per_block,json=maxRecurringQueriesPerBlock,proto3" json:"max_recurring_queries_per_block,omitempty"`
+	// max_recurring_queries_per_owner is the maximum number of recurring queries an address can have registered.
+	// 0 means no limit.
+	MaxRecurringQueriesPerOwner uint64 `protobuf:"varint,4,opt,name=max_recurring_queries_per_owner,json=maxRecurringQueriesPerOwner,proto3" json:"max_recurring_queries_per_owner,omitempty"`
+	// max_recurring_query_requests is the maximum number of requests of a recurring query. 0 means no limit.
+	MaxRecurringQueryRequests uint64 `protobuf:"varint,5,opt,name=max_recurring_query_requests,json=maxRecurringQueryRequests,proto3" json:"max_recurring_query_requests,omitempty"`
+	// max_recurring_query_bytes is the maximum total size in bytes of the requests of a recurring query. 0 means no
+	// limit.
+	MaxRecurringQueryBytes uint64 `protobuf:"varint,6,opt,name=max_recurring_query_bytes,json=maxRecurringQueryBytes,proto3" json:"max_recurring_query_bytes,omitempty"`
+}
+
+func (m *ControllerParams) Reset()         { *m = ControllerParams{} }
+func (m *ControllerParams) String() string { return proto.CompactTextString(m) }
+func (*ControllerParams) ProtoMessage()    {}
+func (*ControllerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ff9d55a5687192, []int{1}
+}
+func (m *ControllerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerParams.Merge(m, src)
+}
+func (m *ControllerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerParams proto.InternalMessageInfo
+
+func (m *ControllerParams) GetRecurringQueryDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecurringQueryDeposit
+	}
+	return nil
+}
+
+func (m *ControllerParams) GetRecurringQueryByteDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecurringQueryByteDeposit
+	}
+	return nil
+}
+
+func (m *ControllerParams) GetMaxRecurringQueriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecurringQueriesPerBlock
+	}
+	return 0
+}
+
+func (m *ControllerParams) GetMaxRecurringQueriesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxRecurringQueriesPerOwner
+	}
+	return 0
+}
+
+func (m *ControllerParams) GetMaxRecurringQueryRequests() uint64 {
+	if m != nil {
+		return m.MaxRecurringQueryRequests
+	}
+	return 0
+}
+
+func (m *ControllerParams) GetMaxRecurringQueryBytes() uint64 {
+	if m != nil {
+		return m.MaxRecurringQueryBytes
+	}
+	return 0
+}
+
+// RecurringQuery is a query registered to be sent by the controller every interval blocks, until it has been sent
+// max_runs times.
+type RecurringQuery struct {
+	// id is the ID of the registration.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address which registered the query, and can cancel the registration.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// channel_id is the controller channel the query is sent on.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// requests are the ABCI query requests sent to the host chain.
+	Requests []types.RequestQuery `protobuf:"bytes,4,rep,name=requests,proto3" json:"requests"`
+	// interval is the number of blocks between two runs of the query.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_runs is the number of times the query is sent.
+	MaxRuns uint64 `protobuf:"varint,6,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// relative_timeout is the timeout of each run, in nanoseconds after the block time it is sent at.
+	RelativeTimeout uint64 `protobuf:"varint,7,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// runs is the number of times the query has been sent.
+	Runs uint64 `protobuf:"varint,8,opt,name=runs,proto3" json:"runs,omitempty"`
+	// next_run_height is the height of the block the query is sent at next, if it has runs left.
+	NextRunHeight int64 `protobuf:"varint,9,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+	// deposit is the deposit escrowed from the owner, refunded when the registration is cancelled.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// module is the name of the module which registered the query, whose QueryCallbacks receive the results of its
+	// runs. Empty for the registrations with MsgRegisterRecurringQuery.
+	Module string `protobuf:"bytes,11,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *RecurringQuery) Reset()         { *m = RecurringQuery{} }
+func (m *RecurringQuery) String() string { return proto.CompactTextString(m) }
+func (*RecurringQuery) ProtoMessage()    {}
+func (*RecurringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ff9d55a5687192, []int{2}
+}
+func (m *RecurringQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringQuery.Merge(m, src)
+}
+func (m *RecurringQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringQuery proto.InternalMessageInfo
+
+func (m *RecurringQuery) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RecurringQuery) GetRequests() []types.RequestQuery {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *RecurringQuery) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetRuns() uint64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetNextRunHeight() int64 {
+	if m != nil {
+		return m.NextRunHeight
+	}
+	return 0
+}
+
+func (m *RecurringQuery) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *RecurringQuery) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// RecurringQueryResult is the latest result of a recurring query.
+type RecurringQueryResult struct {
+	// recurring_query_id is the ID of the recurring query.
+	RecurringQueryId uint64 `protobuf:"varint,1,opt,name=recurring_query_id,json=recurringQueryId,proto3" json:"recurring_query_id,omitempty"`
+	// run is the number of the run the result is of.
+	Run uint64 `protobuf:"varint,2,opt,name=run,proto3" json:"run,omitempty"`
+	// sequence is the sequence of the packet of the run, 0 if the run could not be sent.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the height of the controller block the result was received at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// responses are the responses of the host chain, in the order of the requests.
+	Responses []types.ResponseQuery `protobuf:"bytes,5,rep,name=responses,proto3" json:"responses"`
+	// error is the error of the run if it failed: the error acknowledgement of the host chain, the timeout of the
+	// query, or the reason it could not be sent.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RecurringQueryResult) Reset()         { *m = RecurringQueryResult{} }
+func (m *RecurringQueryResult) String() string { return proto.CompactTextString(m) }
+func (*RecurringQueryResult) ProtoMessage()    {}
+func (*RecurringQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ff9d55a5687192, []int{3}
+}
+func (m *RecurringQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringQueryResult.Merge(m, src)
+}
+func (m *RecurringQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringQueryResult proto.InternalMessageInfo
+
+func (m *RecurringQueryResult) GetRecurringQueryId() uint64 {
+	if m != nil {
+		return m.RecurringQueryId
+	}
+	return 0
+}
+
+func (m *RecurringQueryResult) GetRun() uint64 {
+	if m != nil {
+		return m.Run
+	}
+	return 0
+}
+
+func (m *RecurringQueryResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *RecurringQueryResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecurringQueryResult) GetResponses() []types.ResponseQuery {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *RecurringQueryResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ControllerGenesisState defines the interchain query controller genesis state
+type ControllerGenesisState struct {
+	PendingQueries        []PendingQuery         `protobuf:"bytes,1,rep,name=pending_queries,json=pendingQueries,proto3" json:"pending_queries"`
+	Params                ControllerParams       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	RecurringQueries      []RecurringQuery       `protobuf:"bytes,3,rep,name=recurring_queries,json=recurringQueries,proto3" json:"recurring_queries"`
+	RecurringQueryResults []RecurringQueryResult `protobuf:"bytes,4,rep,name=recurring_query_results,json=recurringQueryResults,proto3" json:"recurring_query_results"`
+	// next_recurring_query_id is the ID of the next registered recurring query.
+	NextRecurringQueryId uint64 `protobuf:"varint,5,opt,name=next_recurring_query_id,json=nextRecurringQueryId,proto3" json:"next_recurring_query_id,omitempty"`
+}
+
+func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
+func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
+func (*ControllerGenesisState) ProtoMessage()    {}
+func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ff9d55a5687192, []int{4}
+}
+func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerGenesisState.Merge(m, src)
+}
+func (m *ControllerGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerGenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerGenesisState proto.InternalMessageInfo
+
+func (m *ControllerGenesisState) GetPendingQueries() []PendingQuery {
+	if m != nil {
+		return m.PendingQueries
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetParams() ControllerParams {
+	if m != nil {
+		return m.Params
+	}
+	return ControllerParams{}
+}
+
+func (m *ControllerGenesisState) GetRecurringQueries() []RecurringQuery {
+	if m != nil {
+		return m.RecurringQueries
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetRecurringQueryResults() []RecurringQueryResult {
+	if m != nil {
+		return m.RecurringQueryResults
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextRecurringQueryId() uint64 {
+	if m != nil {
+		return m.NextRecurringQueryId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingQuery)(nil), "icq.v1.PendingQuery")
+	proto.RegisterType((*ControllerParams)(nil), "icq.v1.ControllerParams")
+	proto.RegisterType((*RecurringQuery)(nil), "icq.v1.RecurringQuery")
+	proto.RegisterType((*RecurringQueryResult)(nil), "icq.v1.RecurringQueryResult")
+	proto.RegisterType((*ControllerGenesisState)(nil), "icq.v1.ControllerGenesisState")
+}
+
+func init() { proto.RegisterFile("icq/v1/controller.proto", fileDescriptor_a1ff9d55a5687192) }
+
+var fileDescriptor_a1ff9d55a5687192 = []byte{
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x59, 0x96, 0xc6, 0xad, 0x7f, 0xb6, 0x8a, 0x4c, 0x39, 0x8e, 0x6c, 0xf8, 0x50,
+	0xa8, 0x68, 0x4d, 0xc6, 0x29, 0x1a, 0xa0, 0xa7, 0x00, 0x72, 0x80, 0xd6, 0xa7, 0xba, 0x6c, 0x4e,
+	0xb9, 0x10, 0x14, 0x39, 0x90, 0x17, 0x11, 0x97, 0xf4, 0xee, 0x52, 0xb5, 0xce, 0xbd, 0xf6, 0xd0,
+	0xe7, 0xe8, 0xa5, 0x87, 0x3e, 0x43, 0x81, 0x1c, 0x73, 0xec, 0xa9, 0x2d, 0xec, 0xbe, 0x41, 0x5f,
+	0xa0, 0xd8, 0x1f, 0xca, 0x12, 0xe5, 0xe4, 0x10, 0xe4, 0x24, 0xce, 0xcf, 0x7e, 0x33, 0x3b, 0xf3,
+	0xcd, 0xac, 0x60, 0x8f, 0xc6, 0x57, 0xfe, 0xf4, 0xd4, 0x8f, 0x33, 0x26, 0x79, 0x36, 0x99, 0x20,
+	0xf7, 0x72, 0x9e, 0xc9, 0x8c, 0x34, 0x69, 0x7c, 0xe5, 0x4d, 0x4f, 0xf7, 0x3b, 0xe3, 0x6c, 0x9c,
+	0x69, 0x95, 0xaf, 0xbe, 0x8c, 0x75, 0xbf, 0x1f, 0x67, 0x22, 0xcd, 0x84, 0x3f, 0x8a, 0x04, 0xfa,
+	0xd3, 0xd3, 0x11, 0xca, 0x48, 0x61, 0x50, 0x66, 0xed, 0x0f, 0x25, 0xb2, 0x04, 0x79, 0x4a, 0x99,
+	0xf4, 0xa3, 0x51, 0x4c, 0x7d, 0x39, 0xcb, 0x51, 0x18, 0xe3, 0xf1, 0x1f, 0x35, 0xf8, 0xe8, 0x02,
+	0x59, 0x42, 0xd9, 0xf8, 0xfb, 0x02, 0xf9, 0x8c, 0x3c, 0x02, 0x88, 0x2f, 0x23, 0xc6, 0x70, 0x12,
+	0xd2, 0xc4, 0x75, 0x8e, 0x9c, 0x41, 0x3b, 0x68, 0x5b, 0xcd, 0x79, 0x42, 0xf6, 0xa1, 0x25, 0xf0,
+	0xaa, 0x40, 0x16, 0xa3, 0x5b, 0x3b, 0x72, 0x06, 0x8d, 0x60, 0x2e, 0x93, 0x67, 0xd0, 0xe2, 0xea,
+	0x5b, 0x48, 0xe1, 0xd6, 0x8f, 0xea, 0x83, 0xcd, 0x27, 0x8f, 0xbc, 0xbb, 0xd8, 0x9e, 0x8a, 0xed,
+	0x05, 0xc6, 0x41, 0xc7, 0x1a, 0x36, 0x5e, 0xff, 0x75, 0xb8, 0x16, 0xcc, 0x0f, 0x91, 0xcf, 0x61,
+	0x57, 0xd2, 0x14, 0xb3, 0x42, 0x86, 0xea, 0x57, 0xc8, 0x28, 0xcd, 0xdd, 0x86, 0x8e, 0xb2, 0x63,
+	0x0d, 0x2f, 0x4a, 0x3d, 0xe9, 0x42, 0x53, 0x68, 0x70, 0x77, 0x5d, 0x27, 0x69, 0x25, 0xf2, 0x05,
+	0x10, 0x8e, 0x71, 0xc1, 0x39, 0x65, 0xe3, 0xf0, 0x4a, 0xc5, 0x51, 0x17, 0x69, 0x1a, 0x94, 0xb9,
+	0x45, 0x27, 0x70, 0x9e, 0x10, 0x0f, 0x3e, 0xa9, 0x7a, 0xf3, 0x82, 0xb9, 0x1b, 0xda, 0x7d, 0x77,
+	0xd9, 0x3d, 0x28, 0x98, 0x8a, 0x9a, 0x66, 0x49, 0x31, 0x41, 0xb7, 0x65, 0xa2, 0x1a, 0xe9, 0xf8,
+	0xb7, 0x06, 0xec, 0x9c, 0xcd, 0xfb, 0x76, 0x11, 0xf1, 0x28, 0x15, 0xe4, 0x27, 0x07, 0xf6, 0xaa,
+	0xe8, 0x09, 0xe6, 0x99, 0xa0, 0xd2, 0x75, 0x74, 0x81, 0x7a, 0x9e, 0x69, 0x9e, 0xa7, 0x9a, 0xe7,
+	0xd9, 0xe6, 0x79, 0x67, 0x19, 0x65, 0xc3, 0xc7, 0xaa, 0x38, 0xbf, 0xfe, 0x7d, 0x38, 0x18, 0x53,
+	0x79, 0x59, 0x8c, 0xbc, 0x38, 0x4b, 0x7d, 0xdb, 0x69, 0xf3, 0x73, 0x22, 0x92, 0x57, 0xb6, 0x97,
+	0xea, 0x80, 0x08, 0x1e, 0x2c, 0xa7, 0xfb, 0xdc, 0x44, 0x22, 0x3f, 0x3b, 0x70, 0x50, 0xcd, 0x62,
+	0x34, 0x93, 0x38, 0x4f, 0xa5, 0xf6, 0xe1, 0x53, 0xe9, 0x2d, 0xa7, 0x32, 0x9c, 0x49, 0x2c, 0xd3,
+	0x79, 0x0e, 0x87, 0x69, 0x74, 0x1d, 0x2e, 0x67, 0x44, 0x51, 0x84, 0x39, 0xf2, 0x70, 0x34, 0xc9,
+	0xe2, 0x57, 0x6e, 0x5d, 0x57, 0xff, 0x61, 0x1a, 0x5d, 0x07, 0x8b, 0x30, 0x14, 0xc5, 0x05, 0xf2,
+	0xa1, 0x72, 0x79, 0x37, 0x4a, 0xf6, 0x23, 0x43, 0xee, 0x36, 0xde, 0x85, 0xf2, 0x9d, 0x72, 0x21,
+	0xcf, 0xe0, 0x60, 0x15, 0x65, 0x16, 0xce, 0x59, 0xbc, 0xae, 0x21, 0x7a, 0x55, 0x88, 0x59, 0x50,
+	0x32, 0xf6, 0x6b, 0xe8, 0xdd, 0x07, 0xa0, 0xca, 0x2b, 0x2c, 0xe7, 0xba, 0x2b, 0xa7, 0x55, 0x35,
+	0xc4, 0xf1, 0xef, 0x75, 0xd8, 0x5a, 0xd6, 0x93, 0x2d, 0xa8, 0xd9, 0x99, 0x6b, 0x04, 0x35, 0x9a,
+	0x90, 0x0e, 0xac, 0x9b, 0xab, 0xd4, 0x34, 0xd7, 0x8c, 0x50, 0x99, 0xd0, 0x7a, 0x75, 0x42, 0x17,
+	0xa7, 0xb0, 0xf1, 0x3e, 0x53, 0xb8, 0x0f, 0x2d, 0xca, 0x24, 0xf2, 0x69, 0x34, 0xb1, 0x05, 0x98,
+	0xcb, 0xa4, 0x07, 0x2d, 0x7d, 0xdf, 0x82, 0x95, 0xd7, 0xdb, 0x50, 0xd7, 0x2b, 0x98, 0x20, 0x9f,
+	0xc1, 0x0e, 0xc7, 0x49, 0x24, 0xe9, 0x14, 0x43, 0x3b, 0xac, 0x76, 0x8c, 0xb6, 0x4b, 0xfd, 0x0b,
+	0xa3, 0x26, 0x04, 0x1a, 0x1a, 0xa1, 0xa5, 0xcd, 0xfa, 0x9b, 0x7c, 0x0a, 0xdb, 0x0c, 0xaf, 0xa5,
+	0x82, 0x0e, 0x2f, 0x91, 0x8e, 0x2f, 0xa5, 0xdb, 0x3e, 0x72, 0x06, 0xf5, 0xe0, 0x63, 0xa5, 0x0e,
+	0x0a, 0xf6, 0xad, 0x56, 0x12, 0x84, 0x8d, 0x92, 0xb7, 0xf0, 0xe1, 0x79, 0x5b, 0x62, 0x2f, 0xcc,
+	0xf9, 0xe6, 0xd2, 0x9c, 0xff, 0xeb, 0x40, 0xa7, 0xca, 0x05, 0x51, 0x4c, 0xe4, 0x5b, 0xd6, 0x8e,
+	0xf3, 0x96, 0xb5, 0xb3, 0x03, 0x75, 0xb5, 0x66, 0xcc, 0x06, 0x55, 0x9f, 0x4b, 0x8b, 0xb5, 0x5e,
+	0x59, 0xac, 0x5d, 0x68, 0xda, 0x92, 0x34, 0x74, 0x49, 0xac, 0x44, 0x86, 0xd0, 0xe6, 0x28, 0xf2,
+	0x8c, 0x09, 0x54, 0x5c, 0x55, 0xd5, 0xe8, 0xdf, 0xd3, 0x6b, 0xe3, 0xb1, 0xd8, 0xec, 0xbb, 0x63,
+	0x8a, 0x63, 0xc8, 0x79, 0xc6, 0x75, 0x3b, 0xdb, 0x81, 0x11, 0x8e, 0xff, 0xab, 0x41, 0xf7, 0x6e,
+	0x9d, 0x7d, 0x83, 0x0c, 0x05, 0x15, 0x3f, 0xc8, 0x48, 0x22, 0x39, 0x83, 0xed, 0xdc, 0x3c, 0x18,
+	0xe5, 0xcc, 0xd9, 0x5d, 0xd6, 0xf1, 0xcc, 0x33, 0xe5, 0x2d, 0xbe, 0x27, 0x36, 0xe0, 0x56, 0x7e,
+	0xa7, 0xa3, 0x28, 0xc8, 0x53, 0x68, 0xe6, 0x7a, 0x47, 0xea, 0x12, 0x6c, 0x3e, 0x71, 0xcb, 0xb3,
+	0xd5, 0x1d, 0x6a, 0xcf, 0x5b, 0x6f, 0x72, 0x0e, 0xbb, 0x2b, 0x23, 0x6f, 0xdf, 0x9a, 0x6e, 0x09,
+	0x51, 0x19, 0x36, 0x03, 0xb0, 0xdc, 0x02, 0x95, 0xc2, 0xcb, 0xd5, 0xdd, 0xcc, 0x75, 0x2b, 0xcb,
+	0xb1, 0x39, 0xb8, 0x1f, 0xd0, 0xf4, 0xdb, 0xc2, 0x3e, 0xe0, 0xf7, 0xd8, 0x04, 0xf9, 0x0a, 0xf6,
+	0x0c, 0x99, 0x57, 0x19, 0x61, 0x26, 0xaa, 0xa3, 0xcc, 0x41, 0x85, 0x15, 0xc3, 0x8b, 0xd7, 0x37,
+	0x7d, 0xe7, 0xcd, 0x4d, 0xdf, 0xf9, 0xe7, 0xa6, 0xef, 0xfc, 0x72, 0xdb, 0x5f, 0x7b, 0x73, 0xdb,
+	0x5f, 0xfb, 0xf3, 0xb6, 0xbf, 0xf6, 0xf2, 0xe9, 0x2a, 0x83, 0xe9, 0x28, 0x3e, 0x89, 0xf2, 0x5c,
+	0xf8, 0x86, 0x99, 0xc2, 0x8f, 0xc4, 0x8c, 0xc5, 0x27, 0xe6, 0x3f, 0xc4, 0x63, 0xc3, 0xea, 0x51,
+	0x53, 0xbf, 0xf2, 0x5f, 0xfe, 0x3f, 0x00, 0x78, 0x9a, 0xae, 0xc7, 0x5b, 0x08, 0x00, 0x00,
+}
+
+func (m *PendingQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RecurringQueryRun != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RecurringQueryRun))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RecurringQueryId != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RecurringQueryId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecurringQueryBytes != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRecurringQueryBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRecurringQueryRequests != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRecurringQueryRequests))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRecurringQueriesPerOwner != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRecurringQueriesPerOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxRecurringQueriesPerBlock != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRecurringQueriesPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecurringQueryByteDeposit) > 0 {
+		for iNdEx := len(m.RecurringQueryByteDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueryByteDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecurringQueryDeposit) > 0 {
+		for iNdEx := len(m.RecurringQueryDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueryDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextRunHeight != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.NextRunHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Runs != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Run != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Run))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecurringQueryId != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RecurringQueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRecurringQueryId != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.NextRecurringQueryId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RecurringQueryResults) > 0 {
+		for iNdEx := len(m.RecurringQueryResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueryResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecurringQueries) > 0 {
+		for iNdEx := len(m.RecurringQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PendingQueries) > 0 {
+		for iNdEx := len(m.PendingQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovController(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.RecurringQueryId != 0 {
+		n += 1 + sovController(uint64(m.RecurringQueryId))
+	}
+	if m.RecurringQueryRun != 0 {
+		n += 1 + sovController(uint64(m.RecurringQueryRun))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecurringQueryDeposit) > 0 {
+		for _, e := range m.RecurringQueryDeposit {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if len(m.RecurringQueryByteDeposit) > 0 {
+		for _, e := range m.RecurringQueryByteDeposit {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.MaxRecurringQueriesPerBlock != 0 {
+		n += 1 + sovController(uint64(m.MaxRecurringQueriesPerBlock))
+	}
+	if m.MaxRecurringQueriesPerOwner != 0 {
+		n += 1 + sovController(uint64(m.MaxRecurringQueriesPerOwner))
+	}
+	if m.MaxRecurringQueryRequests != 0 {
+		n += 1 + sovController(uint64(m.MaxRecurringQueryRequests))
+	}
+	if m.MaxRecurringQueryBytes != 0 {
+		n += 1 + sovController(uint64(m.MaxRecurringQueryBytes))
+	}
+	return n
+}
+
+func (m *RecurringQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovController(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovController(uint64(m.Interval))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovController(uint64(m.MaxRuns))
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovController(uint64(m.Runs))
+	}
+	if m.NextRunHeight != 0 {
+		n += 1 + sovController(uint64(m.NextRunHeight))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *RecurringQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringQueryId != 0 {
+		n += 1 + sovController(uint64(m.RecurringQueryId))
+	}
+	if m.Run != 0 {
+		n += 1 + sovController(uint64(m.Run))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovController(uint64(m.Height))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingQueries) > 0 {
+		for _, e := range m.PendingQueries {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovController(uint64(l))
+	if len(m.RecurringQueries) > 0 {
+		for _, e := range m.RecurringQueries {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if len(m.RecurringQueryResults) > 0 {
+		for _, e := range m.RecurringQueryResults {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.NextRecurringQueryId != 0 {
+		n += 1 + sovController(uint64(m.NextRecurringQueryId))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, types.RequestQuery{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryId", wireType)
+			}
+			m.RecurringQueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringQueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryRun", wireType)
+			}
+			m.RecurringQueryRun = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringQueryRun |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueryDeposit = append(m.RecurringQueryDeposit, types1.Coin{})
+			if err := m.RecurringQueryDeposit[len(m.RecurringQueryDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryByteDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueryByteDeposit = append(m.RecurringQueryByteDeposit, types1.Coin{})
+			if err := m.RecurringQueryByteDeposit[len(m.RecurringQueryByteDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecurringQueriesPerBlock", wireType)
+			}
+			m.MaxRecurringQueriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecurringQueriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecurringQueriesPerOwner", wireType)
+			}
+			m.MaxRecurringQueriesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecurringQueriesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecurringQueryRequests", wireType)
+			}
+			m.MaxRecurringQueryRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecurringQueryRequests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecurringQueryBytes", wireType)
+			}
+			m.MaxRecurringQueryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecurringQueryBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, types.RequestQuery{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
+			}
+			m.NextRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryId", wireType)
+			}
+			m.RecurringQueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringQueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+			}
+			m.Run = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Run |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, types.ResponseQuery{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingQueries = append(m.PendingQueries, PendingQuery{})
+			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueries = append(m.RecurringQueries, RecurringQuery{})
+			if err := m.RecurringQueries[len(m.RecurringQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringQueryResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringQueryResults = append(m.RecurringQueryResults, RecurringQueryResult{})
+			if err := m.RecurringQueryResults[len(m.RecurringQueryResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecurringQueryId", wireType)
+			}
+			m.NextRecurringQueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecurringQueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipController(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthController
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupController
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthController
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthController        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowController          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupController = fmt.Errorf("proto: unexpected end of group")
+)
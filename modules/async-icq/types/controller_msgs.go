@@ -0,0 +1,75 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRecurringQuery{}
+	_ sdk.Msg = &MsgCancelRecurringQuery{}
+	_ sdk.Msg = &MsgUpdateControllerParams{}
+)
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterRecurringQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterRecurringQuery message.
+func (m *MsgRegisterRecurringQuery) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterRecurringQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.Wrap(err, "invalid owner address")
+	}
+
+	return ValidateRecurringQuerySchedule(m.ChannelId, m.Requests, m.Interval, m.MaxRuns, m.RelativeTimeout)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelRecurringQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelRecurringQuery message.
+func (m *MsgCancelRecurringQuery) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelRecurringQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.Wrap(err, "invalid owner address")
+	}
+	if m.Id == 0 {
+		return errors.Wrap(ErrInvalidQuery, "recurring query ID cannot be 0")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateControllerParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateControllerParams message.
+func (m *MsgUpdateControllerParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateControllerParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
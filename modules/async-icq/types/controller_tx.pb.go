@@ -0,0 +1,1572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icq/v1/controller_tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterRecurringQuery is the Msg/RegisterRecurringQuery request type.
+type MsgRegisterRecurringQuery struct {
+	// owner is the address registering the query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// channel_id is the controller channel the query is sent on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// requests are the ABCI query requests sent to the host chain.
+	Requests []types.RequestQuery `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests"`
+	// interval is the number of blocks between two runs of the query.
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_runs is the number of times the query is sent.
+	MaxRuns uint64 `protobuf:"varint,5,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// relative_timeout is the timeout of each run, in nanoseconds after the block time it is sent at.
+	RelativeTimeout uint64 `protobuf:"varint,6,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+}
+
+func (m *MsgRegisterRecurringQuery) Reset()         { *m = MsgRegisterRecurringQuery{} }
+func (m *MsgRegisterRecurringQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRecurringQuery) ProtoMessage()    {}
+func (*MsgRegisterRecurringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{0}
+}
+func (m *MsgRegisterRecurringQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRecurringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRecurringQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRecurringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRecurringQuery.Merge(m, src)
+}
+func (m *MsgRegisterRecurringQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRecurringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRecurringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRecurringQuery proto.InternalMessageInfo
+
+func (m *MsgRegisterRecurringQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterRecurringQuery) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgRegisterRecurringQuery) GetRequests() []types.RequestQuery {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *MsgRegisterRecurringQuery) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgRegisterRecurringQuery) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *MsgRegisterRecurringQuery) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+// MsgRegisterRecurringQueryResponse defines the response structure for executing a
+// MsgRegisterRecurringQuery message.
+type MsgRegisterRecurringQueryResponse struct {
+	// id is the ID of the registered recurring query.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRegisterRecurringQueryResponse) Reset()         { *m = MsgRegisterRecurringQueryResponse{} }
+func (m *MsgRegisterRecurringQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRecurringQueryResponse) ProtoMessage()    {}
+func (*MsgRegisterRecurringQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{1}
+}
+func (m *MsgRegisterRecurringQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRecurringQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRecurringQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRecurringQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRecurringQueryResponse.Merge(m, src)
+}
+func (m *MsgRegisterRecurringQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRecurringQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRecurringQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRecurringQueryResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterRecurringQueryResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelRecurringQuery is the Msg/CancelRecurringQuery request type.
+type MsgCancelRecurringQuery struct {
+	// owner is the address which registered the query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id is the ID of the recurring query.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelRecurringQuery) Reset()         { *m = MsgCancelRecurringQuery{} }
+func (m *MsgCancelRecurringQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringQuery) ProtoMessage()    {}
+func (*MsgCancelRecurringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{2}
+}
+func (m *MsgCancelRecurringQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringQuery.Merge(m, src)
+}
+func (m *MsgCancelRecurringQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringQuery proto.InternalMessageInfo
+
+func (m *MsgCancelRecurringQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelRecurringQuery) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelRecurringQueryResponse defines the response structure for executing a
+// MsgCancelRecurringQuery message.
+type MsgCancelRecurringQueryResponse struct {
+}
+
+func (m *MsgCancelRecurringQueryResponse) Reset()         { *m = MsgCancelRecurringQueryResponse{} }
+func (m *MsgCancelRecurringQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringQueryResponse) ProtoMessage()    {}
+func (*MsgCancelRecurringQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{3}
+}
+func (m *MsgCancelRecurringQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringQueryResponse.Merge(m, src)
+}
+func (m *MsgCancelRecurringQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringQueryResponse proto.InternalMessageInfo
+
+// MsgUpdateControllerParams is the Msg/UpdateControllerParams request type.
+type MsgUpdateControllerParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the interchain query controller parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params ControllerParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateControllerParams) Reset()         { *m = MsgUpdateControllerParams{} }
+func (m *MsgUpdateControllerParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateControllerParams) ProtoMessage()    {}
+func (*MsgUpdateControllerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{4}
+}
+func (m *MsgUpdateControllerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateControllerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateControllerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateControllerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateControllerParams.Merge(m, src)
+}
+func (m *MsgUpdateControllerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateControllerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateControllerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateControllerParams proto.InternalMessageInfo
+
+func (m *MsgUpdateControllerParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateControllerParams) GetParams() ControllerParams {
+	if m != nil {
+		return m.Params
+	}
+	return ControllerParams{}
+}
+
+// MsgUpdateControllerParamsResponse defines the response structure for executing a
+// MsgUpdateControllerParams message.
+type MsgUpdateControllerParamsResponse struct {
+}
+
+func (m *MsgUpdateControllerParamsResponse) Reset()         { *m = MsgUpdateControllerParamsResponse{} }
+func (m *MsgUpdateControllerParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateControllerParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateControllerParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e980ba4b15b46b7, []int{5}
+}
+func (m *MsgUpdateControllerParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateControllerParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateControllerParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateControllerParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateControllerParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateControllerParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateControllerParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateControllerParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateControllerParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterRecurringQuery)(nil), "icq.v1.MsgRegisterRecurringQuery")
+	proto.RegisterType((*MsgRegisterRecurringQueryResponse)(nil), "icq.v1.MsgRegisterRecurringQueryResponse")
+	proto.RegisterType((*MsgCancelRecurringQuery)(nil), "icq.v1.MsgCancelRecurringQuery")
+	proto.RegisterType((*MsgCancelRecurringQueryResponse)(nil), "icq.v1.MsgCancelRecurringQueryResponse")
+	proto.RegisterType((*MsgUpdateControllerParams)(nil), "icq.v1.MsgUpdateControllerParams")
+	proto.RegisterType((*MsgUpdateControllerParamsResponse)(nil), "icq.v1.MsgUpdateControllerParamsResponse")
+}
+
+func init() { proto.RegisterFile("icq/v1/controller_tx.proto", fileDescriptor_5e980ba4b15b46b7) }
+
+var fileDescriptor_5e980ba4b15b46b7 = []byte{
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc7, 0x63, 0x37, 0xcd, 0xdb, 0x5e, 0xf5, 0x16, 0x64, 0x55, 0xad, 0x6b, 0x54, 0xb7, 0x09,
+	0x03, 0x49, 0xa5, 0xd8, 0x34, 0x95, 0x32, 0x74, 0x41, 0xa4, 0x13, 0x43, 0xa4, 0x62, 0x60, 0x61,
+	0x09, 0x17, 0xfb, 0xea, 0x9c, 0x64, 0xdf, 0x39, 0x77, 0xe7, 0x90, 0x6c, 0x88, 0x4f, 0xc0, 0x8a,
+	0xf8, 0x12, 0x1d, 0xf8, 0x10, 0x19, 0x2b, 0x26, 0x26, 0x84, 0x92, 0xa1, 0x5f, 0x03, 0xd9, 0x67,
+	0x27, 0x05, 0x62, 0x65, 0x60, 0xbb, 0x7b, 0x9e, 0xdf, 0xfd, 0x9f, 0xff, 0xdd, 0x73, 0x7a, 0x80,
+	0x81, 0xdd, 0xa1, 0x3d, 0x3a, 0xb3, 0x5d, 0x4a, 0x04, 0xa3, 0x41, 0x80, 0x58, 0x4f, 0x8c, 0xad,
+	0x88, 0x51, 0x41, 0xb5, 0x0a, 0x76, 0x87, 0xd6, 0xe8, 0xcc, 0x38, 0x70, 0x29, 0x0f, 0x29, 0xb7,
+	0x43, 0xee, 0x27, 0x68, 0xc8, 0x7d, 0x09, 0x18, 0x07, 0x7f, 0x1d, 0xce, 0x12, 0x7b, 0x3e, 0xf5,
+	0x69, 0xba, 0xb4, 0x93, 0x55, 0x16, 0x3d, 0x94, 0x3a, 0x3d, 0x99, 0x90, 0x9b, 0x2c, 0xf5, 0x48,
+	0x20, 0xe2, 0x21, 0x16, 0x62, 0x22, 0x6c, 0xd8, 0x77, 0xb1, 0x2d, 0x26, 0x11, 0xca, 0x92, 0xb5,
+	0xcf, 0x2a, 0x38, 0xec, 0x72, 0xdf, 0x41, 0x3e, 0xe6, 0x02, 0x31, 0x07, 0xb9, 0x31, 0x63, 0x98,
+	0xf8, 0x2f, 0x63, 0xc4, 0x26, 0x9a, 0x05, 0x36, 0xe9, 0x7b, 0x82, 0x98, 0xae, 0x9c, 0x28, 0xf5,
+	0xed, 0x8e, 0xfe, 0xed, 0x6b, 0x73, 0x2f, 0xd3, 0x7e, 0xee, 0x79, 0x0c, 0x71, 0xfe, 0x4a, 0x24,
+	0xb4, 0x23, 0x31, 0xed, 0x08, 0x00, 0x77, 0x00, 0x09, 0x41, 0x41, 0x0f, 0x7b, 0xba, 0x9a, 0x1c,
+	0x72, 0xb6, 0xb3, 0xc8, 0x0b, 0x4f, 0x7b, 0x06, 0xb6, 0x18, 0x1a, 0xc6, 0x88, 0x0b, 0xae, 0x6f,
+	0x9c, 0x6c, 0xd4, 0x77, 0x5a, 0x47, 0xd6, 0xd2, 0x9c, 0x95, 0x98, 0xb3, 0x1c, 0x09, 0xa4, 0xf5,
+	0x3b, 0xe5, 0xe9, 0x8f, 0xe3, 0x92, 0xb3, 0x38, 0xa4, 0x19, 0x60, 0x0b, 0x13, 0x81, 0xd8, 0x08,
+	0x06, 0x7a, 0xf9, 0x44, 0xa9, 0x97, 0x9d, 0xc5, 0x5e, 0x3b, 0x04, 0x5b, 0x21, 0x1c, 0xf7, 0x58,
+	0x4c, 0xb8, 0xbe, 0x99, 0xe6, 0xfe, 0x0b, 0xe1, 0xd8, 0x89, 0x09, 0xd7, 0x1a, 0xe0, 0x21, 0x43,
+	0x01, 0x14, 0x78, 0x84, 0x7a, 0x02, 0x87, 0x88, 0xc6, 0x42, 0xaf, 0xa4, 0xc8, 0x83, 0x3c, 0xfe,
+	0x5a, 0x86, 0x2f, 0xc0, 0xc7, 0xbb, 0x9b, 0x53, 0x79, 0x9b, 0xda, 0x39, 0xa8, 0x16, 0x3e, 0x8d,
+	0x83, 0x78, 0x44, 0x09, 0x47, 0xda, 0x2e, 0x50, 0xb1, 0x97, 0xbe, 0x4f, 0xd9, 0x51, 0xb1, 0x57,
+	0x43, 0xe0, 0xa0, 0xcb, 0xfd, 0x4b, 0x48, 0x5c, 0x14, 0xfc, 0xe3, 0x6b, 0x4a, 0x69, 0x35, 0x97,
+	0xfe, 0xcd, 0x5b, 0x15, 0x1c, 0x17, 0x94, 0xc9, 0x9d, 0xd5, 0xbe, 0x28, 0x69, 0x6b, 0xdf, 0x44,
+	0x1e, 0x14, 0xe8, 0x72, 0xf1, 0x8d, 0xae, 0x20, 0x83, 0x21, 0xd7, 0xda, 0x60, 0x1b, 0xc6, 0x62,
+	0x40, 0x19, 0x16, 0x93, 0xb5, 0x86, 0x96, 0xa8, 0xd6, 0x06, 0x95, 0x28, 0x55, 0x48, 0x8d, 0xed,
+	0xb4, 0x74, 0x4b, 0xfe, 0x64, 0xeb, 0xcf, 0x0a, 0x59, 0xf3, 0x32, 0xfa, 0x62, 0x37, 0x31, 0xbf,
+	0xd4, 0xa9, 0x3d, 0x06, 0xd5, 0x42, 0x73, 0xf9, 0x15, 0x5a, 0x53, 0x15, 0xfc, 0xbf, 0x4c, 0x76,
+	0xb9, 0xaf, 0x5d, 0x83, 0xfd, 0x82, 0xbf, 0x5a, 0xcd, 0x8d, 0x14, 0xf6, 0xcc, 0x68, 0xac, 0x45,
+	0x16, 0x6d, 0x7d, 0x07, 0xf6, 0x56, 0xf6, 0xf0, 0xf8, 0x9e, 0xc4, 0x2a, 0xc0, 0x78, 0xb2, 0x06,
+	0x58, 0x54, 0xb8, 0x06, 0xfb, 0x05, 0xad, 0xb9, 0x7f, 0x93, 0xd5, 0x88, 0xd1, 0x58, 0x8b, 0xe4,
+	0x75, 0x8c, 0xcd, 0x0f, 0x77, 0x37, 0xa7, 0x4a, 0xe7, 0x6a, 0x3a, 0x33, 0x95, 0xdb, 0x99, 0xa9,
+	0xfc, 0x9c, 0x99, 0xca, 0xa7, 0xb9, 0x59, 0xba, 0x9d, 0x9b, 0xa5, 0xef, 0x73, 0xb3, 0xf4, 0xb6,
+	0xed, 0x63, 0x31, 0x88, 0xfb, 0x96, 0x4b, 0xc3, 0x6c, 0x70, 0xd8, 0xb8, 0xef, 0x36, 0x61, 0x14,
+	0x71, 0x3b, 0xa4, 0x5e, 0x1c, 0x20, 0x6e, 0x43, 0x3e, 0x21, 0x6e, 0x53, 0x8e, 0xa4, 0xa7, 0x72,
+	0x80, 0xf4, 0x2b, 0xe9, 0x04, 0x39, 0xff, 0x35, 0x00, 0xf7, 0x18, 0x4a, 0xe9, 0xe7, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ControllerMsgClient is the client API for ControllerMsg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControllerMsgClient interface {
+	// RegisterRecurringQuery registers a query sent every interval blocks, escrowing the recurring query deposit from
+	// the owner.
+	RegisterRecurringQuery(ctx context.Context, in *MsgRegisterRecurringQuery, opts ...grpc.CallOption) (*MsgRegisterRecurringQueryResponse, error)
+	// CancelRecurringQuery removes a recurring query and its latest result, refunding its deposit to the owner.
+	CancelRecurringQuery(ctx context.Context, in *MsgCancelRecurringQuery, opts ...grpc.CallOption) (*MsgCancelRecurringQueryResponse, error)
+	// UpdateControllerParams defines a governance operation for updating the interchain query controller parameters.
+	UpdateControllerParams(ctx context.Context, in *MsgUpdateControllerParams, opts ...grpc.CallOption) (*MsgUpdateControllerParamsResponse, error)
+}
+
+type controllerMsgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewControllerMsgClient(cc grpc1.ClientConn) ControllerMsgClient {
+	return &controllerMsgClient{cc}
+}
+
+func (c *controllerMsgClient) RegisterRecurringQuery(ctx context.Context, in *MsgRegisterRecurringQuery, opts ...grpc.CallOption) (*MsgRegisterRecurringQueryResponse, error) {
+	out := new(MsgRegisterRecurringQueryResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.ControllerMsg/RegisterRecurringQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerMsgClient) CancelRecurringQuery(ctx context.Context, in *MsgCancelRecurringQuery, opts ...grpc.CallOption) (*MsgCancelRecurringQueryResponse, error) {
+	out := new(MsgCancelRecurringQueryResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.ControllerMsg/CancelRecurringQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerMsgClient) UpdateControllerParams(ctx context.Context, in *MsgUpdateControllerParams, opts ...grpc.CallOption) (*MsgUpdateControllerParamsResponse, error) {
+	out := new(MsgUpdateControllerParamsResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.ControllerMsg/UpdateControllerParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControllerMsgServer is the server API for ControllerMsg service.
+type ControllerMsgServer interface {
+	// RegisterRecurringQuery registers a query sent every interval blocks, escrowing the recurring query deposit from
+	// the owner.
+	RegisterRecurringQuery(context.Context, *MsgRegisterRecurringQuery) (*MsgRegisterRecurringQueryResponse, error)
+	// CancelRecurringQuery removes a recurring query and its latest result, refunding its deposit to the owner.
+	CancelRecurringQuery(context.Context, *MsgCancelRecurringQuery) (*MsgCancelRecurringQueryResponse, error)
+	// UpdateControllerParams defines a governance operation for updating the interchain query controller parameters.
+	UpdateControllerParams(context.Context, *MsgUpdateControllerParams) (*MsgUpdateControllerParamsResponse, error)
+}
+
+// UnimplementedControllerMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedControllerMsgServer struct {
+}
+
+func (*UnimplementedControllerMsgServer) RegisterRecurringQuery(ctx context.Context, req *MsgRegisterRecurringQuery) (*MsgRegisterRecurringQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRecurringQuery not implemented")
+}
+func (*UnimplementedControllerMsgServer) CancelRecurringQuery(ctx context.Context, req *MsgCancelRecurringQuery) (*MsgCancelRecurringQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringQuery not implemented")
+}
+func (*UnimplementedControllerMsgServer) UpdateControllerParams(ctx context.Context, req *MsgUpdateControllerParams) (*MsgUpdateControllerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateControllerParams not implemented")
+}
+
+func RegisterControllerMsgServer(s grpc1.Server, srv ControllerMsgServer) {
+	s.RegisterService(&_ControllerMsg_serviceDesc, srv)
+}
+
+func _ControllerMsg_RegisterRecurringQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRecurringQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerMsgServer).RegisterRecurringQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.ControllerMsg/RegisterRecurringQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerMsgServer).RegisterRecurringQuery(ctx, req.(*MsgRegisterRecurringQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerMsg_CancelRecurringQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerMsgServer).CancelRecurringQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.ControllerMsg/CancelRecurringQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerMsgServer).CancelRecurringQuery(ctx, req.(*MsgCancelRecurringQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerMsg_UpdateControllerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateControllerParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerMsgServer).UpdateControllerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.ControllerMsg/UpdateControllerParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerMsgServer).UpdateControllerParams(ctx, req.(*MsgUpdateControllerParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var ControllerMsg_serviceDesc = _ControllerMsg_serviceDesc
+var _ControllerMsg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "icq.v1.ControllerMsg",
+	HandlerType: (*ControllerMsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterRecurringQuery",
+			Handler:    _ControllerMsg_RegisterRecurringQuery_Handler,
+		},
+		{
+			MethodName: "CancelRecurringQuery",
+			Handler:    _ControllerMsg_CancelRecurringQuery_Handler,
+		},
+		{
+			MethodName: "UpdateControllerParams",
+			Handler:    _ControllerMsg_UpdateControllerParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "icq/v1/controller_tx.proto",
+}
+
+func (m *MsgRegisterRecurringQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRecurringQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRecurringQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintControllerTx(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintControllerTx(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintControllerTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintControllerTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintControllerTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintControllerTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRecurringQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRecurringQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRecurringQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintControllerTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintControllerTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintControllerTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateControllerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateControllerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateControllerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintControllerTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintControllerTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateControllerParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateControllerParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateControllerParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintControllerTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovControllerTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterRecurringQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovControllerTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovControllerTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovControllerTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovControllerTx(uint64(m.Interval))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovControllerTx(uint64(m.MaxRuns))
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovControllerTx(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
+func (m *MsgRegisterRecurringQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovControllerTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovControllerTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovControllerTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateControllerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovControllerTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovControllerTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateControllerParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovControllerTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozControllerTx(x uint64) (n int) {
+	return sovControllerTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterRecurringQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRecurringQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRecurringQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, types.RequestQuery{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterRecurringQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRecurringQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRecurringQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecurringQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecurringQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecurringQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecurringQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecurringQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecurringQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateControllerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateControllerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateControllerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateControllerParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateControllerParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateControllerParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipControllerTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowControllerTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowControllerTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthControllerTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupControllerTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthControllerTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthControllerTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowControllerTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupControllerTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,9 +5,23 @@ import (
 )
 
 var (
-	ErrUnknownDataType    = sdkerrors.Register(ModuleName, 1, "unknown data type")
-	ErrInvalidChannelFlow = sdkerrors.Register(ModuleName, 2, "invalid message sent to channel end")
-	ErrInvalidHostPort    = sdkerrors.Register(ModuleName, 3, "invalid host port")
-	ErrHostDisabled       = sdkerrors.Register(ModuleName, 4, "host is disabled")
-	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 5, "invalid version")
+	ErrUnknownDataType        = sdkerrors.Register(ModuleName, 1, "unknown data type")
+	ErrInvalidChannelFlow     = sdkerrors.Register(ModuleName, 2, "invalid message sent to channel end")
+	ErrInvalidHostPort        = sdkerrors.Register(ModuleName, 3, "invalid host port")
+	ErrHostDisabled           = sdkerrors.Register(ModuleName, 4, "host is disabled")
+	ErrInvalidVersion         = sdkerrors.Register(ModuleName, 5, "invalid version")
+	ErrInvalidQuery           = sdkerrors.Register(ModuleName, 6, "invalid query")
+	ErrPendingQueryNotFound   = sdkerrors.Register(ModuleName, 7, "pending query not found")
+	ErrInvalidAck             = sdkerrors.Register(ModuleName, 8, "invalid acknowledgement")
+	ErrInvalidProof           = sdkerrors.Register(ModuleName, 9, "invalid query proof")
+	ErrTooManyRequests        = sdkerrors.Register(ModuleName, 10, "too many query requests")
+	ErrResponseTooLarge       = sdkerrors.Register(ModuleName, 11, "query responses too large")
+	ErrGasBudgetExceeded      = sdkerrors.Register(ModuleName, 12, "query gas budget exceeded")
+	ErrInvalidPermissions     = sdkerrors.Register(ModuleName, 13, "invalid query permissions")
+	ErrInvalidEncoding        = sdkerrors.Register(ModuleName, 14, "invalid packet data encoding")
+	ErrQuotaExceeded          = sdkerrors.Register(ModuleName, 15, "query quota exceeded")
+	ErrInvalidQuota           = sdkerrors.Register(ModuleName, 16, "invalid query quota")
+	ErrQueryNotRegistered     = sdkerrors.Register(ModuleName, 17, "query path not registered")
+	ErrRecurringQueryNotFound = sdkerrors.Register(ModuleName, 18, "recurring query not found")
+	ErrRecurringQueryLimit    = sdkerrors.Register(ModuleName, 19, "recurring query limit reached")
 )
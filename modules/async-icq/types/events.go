@@ -2,8 +2,23 @@ package types
 
 // ICQ Interchain Query events
 const (
-	EventTypePacketError = "icq_packet_error"
+	EventTypePacketError   = "icq_packet_error"
+	EventTypePacketSuccess = "icq_packet_success"
+	EventTypeQuerySent     = "icq_query_sent"
+	EventTypeQueryResult   = "icq_query_result"
+	EventTypeQueryTimeout  = "icq_query_timeout"
+	EventTypeCallbackError = "icq_callback_error"
 
-	AttributeKeyAckError      = "error"
-	AttributeKeyHostChannelID = "host_channel_id"
+	EventTypeRecurringQueryRegistered = "icq_recurring_query_registered"
+	EventTypeRecurringQueryCancelled  = "icq_recurring_query_cancelled"
+	EventTypeRecurringQuerySendError  = "icq_recurring_query_send_error"
+
+	AttributeKeyAckError            = "error"
+	AttributeKeyHostChannelID       = "host_channel_id"
+	AttributeKeyControllerChannelID = "controller_channel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeySuccess             = "success"
+	AttributeKeyRecurringQueryID    = "recurring_query_id"
+	AttributeKeyRecurringQueryRun   = "run"
+	AttributeKeyOwner               = "owner"
 )
@@ -1,7 +1,11 @@
 package types
 
 import (
-	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 // DefaultGenesis creates and returns the default interchain query GenesisState
@@ -25,5 +29,144 @@ func (gs GenesisState) Validate() error {
 	if err := host.PortIdentifierValidator(gs.HostPort); err != nil {
 		return err
 	}
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	channels := make(map[string]bool)
+	for _, permissions := range gs.ChannelPermissions {
+		if err := permissions.Validate(); err != nil {
+			return err
+		}
+		if channels[permissions.ChannelId] {
+			return errors.Wrapf(ErrInvalidPermissions, "duplicate permissions for channel %s", permissions.ChannelId)
+		}
+		channels[permissions.ChannelId] = true
+	}
+
+	clients := make(map[string]bool)
+	for _, permissions := range gs.ClientPermissions {
+		if err := permissions.Validate(); err != nil {
+			return err
+		}
+		if clients[permissions.ClientId] {
+			return errors.Wrapf(ErrInvalidPermissions, "duplicate permissions for client %s", permissions.ClientId)
+		}
+		clients[permissions.ClientId] = true
+	}
+
+	quotas := make(map[string]bool)
+	for _, quota := range gs.ChannelQuotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if quotas[quota.ChannelId] {
+			return errors.Wrapf(ErrInvalidQuota, "duplicate quota for channel %s", quota.ChannelId)
+		}
+		quotas[quota.ChannelId] = true
+	}
+
+	stats := make(map[string]bool)
+	for _, s := range gs.ChannelStats {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := string(ChannelQueryStatsKey(s.ChannelId, s.Epoch))
+		if stats[key] {
+			return errors.Wrapf(ErrInvalidQuota, "duplicate stats for channel %s in epoch %d", s.ChannelId, s.Epoch)
+		}
+		stats[key] = true
+	}
+
+	sharedEpochs := make(map[uint64]bool)
+	for _, s := range gs.SharedStats {
+		if sharedEpochs[s.Epoch] {
+			return errors.Wrapf(ErrInvalidQuota, "duplicate shared stats in epoch %d", s.Epoch)
+		}
+		sharedEpochs[s.Epoch] = true
+	}
+	return nil
+}
+
+// DefaultControllerGenesis creates and returns the default interchain query controller genesis state
+func DefaultControllerGenesis() *ControllerGenesisState {
+	return &ControllerGenesisState{
+		Params:               DefaultControllerParams(),
+		NextRecurringQueryId: 1,
+	}
+}
+
+// Validate performs basic validation of the ControllerGenesisState
+func (gs ControllerGenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, query := range gs.PendingQueries {
+		if err := query.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PendingQueryKey(query.ChannelId, query.Sequence))
+		if seen[key] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate pending query %s/%d", query.ChannelId, query.Sequence)
+		}
+		seen[key] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	recurringQueries := make(map[uint64]bool)
+	for _, query := range gs.RecurringQueries {
+		if err := query.Validate(); err != nil {
+			return err
+		}
+		if recurringQueries[query.Id] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate recurring query %d", query.Id)
+		}
+		if query.Id >= gs.NextRecurringQueryId {
+			return errors.Wrapf(ErrInvalidQuery, "recurring query ID %d must be lower than the next ID %d", query.Id, gs.NextRecurringQueryId)
+		}
+		recurringQueries[query.Id] = true
+	}
+
+	results := make(map[uint64]bool)
+	for _, result := range gs.RecurringQueryResults {
+		if err := result.Validate(); err != nil {
+			return err
+		}
+		if !recurringQueries[result.RecurringQueryId] {
+			return errors.Wrapf(ErrRecurringQueryNotFound, "result of recurring query %d", result.RecurringQueryId)
+		}
+		if results[result.RecurringQueryId] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate result of recurring query %d", result.RecurringQueryId)
+		}
+		results[result.RecurringQueryId] = true
+	}
+	return nil
+}
+
+// Validate performs basic validation of the PendingQuery
+func (q PendingQuery) Validate() error {
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return err
+	}
+	if q.Sequence == 0 {
+		return errors.Wrap(ErrInvalidQuery, "sequence cannot be 0")
+	}
+	if len(q.Requests) == 0 {
+		return errors.Wrap(ErrInvalidQuery, "requests cannot be empty")
+	}
+	if q.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(q.Sender); err != nil {
+			return errors.Wrapf(ErrInvalidQuery, "invalid sender address: %v", err)
+		}
+	}
+	if q.RecurringQueryId == 0 && q.RecurringQueryRun != 0 {
+		return errors.Wrap(ErrInvalidQuery, "run of a query which is not recurring")
+	}
+	if q.RecurringQueryId != 0 && q.RecurringQueryRun == 0 {
+		return errors.Wrap(ErrInvalidQuery, "run of a recurring query cannot be 0")
+	}
+	return nil
 }
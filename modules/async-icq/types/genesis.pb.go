@@ -25,8 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the interchain query genesis state
 type GenesisState struct {
-	HostPort string `protobuf:"bytes,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
-	Params   Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	HostPort           string                    `protobuf:"bytes,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	Params             Params                    `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ChannelPermissions []ChannelQueryPermissions `protobuf:"bytes,5,rep,name=channel_permissions,json=channelPermissions,proto3" json:"channel_permissions"`
+	ClientPermissions  []ClientQueryPermissions  `protobuf:"bytes,6,rep,name=client_permissions,json=clientPermissions,proto3" json:"client_permissions"`
+	ChannelQuotas      []ChannelQueryQuota       `protobuf:"bytes,7,rep,name=channel_quotas,json=channelQuotas,proto3" json:"channel_quotas"`
+	ChannelStats       []ChannelQueryStats       `protobuf:"bytes,8,rep,name=channel_stats,json=channelStats,proto3" json:"channel_stats"`
+	SharedStats        []SharedQueryStats        `protobuf:"bytes,9,rep,name=shared_stats,json=sharedStats,proto3" json:"shared_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +81,41 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChannelPermissions() []ChannelQueryPermissions {
+	if m != nil {
+		return m.ChannelPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientPermissions() []ClientQueryPermissions {
+	if m != nil {
+		return m.ClientPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelQuotas() []ChannelQueryQuota {
+	if m != nil {
+		return m.ChannelQuotas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelStats() []ChannelQueryStats {
+	if m != nil {
+		return m.ChannelStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSharedStats() []SharedQueryStats {
+	if m != nil {
+		return m.SharedStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "icq.v1.GenesisState")
 }
@@ -83,22 +123,31 @@ func init() {
 func init() { proto.RegisterFile("icq/v1/genesis.proto", fileDescriptor_e676a717932d9bd5) }
 
 var fileDescriptor_e676a717932d9bd5 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x62, 0xcb, 0x4c, 0x2e, 0xd4, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b,
-	0xe9, 0x83, 0x58, 0x10, 0x59, 0x29, 0x01, 0xa8, 0x1e, 0x90, 0x22, 0xb0, 0x88, 0x52, 0x24, 0x17,
-	0x8f, 0x3b, 0xc4, 0x80, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x69, 0x2e, 0xce, 0x8c, 0xfc, 0xe2,
-	0x92, 0xf8, 0x82, 0xfc, 0xa2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x0e, 0x90, 0x40,
-	0x40, 0x7e, 0x51, 0x89, 0x90, 0x0e, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0x8b,
-	0x02, 0xa3, 0x06, 0xb7, 0x11, 0x9f, 0x1e, 0xc4, 0x36, 0xbd, 0x00, 0xb0, 0xa8, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x35, 0x4e, 0xfe, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c,
-	0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x58, 0x50, 0x50, 0xac, 0x9f, 0x9b,
-	0x9f, 0x52, 0x9a, 0x93, 0x5a, 0xac, 0x9f, 0x58, 0x5c, 0x99, 0x97, 0xac, 0x0b, 0x76, 0xaf, 0x85,
-	0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xc9, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0x5f, 0xa7, 0xdb, 0xfa, 0x00, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xda, 0x30,
+	0x18, 0xc6, 0x13, 0xc1, 0x32, 0x30, 0x0c, 0x6d, 0x1e, 0x87, 0x8c, 0x49, 0x01, 0xed, 0xc4, 0x61,
+	0xc4, 0x83, 0x49, 0xbb, 0xef, 0x8f, 0xb6, 0x6b, 0x00, 0x69, 0x87, 0x5e, 0x90, 0x31, 0x56, 0x62,
+	0x89, 0xc4, 0x21, 0xaf, 0x83, 0xc4, 0xb7, 0xe8, 0xc7, 0xe2, 0xc8, 0xad, 0x3d, 0x55, 0x15, 0x7c,
+	0x91, 0x2a, 0x76, 0xd2, 0x52, 0xb5, 0xdc, 0xa2, 0xdf, 0xfb, 0x3c, 0xbf, 0xbc, 0xb2, 0x5e, 0xd4,
+	0x15, 0x6c, 0x43, 0xb6, 0x63, 0x12, 0xf2, 0x84, 0x83, 0x00, 0x3f, 0xcd, 0xa4, 0x92, 0xd8, 0x11,
+	0x6c, 0xe3, 0x6f, 0xc7, 0xbd, 0x6e, 0x28, 0x43, 0xa9, 0x11, 0x29, 0xbe, 0xcc, 0xb4, 0xf7, 0xbe,
+	0xec, 0x14, 0x21, 0x4d, 0xbe, 0xdc, 0xd4, 0x50, 0xfb, 0x9f, 0x31, 0xcc, 0x15, 0x55, 0x1c, 0x7f,
+	0x46, 0xcd, 0x48, 0x82, 0x5a, 0xa4, 0x32, 0x53, 0xae, 0x3d, 0xb0, 0x87, 0xcd, 0x59, 0xa3, 0x00,
+	0x81, 0xcc, 0x14, 0xfe, 0x8a, 0x9c, 0x94, 0x66, 0x34, 0x06, 0xb7, 0x3e, 0xb0, 0x87, 0xad, 0x49,
+	0xc7, 0x37, 0xbf, 0xf3, 0x03, 0x4d, 0x7f, 0xd5, 0xf7, 0x77, 0x7d, 0x6b, 0x56, 0x66, 0xf0, 0x7f,
+	0xf4, 0x91, 0x45, 0x34, 0x49, 0xf8, 0x7a, 0x91, 0xf2, 0x2c, 0x16, 0x00, 0x42, 0x26, 0xe0, 0xbe,
+	0x19, 0xd4, 0x86, 0xad, 0x49, 0xbf, 0xaa, 0xfe, 0x36, 0x91, 0x69, 0xce, 0xb3, 0x5d, 0xf0, 0x14,
+	0x2b, 0x5d, 0xb8, 0x34, 0x9c, 0x4d, 0xf0, 0x1c, 0x61, 0xb6, 0x16, 0x3c, 0x51, 0xcf, 0xb4, 0x8e,
+	0xd6, 0x7a, 0x8f, 0x5a, 0x9d, 0xb8, 0x60, 0xfd, 0x60, 0xfa, 0xe7, 0xd2, 0xbf, 0xa8, 0x53, 0x2d,
+	0xbb, 0xc9, 0xa5, 0xa2, 0xe0, 0xbe, 0xd5, 0xc2, 0x4f, 0xaf, 0xed, 0x39, 0x2d, 0x12, 0xa5, 0xeb,
+	0x1d, 0xab, 0x06, 0x45, 0x0b, 0xff, 0x41, 0x15, 0x58, 0x80, 0xa2, 0x0a, 0xdc, 0xc6, 0x65, 0x4d,
+	0xf1, 0xe2, 0xd5, 0x4a, 0xed, 0xb2, 0xa5, 0x19, 0xfe, 0x89, 0xda, 0x10, 0xd1, 0x8c, 0xaf, 0x4a,
+	0x49, 0x53, 0x4b, 0xdc, 0x4a, 0x32, 0xd7, 0xb3, 0x17, 0x8e, 0x96, 0xe9, 0x18, 0x14, 0xec, 0x8f,
+	0x9e, 0x7d, 0x38, 0x7a, 0xf6, 0xfd, 0xd1, 0xb3, 0xaf, 0x4f, 0x9e, 0x75, 0x38, 0x79, 0xd6, 0xed,
+	0xc9, 0xb3, 0xae, 0x7e, 0x84, 0x42, 0x45, 0xf9, 0xd2, 0x67, 0x32, 0x26, 0x4c, 0x42, 0x2c, 0x81,
+	0x88, 0x25, 0x1b, 0xd1, 0x34, 0x05, 0x12, 0xcb, 0x55, 0xbe, 0xe6, 0x40, 0x28, 0xec, 0x12, 0x36,
+	0x32, 0xe7, 0xf2, 0x8d, 0xa8, 0x5d, 0xca, 0x61, 0xe9, 0xe8, 0x93, 0xf9, 0xfe, 0x30, 0x00, 0xc2,
+	0x32, 0x4b, 0xcf, 0x7a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +170,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SharedStats) > 0 {
+		for iNdEx := len(m.SharedStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharedStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChannelStats) > 0 {
+		for iNdEx := len(m.ChannelStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelQuotas) > 0 {
+		for iNdEx := len(m.ChannelQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClientPermissions) > 0 {
+		for iNdEx := len(m.ClientPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChannelPermissions) > 0 {
+		for iNdEx := len(m.ChannelPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -164,6 +283,36 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelPermissions) > 0 {
+		for _, e := range m.ChannelPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientPermissions) > 0 {
+		for _, e := range m.ClientPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelQuotas) > 0 {
+		for _, e := range m.ChannelQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelStats) > 0 {
+		for _, e := range m.ChannelStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SharedStats) > 0 {
+		for _, e := range m.SharedStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +416,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPermissions = append(m.ChannelPermissions, ChannelQueryPermissions{})
+			if err := m.ChannelPermissions[len(m.ChannelPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPermissions = append(m.ClientPermissions, ClientQueryPermissions{})
+			if err := m.ClientPermissions[len(m.ClientPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelQuotas = append(m.ChannelQuotas, ChannelQueryQuota{})
+			if err := m.ChannelQuotas[len(m.ChannelQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStats = append(m.ChannelStats, ChannelQueryStats{})
+			if err := m.ChannelStats[len(m.ChannelStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedStats = append(m.SharedStats, SharedQueryStats{})
+			if err := m.SharedStats[len(m.SharedStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,10 +3,16 @@ package types_test
 import (
 	"testing"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/stretchr/testify/suite"
 
-	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 type TypesTestSuite struct {
@@ -63,6 +69,81 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 			},
 			false,
 		},
+		{
+			"success - query permissions",
+			func() {
+				genesisState.ChannelPermissions = []types.ChannelQueryPermissions{{ChannelId: "channel-0"}}
+				genesisState.ClientPermissions = []types.ClientQueryPermissions{{ClientId: "07-tendermint-0"}}
+			},
+			true,
+		},
+		{
+			"failed to validate - invalid channel identifier of query permissions",
+			func() {
+				genesisState.ChannelPermissions = []types.ChannelQueryPermissions{{ChannelId: "c"}}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate channel query permissions",
+			func() {
+				genesisState.ChannelPermissions = []types.ChannelQueryPermissions{{ChannelId: "channel-0"}, {ChannelId: "channel-0"}}
+			},
+			false,
+		},
+		{
+			"failed to validate - invalid client identifier of query permissions",
+			func() {
+				genesisState.ClientPermissions = []types.ClientQueryPermissions{{ClientId: "c"}}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate client query permissions",
+			func() {
+				genesisState.ClientPermissions = []types.ClientQueryPermissions{{ClientId: "07-tendermint-0"}, {ClientId: "07-tendermint-0"}}
+			},
+			false,
+		},
+		{
+			"failed to validate - invalid query pattern in query permissions",
+			func() {
+				genesisState.ClientPermissions = []types.ClientQueryPermissions{
+					{ClientId: "07-tendermint-0", Permissions: types.NewQueryPermissions([]string{"/cosmos.*.Query/*"}, nil)},
+				}
+			},
+			false,
+		},
+		{
+			"success - query quotas and accounting",
+			func() {
+				genesisState.ChannelQuotas = []types.ChannelQueryQuota{{ChannelId: "channel-0", Quota: types.NewQueryQuota(10, 0)}}
+				genesisState.ChannelStats = []types.ChannelQueryStats{{ChannelId: "channel-0", Epoch: 1}, {ChannelId: "channel-0", Epoch: 2}}
+			},
+			true,
+		},
+		{
+			"failed to validate - query quota without limit",
+			func() {
+				genesisState.ChannelQuotas = []types.ChannelQueryQuota{{ChannelId: "channel-0"}}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate query quotas",
+			func() {
+				quota := types.NewQueryQuota(10, 0)
+				genesisState.ChannelQuotas = []types.ChannelQueryQuota{{ChannelId: "channel-0", Quota: quota}, {ChannelId: "channel-0", Quota: quota}}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate query accounting",
+			func() {
+				genesisState.ChannelStats = []types.ChannelQueryStats{{ChannelId: "channel-0", Epoch: 1}, {ChannelId: "channel-0", Epoch: 1}}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,3 +162,181 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
+	var (
+		genesisState   types.ControllerGenesisState
+		query          types.PendingQuery
+		recurringQuery types.RecurringQuery
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failed to validate - invalid channel identifier",
+			func() {
+				query.ChannelId = "c"
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			false,
+		},
+		{
+			"failed to validate - sequence is 0",
+			func() {
+				query.Sequence = 0
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			false,
+		},
+		{
+			"failed to validate - empty requests",
+			func() {
+				query.Requests = nil
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			false,
+		},
+		{
+			"success - query sent on behalf of a sender",
+			func() {
+				query.Sender = suite.chainA.SenderAccount.GetAddress().String()
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			true,
+		},
+		{
+			"failed to validate - invalid sender",
+			func() {
+				query.Sender = "sender"
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate pending query",
+			func() {
+				genesisState.PendingQueries = []types.PendingQuery{query, query}
+			},
+			false,
+		},
+		{
+			"success - recurring query",
+			func() {
+				genesisState.RecurringQueries = []types.RecurringQuery{recurringQuery}
+				genesisState.RecurringQueryResults = []types.RecurringQueryResult{{RecurringQueryId: 1, Run: 1}}
+				genesisState.NextRecurringQueryId = 2
+			},
+			true,
+		},
+		{
+			"success - run of a recurring query",
+			func() {
+				query.RecurringQueryId = 1
+				query.RecurringQueryRun = 1
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			true,
+		},
+		{
+			"failed to validate - run of a query which is not recurring",
+			func() {
+				query.RecurringQueryRun = 1
+				genesisState.PendingQueries = []types.PendingQuery{query}
+			},
+			false,
+		},
+		{
+			"failed to validate - invalid recurring query deposit",
+			func() {
+				genesisState.Params.RecurringQueryDeposit = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.OneInt()}}
+			},
+			false,
+		},
+		{
+			"failed to validate - invalid recurring query byte deposit",
+			func() {
+				genesisState.Params.RecurringQueryByteDeposit = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.OneInt()}}
+			},
+			false,
+		},
+		{
+			"failed to validate - recurring query interval is 0",
+			func() {
+				recurringQuery.Interval = 0
+				genesisState.RecurringQueries = []types.RecurringQuery{recurringQuery}
+				genesisState.NextRecurringQueryId = 2
+			},
+			false,
+		},
+		{
+			"failed to validate - recurring query ran more than max runs",
+			func() {
+				recurringQuery.Runs = recurringQuery.MaxRuns + 1
+				genesisState.RecurringQueries = []types.RecurringQuery{recurringQuery}
+				genesisState.NextRecurringQueryId = 2
+			},
+			false,
+		},
+		{
+			"failed to validate - recurring query ID is not lower than the next ID",
+			func() {
+				genesisState.RecurringQueries = []types.RecurringQuery{recurringQuery}
+			},
+			false,
+		},
+		{
+			"failed to validate - duplicate recurring query",
+			func() {
+				genesisState.RecurringQueries = []types.RecurringQuery{recurringQuery, recurringQuery}
+				genesisState.NextRecurringQueryId = 2
+			},
+			false,
+		},
+		{
+			"failed to validate - result of an unknown recurring query",
+			func() {
+				genesisState.RecurringQueryResults = []types.RecurringQueryResult{{RecurringQueryId: 1, Run: 1}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query = types.PendingQuery{
+				ChannelId: "channel-0",
+				Sequence:  1,
+				Requests:  []abcitypes.RequestQuery{{Path: "path/to/query1"}},
+			}
+			recurringQuery = types.RecurringQuery{
+				Id:              1,
+				Owner:           suite.chainA.SenderAccount.GetAddress().String(),
+				ChannelId:       "channel-0",
+				Requests:        []abcitypes.RequestQuery{{Path: "path/to/query1"}},
+				Interval:        10,
+				MaxRuns:         3,
+				RelativeTimeout: 100,
+				NextRunHeight:   10,
+			}
+			genesisState = types.ControllerGenesisState{PendingQueries: []types.PendingQuery{query}}
+
+			tc.malleate() // malleate mutates test data
+
+			err := genesisState.Validate()
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
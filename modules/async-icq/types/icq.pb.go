@@ -28,7 +28,27 @@ type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,2,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_queries defines a list of query paths allowed to be queried on a host chain.
+	// Entries ending with "/*" allow every method of a service, entries ending with ".*" every
+	// path of a package prefix, and entries starting with "!" deny the paths they match.
 	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// allow_store_queries defines a list of store names whose committed state can be queried with proofs
+	// through raw "/store/<store name>/key" query paths.
+	AllowStoreQueries []string `protobuf:"bytes,4,rep,name=allow_store_queries,json=allowStoreQueries,proto3" json:"allow_store_queries,omitempty" yaml:"allow_store_queries"`
+	// max_requests_per_packet defines the maximum number of query requests in a packet. 0 means no limit.
+	MaxRequestsPerPacket uint64 `protobuf:"varint,5,opt,name=max_requests_per_packet,json=maxRequestsPerPacket,proto3" json:"max_requests_per_packet,omitempty" yaml:"max_requests_per_packet"`
+	// max_response_bytes defines the maximum total size in bytes of the responses to the requests of a packet.
+	// 0 means no limit.
+	MaxResponseBytes uint64 `protobuf:"varint,6,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty" yaml:"max_response_bytes"`
+	// max_gas_per_packet defines the gas budget of the queries of a packet. 0 means the queries are only limited by
+	// the gas of the transaction relaying the packet.
+	MaxGasPerPacket uint64 `protobuf:"varint,7,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// epoch_blocks defines the number of blocks of an accounting epoch, over which the queries received on each
+	// channel are counted and the query quotas apply. 0 means the counters are never reset.
+	EpochBlocks uint64 `protobuf:"varint,8,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty" yaml:"epoch_blocks"`
+	// shared_quota defines the query quota shared by all the host channels without a query quota of their own, whose
+	// queries are counted together. Since anyone can open a channel, it bounds the queries of the channels which
+	// governance did not grant a quota to. A quota without limits means the shared queries are not limited.
+	SharedQuota QueryQuota `protobuf:"bytes,9,opt,name=shared_quota,json=sharedQuota,proto3" json:"shared_quota" yaml:"shared_quota"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,30 +98,517 @@ func (m *Params) GetAllowQueries() []string {
 	return nil
 }
 
+func (m *Params) GetAllowStoreQueries() []string {
+	if m != nil {
+		return m.AllowStoreQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRequestsPerPacket() uint64 {
+	if m != nil {
+		return m.MaxRequestsPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetMaxResponseBytes() uint64 {
+	if m != nil {
+		return m.MaxResponseBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSharedQuota() QueryQuota {
+	if m != nil {
+		return m.SharedQuota
+	}
+	return QueryQuota{}
+}
+
+// QueryPermissions defines the queries allowed on a channel or to a counterparty light client. They replace the allowlists
+// of the params for the packets they apply to.
+type QueryPermissions struct {
+	// allow_queries defines the query paths allowed, with the same patterns as the allow_queries param.
+	AllowQueries []string `protobuf:"bytes,1,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// allow_store_queries defines the store names allowed in raw store queries.
+	AllowStoreQueries []string `protobuf:"bytes,2,rep,name=allow_store_queries,json=allowStoreQueries,proto3" json:"allow_store_queries,omitempty" yaml:"allow_store_queries"`
+}
+
+func (m *QueryPermissions) Reset()         { *m = QueryPermissions{} }
+func (m *QueryPermissions) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissions) ProtoMessage()    {}
+func (*QueryPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{1}
+}
+func (m *QueryPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissions.Merge(m, src)
+}
+func (m *QueryPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissions proto.InternalMessageInfo
+
+func (m *QueryPermissions) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *QueryPermissions) GetAllowStoreQueries() []string {
+	if m != nil {
+		return m.AllowStoreQueries
+	}
+	return nil
+}
+
+// ChannelQueryPermissions defines the queries allowed on a host channel.
+type ChannelQueryPermissions struct {
+	ChannelId   string           `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Permissions QueryPermissions `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *ChannelQueryPermissions) Reset()         { *m = ChannelQueryPermissions{} }
+func (m *ChannelQueryPermissions) String() string { return proto.CompactTextString(m) }
+func (*ChannelQueryPermissions) ProtoMessage()    {}
+func (*ChannelQueryPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{2}
+}
+func (m *ChannelQueryPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelQueryPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelQueryPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelQueryPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelQueryPermissions.Merge(m, src)
+}
+func (m *ChannelQueryPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelQueryPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelQueryPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelQueryPermissions proto.InternalMessageInfo
+
+func (m *ChannelQueryPermissions) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelQueryPermissions) GetPermissions() QueryPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return QueryPermissions{}
+}
+
+// ClientQueryPermissions defines the queries allowed to a counterparty light client, on every channel built on that
+// client. Permissions are bound to the client ID rather than to the chain ID of the client, since anyone can create a
+// client with any chain ID.
+type ClientQueryPermissions struct {
+	ClientId    string           `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Permissions QueryPermissions `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *ClientQueryPermissions) Reset()         { *m = ClientQueryPermissions{} }
+func (m *ClientQueryPermissions) String() string { return proto.CompactTextString(m) }
+func (*ClientQueryPermissions) ProtoMessage()    {}
+func (*ClientQueryPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{3}
+}
+func (m *ClientQueryPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientQueryPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientQueryPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientQueryPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientQueryPermissions.Merge(m, src)
+}
+func (m *ClientQueryPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientQueryPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientQueryPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientQueryPermissions proto.InternalMessageInfo
+
+func (m *ClientQueryPermissions) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientQueryPermissions) GetPermissions() QueryPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return QueryPermissions{}
+}
+
+// QueryQuota defines the queries a channel can have answered in an accounting epoch. Packets received once a limit is
+// reached are acknowledged with an error until the next epoch.
+type QueryQuota struct {
+	// max_queries defines the maximum number of query packets received in an epoch. 0 means no limit.
+	MaxQueries uint64 `protobuf:"varint,1,opt,name=max_queries,json=maxQueries,proto3" json:"max_queries,omitempty" yaml:"max_queries"`
+	// max_gas defines the maximum gas consumed by the queries of an epoch. 0 means no limit.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *QueryQuota) Reset()         { *m = QueryQuota{} }
+func (m *QueryQuota) String() string { return proto.CompactTextString(m) }
+func (*QueryQuota) ProtoMessage()    {}
+func (*QueryQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{4}
+}
+func (m *QueryQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuota.Merge(m, src)
+}
+func (m *QueryQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuota proto.InternalMessageInfo
+
+func (m *QueryQuota) GetMaxQueries() uint64 {
+	if m != nil {
+		return m.MaxQueries
+	}
+	return 0
+}
+
+func (m *QueryQuota) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// ChannelQueryQuota defines the query quota of a host channel.
+type ChannelQueryQuota struct {
+	ChannelId string     `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Quota     QueryQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *ChannelQueryQuota) Reset()         { *m = ChannelQueryQuota{} }
+func (m *ChannelQueryQuota) String() string { return proto.CompactTextString(m) }
+func (*ChannelQueryQuota) ProtoMessage()    {}
+func (*ChannelQueryQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{5}
+}
+func (m *ChannelQueryQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelQueryQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelQueryQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelQueryQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelQueryQuota.Merge(m, src)
+}
+func (m *ChannelQueryQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelQueryQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelQueryQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelQueryQuota proto.InternalMessageInfo
+
+func (m *ChannelQueryQuota) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelQueryQuota) GetQuota() QueryQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return QueryQuota{}
+}
+
+// ChannelQueryStats defines the queries served on a host channel in an accounting epoch. Failed queries are not
+// counted, as core IBC discards the state changes of a packet acknowledged with an error.
+type ChannelQueryStats struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Epoch     uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// queries_served defines the number of query packets acknowledged with the query responses.
+	QueriesServed uint64 `protobuf:"varint,3,opt,name=queries_served,json=queriesServed,proto3" json:"queries_served,omitempty"`
+	// gas_consumed defines the gas consumed by the served queries.
+	GasConsumed uint64 `protobuf:"varint,5,opt,name=gas_consumed,json=gasConsumed,proto3" json:"gas_consumed,omitempty"`
+}
+
+func (m *ChannelQueryStats) Reset()         { *m = ChannelQueryStats{} }
+func (m *ChannelQueryStats) String() string { return proto.CompactTextString(m) }
+func (*ChannelQueryStats) ProtoMessage()    {}
+func (*ChannelQueryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{6}
+}
+func (m *ChannelQueryStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelQueryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelQueryStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelQueryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelQueryStats.Merge(m, src)
+}
+func (m *ChannelQueryStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelQueryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelQueryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelQueryStats proto.InternalMessageInfo
+
+func (m *ChannelQueryStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelQueryStats) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ChannelQueryStats) GetQueriesServed() uint64 {
+	if m != nil {
+		return m.QueriesServed
+	}
+	return 0
+}
+
+func (m *ChannelQueryStats) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+// SharedQueryStats defines the queries served in an accounting epoch on the host channels without a query quota,
+// which count towards the shared quota of the params.
+type SharedQueryStats struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// queries_served defines the number of query packets acknowledged with the query responses.
+	QueriesServed uint64 `protobuf:"varint,2,opt,name=queries_served,json=queriesServed,proto3" json:"queries_served,omitempty"`
+	// gas_consumed defines the gas consumed by the served queries.
+	GasConsumed uint64 `protobuf:"varint,3,opt,name=gas_consumed,json=gasConsumed,proto3" json:"gas_consumed,omitempty"`
+}
+
+func (m *SharedQueryStats) Reset()         { *m = SharedQueryStats{} }
+func (m *SharedQueryStats) String() string { return proto.CompactTextString(m) }
+func (*SharedQueryStats) ProtoMessage()    {}
+func (*SharedQueryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a9dc71eedc8bea6, []int{7}
+}
+func (m *SharedQueryStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedQueryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharedQueryStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharedQueryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedQueryStats.Merge(m, src)
+}
+func (m *SharedQueryStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedQueryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedQueryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedQueryStats proto.InternalMessageInfo
+
+func (m *SharedQueryStats) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SharedQueryStats) GetQueriesServed() uint64 {
+	if m != nil {
+		return m.QueriesServed
+	}
+	return 0
+}
+
+func (m *SharedQueryStats) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "icq.v1.Params")
+	proto.RegisterType((*QueryPermissions)(nil), "icq.v1.QueryPermissions")
+	proto.RegisterType((*ChannelQueryPermissions)(nil), "icq.v1.ChannelQueryPermissions")
+	proto.RegisterType((*ClientQueryPermissions)(nil), "icq.v1.ClientQueryPermissions")
+	proto.RegisterType((*QueryQuota)(nil), "icq.v1.QueryQuota")
+	proto.RegisterType((*ChannelQueryQuota)(nil), "icq.v1.ChannelQueryQuota")
+	proto.RegisterType((*ChannelQueryStats)(nil), "icq.v1.ChannelQueryStats")
+	proto.RegisterType((*SharedQueryStats)(nil), "icq.v1.SharedQueryStats")
 }
 
 func init() { proto.RegisterFile("icq/v1/icq.proto", fileDescriptor_0a9dc71eedc8bea6) }
 
 var fileDescriptor_0a9dc71eedc8bea6 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0xcf, 0x4c, 0x2e, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x03, 0x31,
-	0xcb, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x42, 0xfa, 0x20, 0x16, 0x44, 0x56, 0xa9,
-	0x99, 0x91, 0x8b, 0x2d, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8a, 0x8b, 0x27, 0x23, 0xbf,
-	0xb8, 0x24, 0x3e, 0x35, 0x2f, 0x31, 0x29, 0x27, 0x35, 0x45, 0x82, 0x49, 0x81, 0x51, 0x83, 0xc3,
-	0x49, 0xfc, 0xd3, 0x3d, 0x79, 0xe1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x64, 0x59, 0xa5, 0x20,
-	0x6e, 0x10, 0xd7, 0x15, 0xc2, 0x13, 0xb2, 0xe5, 0xe2, 0x4d, 0xcc, 0xc9, 0xc9, 0x2f, 0x8f, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xca, 0x4c, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x74, 0x92, 0xf8, 0x74,
-	0x4f, 0x5e, 0x04, 0xa2, 0x19, 0x45, 0x5a, 0x29, 0x88, 0x07, 0xcc, 0x0f, 0x84, 0x70, 0x9d, 0xfc,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0x33, 0x29,
-	0x59, 0x37, 0xb1, 0xa0, 0xa0, 0x58, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x58, 0x3f, 0xb1,
-	0xb8, 0x32, 0x2f, 0x59, 0x17, 0xec, 0x71, 0x0b, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xef, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0x5a, 0xea, 0x22, 0x0f, 0x01, 0x00,
-	0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6a, 0xdb, 0x48,
+	0x14, 0xb6, 0xfc, 0x17, 0x7b, 0xe4, 0x64, 0x9d, 0x89, 0x49, 0xb4, 0x09, 0x91, 0xbc, 0x03, 0x0b,
+	0x86, 0x25, 0xd6, 0x26, 0x0b, 0xbb, 0x10, 0x58, 0x58, 0x14, 0x96, 0xd2, 0x14, 0x8a, 0x33, 0xbe,
+	0x6a, 0x6f, 0xc4, 0x58, 0x1e, 0x6c, 0x11, 0x49, 0x23, 0x6b, 0xe4, 0xc4, 0xee, 0x53, 0xf4, 0xb2,
+	0xb7, 0x7d, 0x9b, 0x5c, 0xe6, 0xae, 0xbd, 0x12, 0x25, 0x79, 0x03, 0x3f, 0x41, 0xd1, 0x8c, 0x63,
+	0xcb, 0xb8, 0x69, 0x4a, 0xc9, 0x9d, 0xcf, 0xf7, 0x9d, 0xf9, 0xce, 0x99, 0x73, 0xbe, 0xb1, 0x40,
+	0xdd, 0x75, 0x46, 0xe6, 0xd5, 0xb1, 0xe9, 0x3a, 0xa3, 0x76, 0x18, 0xb1, 0x98, 0xc1, 0x72, 0xfa,
+	0xf3, 0xea, 0x78, 0xbf, 0x31, 0x60, 0x03, 0x26, 0x20, 0x33, 0xfd, 0x25, 0x59, 0xf4, 0xa9, 0x08,
+	0xca, 0x1d, 0x12, 0x11, 0x9f, 0xc3, 0x53, 0x50, 0x1b, 0x32, 0x1e, 0xdb, 0x34, 0x20, 0x3d, 0x8f,
+	0xf6, 0xb5, 0x7c, 0x53, 0x69, 0x55, 0xac, 0xbd, 0x59, 0x62, 0xec, 0x4c, 0x89, 0xef, 0x9d, 0xa2,
+	0x2c, 0x8b, 0xb0, 0x9a, 0x86, 0xff, 0xcb, 0x08, 0xfe, 0x0b, 0x36, 0x89, 0xe7, 0xb1, 0x6b, 0x7b,
+	0x34, 0xa6, 0x91, 0x4b, 0xb9, 0x56, 0x68, 0x16, 0x5a, 0x55, 0x4b, 0x9b, 0x25, 0x46, 0x43, 0x1e,
+	0x5e, 0xa1, 0x11, 0xae, 0x89, 0xf8, 0x42, 0x86, 0xf0, 0x35, 0xd8, 0x91, 0x3c, 0x8f, 0x59, 0x44,
+	0x17, 0x22, 0x45, 0x21, 0xa2, 0xcf, 0x12, 0x63, 0x3f, 0x2b, 0xb2, 0x92, 0x84, 0xf0, 0xb6, 0x40,
+	0xbb, 0x29, 0xf8, 0xa0, 0xf7, 0x06, 0xec, 0xf9, 0x64, 0x62, 0x47, 0x74, 0x34, 0xa6, 0x3c, 0xe6,
+	0x76, 0x48, 0x23, 0x3b, 0x24, 0xce, 0x25, 0x8d, 0xb5, 0x52, 0x53, 0x69, 0x15, 0x2d, 0x34, 0x4b,
+	0x0c, 0x5d, 0x6a, 0x3e, 0x92, 0x88, 0x70, 0xc3, 0x27, 0x13, 0x3c, 0x27, 0x3a, 0x34, 0xea, 0x08,
+	0x18, 0xbe, 0x02, 0x50, 0x9e, 0xe0, 0x21, 0x0b, 0x38, 0xb5, 0x7b, 0xd3, 0x98, 0x72, 0xad, 0x2c,
+	0x54, 0x0f, 0x67, 0x89, 0xf1, 0x6b, 0x56, 0x35, 0x9b, 0x83, 0x70, 0x5d, 0x08, 0x4a, 0xcc, 0x4a,
+	0x21, 0x78, 0x2e, 0xc5, 0x06, 0x64, 0xa5, 0xc5, 0x8d, 0x6f, 0x89, 0xad, 0xe6, 0x20, 0xfc, 0x8b,
+	0x4f, 0x26, 0x2f, 0x48, 0xa6, 0xb1, 0x53, 0x50, 0xa3, 0x21, 0x73, 0x86, 0x76, 0xcf, 0x63, 0xce,
+	0x25, 0xd7, 0x2a, 0x42, 0x25, 0xb3, 0xbe, 0x2c, 0x8b, 0xb0, 0x2a, 0x42, 0x4b, 0x44, 0x10, 0x83,
+	0x1a, 0x1f, 0x92, 0x88, 0xf6, 0xed, 0xd1, 0x98, 0xc5, 0x44, 0xab, 0x36, 0x95, 0x96, 0x7a, 0x02,
+	0xdb, 0xd2, 0x3a, 0xed, 0x74, 0xac, 0xd3, 0x8b, 0x94, 0xb1, 0x0e, 0x6e, 0x12, 0x23, 0xb7, 0xd4,
+	0xcc, 0x9e, 0x42, 0x58, 0x95, 0xa1, 0xc8, 0x44, 0x1f, 0x15, 0x50, 0x17, 0x07, 0x3b, 0x34, 0xf2,
+	0x5d, 0xce, 0x5d, 0x16, 0xf0, 0x75, 0x9f, 0x28, 0xcf, 0xe1, 0x93, 0xfc, 0x4f, 0xfa, 0x04, 0xbd,
+	0x03, 0x7b, 0x67, 0x43, 0x12, 0x04, 0xd4, 0x5b, 0xeb, 0xf4, 0x10, 0x00, 0x47, 0x52, 0xb6, 0xdb,
+	0xd7, 0x94, 0xa6, 0xd2, 0xaa, 0xe2, 0xea, 0x1c, 0x79, 0xd9, 0x87, 0xff, 0x01, 0x35, 0x5c, 0x66,
+	0x8b, 0xb7, 0xa2, 0x9e, 0x68, 0x2b, 0x03, 0xcb, 0xa8, 0x59, 0xc5, 0x74, 0x6c, 0x38, 0x7b, 0x04,
+	0x5d, 0x83, 0xdd, 0x33, 0xcf, 0xa5, 0x41, 0xbc, 0x56, 0xfa, 0x00, 0x54, 0x1d, 0xc1, 0x2c, 0x2b,
+	0x57, 0x24, 0xf0, 0x2c, 0x85, 0x23, 0x00, 0x96, 0x0b, 0x85, 0xff, 0x00, 0x35, 0xb5, 0xd7, 0x72,
+	0x1f, 0xa9, 0x6b, 0x76, 0x67, 0x89, 0x01, 0x97, 0xde, 0x5b, 0x8c, 0x10, 0xf8, 0x64, 0xf2, 0xb0,
+	0x8b, 0x3f, 0xc0, 0xc6, 0xdc, 0x97, 0xa2, 0x89, 0xa2, 0x05, 0x67, 0x89, 0xb1, 0xb5, 0x62, 0x58,
+	0x84, 0xcb, 0xd2, 0xa5, 0xa8, 0x07, 0xb6, 0xb3, 0x83, 0x96, 0xa5, 0x9f, 0x18, 0x71, 0x1b, 0x94,
+	0xa4, 0x1b, 0xf3, 0x8f, 0xba, 0x51, 0xde, 0x4e, 0xa6, 0xa1, 0x0f, 0xca, 0x6a, 0x91, 0x6e, 0x4c,
+	0xe2, 0x27, 0xf7, 0xd8, 0x00, 0x25, 0xf1, 0x10, 0xe4, 0x1d, 0xb0, 0x0c, 0xe0, 0xef, 0x60, 0x6b,
+	0x7e, 0x67, 0x9b, 0xd3, 0xe8, 0x8a, 0xf6, 0xb5, 0x82, 0xa0, 0x37, 0xe7, 0x68, 0x57, 0x80, 0xf0,
+	0x37, 0x50, 0x4b, 0x9f, 0xa5, 0xc3, 0x02, 0x3e, 0xf6, 0x69, 0x5f, 0xfe, 0xb7, 0x60, 0x75, 0x40,
+	0xf8, 0xd9, 0x1c, 0x3a, 0x2f, 0x56, 0x8a, 0xf5, 0x12, 0x8a, 0x40, 0xbd, 0x3b, 0x7f, 0x1a, 0x8b,
+	0xc6, 0x16, 0x95, 0x95, 0xef, 0x57, 0xce, 0xff, 0x48, 0xe5, 0xc2, 0x5a, 0x65, 0xab, 0x73, 0x73,
+	0xa7, 0x2b, 0xb7, 0x77, 0xba, 0xf2, 0xe5, 0x4e, 0x57, 0xde, 0xdf, 0xeb, 0xb9, 0xdb, 0x7b, 0x3d,
+	0xf7, 0xf9, 0x5e, 0xcf, 0xbd, 0xfd, 0x7b, 0xe0, 0xc6, 0xc3, 0x71, 0xaf, 0xed, 0x30, 0xdf, 0x74,
+	0x18, 0xf7, 0x19, 0x37, 0xdd, 0x9e, 0x73, 0x44, 0xc2, 0x90, 0x9b, 0x3e, 0xeb, 0x8f, 0x3d, 0xca,
+	0x4d, 0xc2, 0xa7, 0x81, 0x73, 0x24, 0x3f, 0x26, 0x7f, 0x9a, 0xf1, 0x34, 0xa4, 0xbc, 0x57, 0x16,
+	0x9f, 0x8c, 0xbf, 0xbe, 0x0e, 0x00, 0xb7, 0x9a, 0x9a, 0x9c, 0x64, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,25 +631,341 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.AllowQueries) > 0 {
-		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowQueries[iNdEx])
-			copy(dAtA[i:], m.AllowQueries[iNdEx])
-			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.SharedQuota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintIcq(dAtA, i, uint64(size))
 	}
-	if m.HostEnabled {
+	i--
+	dAtA[i] = 0x4a
+	if m.EpochBlocks != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.EpochBlocks))
 		i--
-		if m.HostEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x40
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxResponseBytes != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxResponseBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRequestsPerPacket != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxRequestsPerPacket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowStoreQueries) > 0 {
+		for iNdEx := len(m.AllowStoreQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowStoreQueries[iNdEx])
+			copy(dAtA[i:], m.AllowStoreQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowStoreQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowStoreQueries) > 0 {
+		for iNdEx := len(m.AllowStoreQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowStoreQueries[iNdEx])
+			copy(dAtA[i:], m.AllowStoreQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowStoreQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelQueryPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelQueryPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelQueryPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIcq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientQueryPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientQueryPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientQueryPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIcq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxQueries != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.MaxQueries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelQueryQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelQueryQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelQueryQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintIcq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelQueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelQueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelQueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasConsumed != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QueriesServed != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.QueriesServed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcq(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SharedQueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedQueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharedQueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasConsumed != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueriesServed != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.QueriesServed))
 		i--
 		dAtA[i] = 0x10
 	}
+	if m.Epoch != 0 {
+		i = encodeVarintIcq(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -172,16 +995,832 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovIcq(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.AllowStoreQueries) > 0 {
+		for _, s := range m.AllowStoreQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	if m.MaxRequestsPerPacket != 0 {
+		n += 1 + sovIcq(uint64(m.MaxRequestsPerPacket))
+	}
+	if m.MaxResponseBytes != 0 {
+		n += 1 + sovIcq(uint64(m.MaxResponseBytes))
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovIcq(uint64(m.MaxGasPerPacket))
+	}
+	if m.EpochBlocks != 0 {
+		n += 1 + sovIcq(uint64(m.EpochBlocks))
+	}
+	l = m.SharedQuota.Size()
+	n += 1 + l + sovIcq(uint64(l))
+	return n
+}
+
+func (m *QueryPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	if len(m.AllowStoreQueries) > 0 {
+		for _, s := range m.AllowStoreQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelQueryPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = m.Permissions.Size()
+	n += 1 + l + sovIcq(uint64(l))
+	return n
+}
+
+func (m *ClientQueryPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = m.Permissions.Size()
+	n += 1 + l + sovIcq(uint64(l))
+	return n
+}
+
+func (m *QueryQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxQueries != 0 {
+		n += 1 + sovIcq(uint64(m.MaxQueries))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovIcq(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *ChannelQueryQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovIcq(uint64(l))
+	return n
+}
+
+func (m *ChannelQueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcq(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovIcq(uint64(m.Epoch))
+	}
+	if m.QueriesServed != 0 {
+		n += 1 + sovIcq(uint64(m.QueriesServed))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovIcq(uint64(m.GasConsumed))
+	}
+	return n
+}
+
+func (m *SharedQueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovIcq(uint64(m.Epoch))
+	}
+	if m.QueriesServed != 0 {
+		n += 1 + sovIcq(uint64(m.QueriesServed))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovIcq(uint64(m.GasConsumed))
+	}
+	return n
+}
+
+func sovIcq(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcq(x uint64) (n int) {
+	return sovIcq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowStoreQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowStoreQueries = append(m.AllowStoreQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestsPerPacket", wireType)
+			}
+			m.MaxRequestsPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestsPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseBytes", wireType)
+			}
+			m.MaxResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharedQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowStoreQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowStoreQueries = append(m.AllowStoreQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelQueryPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelQueryPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelQueryPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientQueryPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientQueryPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientQueryPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueries", wireType)
+			}
+			m.MaxQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovIcq(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIcq(x uint64) (n int) {
-	return sovIcq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ChannelQueryQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -204,17 +1843,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelQueryQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelQueryQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIcq
@@ -224,15 +1895,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HostEnabled = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelQueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelQueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelQueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -260,8 +1994,172 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesServed", wireType)
+			}
+			m.QueriesServed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesServed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SharedQueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharedQueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharedQueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesServed", wireType)
+			}
+			m.QueriesServed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesServed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIcq(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the interchain query module name
 	ModuleName = "interchainquery"
@@ -10,6 +17,9 @@ const (
 	// Version defines the current version for interchain query
 	Version = "icq-1"
 
+	// EncodingJSON is the encoding of the packet data of interchain queries sent over IBC v2
+	EncodingJSON = "application/json"
+
 	// StoreKey is the store key string for interchain query
 	StoreKey = ModuleName
 
@@ -18,6 +28,15 @@ const (
 
 	// QuerierRoute is the querier route for interchain query
 	QuerierRoute = ModuleName
+
+	// ControllerModuleName defines the interchain query controller module name
+	ControllerModuleName = "icqcontroller"
+
+	// ControllerPortID is the port id that the interchain query controller module binds to
+	ControllerPortID = "icqcontroller"
+
+	// ControllerStoreKey is the store key string for the interchain query controller
+	ControllerStoreKey = ControllerModuleName
 )
 
 var (
@@ -25,15 +44,105 @@ var (
 	ParamsKey = []byte{0x00}
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
+	// PendingQueryKeyPrefix defines the prefix under which the controller stores its pending queries
+	PendingQueryKeyPrefix = []byte{0x02}
+	// ChannelQueryPermissionsKeyPrefix defines the prefix under which the host stores the query permissions of its channels
+	ChannelQueryPermissionsKeyPrefix = []byte{0x03}
+	// ClientQueryPermissionsKeyPrefix defines the prefix under which the host stores the query permissions of the
+	// counterparty light clients
+	ClientQueryPermissionsKeyPrefix = []byte{0x04}
+	// ChannelQueryQuotaKeyPrefix defines the prefix under which the host stores the query quotas of its channels
+	ChannelQueryQuotaKeyPrefix = []byte{0x05}
+	// ChannelQueryStatsKeyPrefix defines the prefix under which the host stores the accounting of the queries received
+	// on its channels
+	ChannelQueryStatsKeyPrefix = []byte{0x06}
+	// ControllerParamsKey defines the key under which the controller stores its params
+	ControllerParamsKey = []byte{0x07}
+	// RecurringQueryKeyPrefix defines the prefix under which the controller stores its recurring queries
+	RecurringQueryKeyPrefix = []byte{0x08}
+	// RecurringQueryScheduleKeyPrefix defines the prefix under which the controller indexes its recurring queries by
+	// the height of their next run
+	RecurringQueryScheduleKeyPrefix = []byte{0x09}
+	// RecurringQueryResultKeyPrefix defines the prefix under which the controller stores the latest results of its
+	// recurring queries
+	RecurringQueryResultKeyPrefix = []byte{0x0a}
+	// NextRecurringQueryIDKey defines the key under which the controller stores the ID of the next recurring query
+	NextRecurringQueryIDKey = []byte{0x0b}
+	// SharedQueryStatsKeyPrefix defines the prefix under which the host stores the accounting of the queries received
+	// on its channels without a query quota
+	SharedQueryStatsKeyPrefix = []byte{0x0c}
+	// RecurringQueryOwnerCountKeyPrefix defines the prefix under which the controller stores the number of recurring
+	// queries registered by each owner
+	RecurringQueryOwnerCountKeyPrefix = []byte{0x0d}
 )
 
-// ContainsQueryPath returns true if the path is present in allowQueries, otherwise false
-func ContainsQueryPath(allowQueries []string, path string) bool {
-	for _, v := range allowQueries {
-		if v == path {
-			return true
-		}
-	}
+// PendingQueryKey returns the key under which the controller stores the query sent on the channel with the sequence
+func PendingQueryKey(channelID string, sequence uint64) []byte {
+	return append(PendingQueryChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PendingQueryChannelPrefix returns the prefix under which the controller stores the queries sent on the channel
+func PendingQueryChannelPrefix(channelID string) []byte {
+	return append(bytes.Clone(PendingQueryKeyPrefix), address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// ChannelQueryPermissionsKey returns the key under which the host stores the query permissions of the channel
+func ChannelQueryPermissionsKey(channelID string) []byte {
+	return append(bytes.Clone(ChannelQueryPermissionsKeyPrefix), []byte(channelID)...)
+}
+
+// ClientQueryPermissionsKey returns the key under which the host stores the query permissions of the counterparty
+// light client
+func ClientQueryPermissionsKey(clientID string) []byte {
+	return append(bytes.Clone(ClientQueryPermissionsKeyPrefix), []byte(clientID)...)
+}
+
+// ChannelQueryQuotaKey returns the key under which the host stores the query quota of the channel
+func ChannelQueryQuotaKey(channelID string) []byte {
+	return append(bytes.Clone(ChannelQueryQuotaKeyPrefix), []byte(channelID)...)
+}
+
+// ChannelQueryStatsKey returns the key under which the host stores the accounting of the queries received on the
+// channel in the epoch
+func ChannelQueryStatsKey(channelID string, epoch uint64) []byte {
+	return append(ChannelQueryStatsChannelPrefix(channelID), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// ChannelQueryStatsChannelPrefix returns the prefix under which the host stores the accounting of the queries
+// received on the channel
+func ChannelQueryStatsChannelPrefix(channelID string) []byte {
+	return append(bytes.Clone(ChannelQueryStatsKeyPrefix), address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// SharedQueryStatsKey returns the key under which the host stores the accounting of the queries received on its
+// channels without a query quota in the epoch
+func SharedQueryStatsKey(epoch uint64) []byte {
+	return append(bytes.Clone(SharedQueryStatsKeyPrefix), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// RecurringQueryKey returns the key under which the controller stores the recurring query
+func RecurringQueryKey(id uint64) []byte {
+	return append(bytes.Clone(RecurringQueryKeyPrefix), sdk.Uint64ToBigEndian(id)...)
+}
+
+// RecurringQueryScheduleKey returns the key indexing the recurring query under the height of its next run
+func RecurringQueryScheduleKey(height int64, id uint64) []byte {
+	return append(RecurringQueryScheduleHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// RecurringQueryScheduleHeightPrefix returns the prefix under which the recurring queries run at the height are
+// indexed. The heights are ordered, so that the queries due at a height are those indexed before the next height
+func RecurringQueryScheduleHeightPrefix(height int64) []byte {
+	return append(bytes.Clone(RecurringQueryScheduleKeyPrefix), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// RecurringQueryOwnerCountKey returns the key under which the controller stores the number of recurring queries
+// registered by the owner
+func RecurringQueryOwnerCountKey(owner string) []byte {
+	return append(bytes.Clone(RecurringQueryOwnerCountKeyPrefix), []byte(owner)...)
+}
 
-	return false
+// RecurringQueryResultKey returns the key under which the controller stores the latest result of the recurring query
+func RecurringQueryResultKey(id uint64) []byte {
+	return append(bytes.Clone(RecurringQueryResultKeyPrefix), sdk.Uint64ToBigEndian(id)...)
 }
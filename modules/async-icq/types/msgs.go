@@ -4,9 +4,19 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetQueryPermissions{}
+	_ sdk.Msg = &MsgDeleteQueryPermissions{}
+	_ sdk.Msg = &MsgSetQueryQuota{}
+	_ sdk.Msg = &MsgDeleteQueryQuota{}
+	_ sdk.Msg = &MsgAddAllowedQueries{}
+	_ sdk.Msg = &MsgRemoveAllowedQueries{}
+)
 
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgUpdateParams) GetSignBytes() []byte {
@@ -27,3 +37,145 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetQueryPermissions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetQueryPermissions message.
+func (m *MsgSetQueryPermissions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetQueryPermissions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if err := validatePermissionsTarget(m.ChannelId, m.ClientId); err != nil {
+		return err
+	}
+
+	return m.Permissions.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteQueryPermissions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeleteQueryPermissions message.
+func (m *MsgDeleteQueryPermissions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgDeleteQueryPermissions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validatePermissionsTarget(m.ChannelId, m.ClientId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetQueryQuota) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetQueryQuota message.
+func (m *MsgSetQueryQuota) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetQueryQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return ChannelQueryQuota{ChannelId: m.ChannelId, Quota: m.Quota}.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteQueryQuota) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeleteQueryQuota message.
+func (m *MsgDeleteQueryQuota) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgDeleteQueryQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return host.ChannelIdentifierValidator(m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddAllowedQueries) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAddAllowedQueries message.
+func (m *MsgAddAllowedQueries) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddAllowedQueries) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validateAllowlistChange(m.AllowQueries)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveAllowedQueries) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAllowedQueries message.
+func (m *MsgRemoveAllowedQueries) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveAllowedQueries) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validateAllowlistChange(m.AllowQueries)
+}
+
+// validateAllowlistChange checks that the entries added to or removed from the allowlist are valid and distinct
+func validateAllowlistChange(allowQueries []string) error {
+	if len(allowQueries) == 0 {
+		return errors.Wrap(ErrInvalidQuery, "allow queries cannot be empty")
+	}
+	if err := validateAllowlist(allowQueries); err != nil {
+		return errors.Wrap(ErrInvalidQuery, err.Error())
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range allowQueries {
+		if seen[entry] {
+			return errors.Wrapf(ErrInvalidQuery, "duplicate allow queries entry %s", entry)
+		}
+		seen[entry] = true
+	}
+	return nil
+}
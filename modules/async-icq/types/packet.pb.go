@@ -223,27 +223,27 @@ func init() {
 func init() { proto.RegisterFile("icq/v1/packet.proto", fileDescriptor_13b1ec1d226ce757) }
 
 var fileDescriptor_13b1ec1d226ce757 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0x32, 0x31,
-	0x14, 0xc5, 0xa7, 0xdf, 0x47, 0x88, 0x14, 0xe3, 0x62, 0x74, 0x31, 0x62, 0xac, 0x64, 0x56, 0x6c,
-	0x68, 0x83, 0xc6, 0xc4, 0x9d, 0x11, 0xdc, 0xb8, 0xf1, 0xcf, 0xc4, 0x95, 0xbb, 0x4e, 0xb9, 0x81,
-	0x06, 0x67, 0x5a, 0xda, 0x0e, 0x09, 0x6f, 0xe1, 0x63, 0xb1, 0x64, 0xe9, 0xca, 0x18, 0x78, 0x11,
-	0x43, 0xab, 0xe2, 0x82, 0xdd, 0xc9, 0xbd, 0xe7, 0xfc, 0x7a, 0x73, 0x8a, 0x0f, 0xa5, 0x98, 0xb2,
-	0x59, 0x8f, 0x69, 0x2e, 0x26, 0xe0, 0xa8, 0x36, 0xca, 0xa9, 0xb8, 0x2e, 0xc5, 0x94, 0xce, 0x7a,
-	0xad, 0xa3, 0x91, 0x1a, 0x29, 0x3f, 0x62, 0x1b, 0x15, 0xb6, 0xad, 0x13, 0x07, 0xe5, 0x10, 0x4c,
-	0x21, 0x4b, 0xc7, 0x78, 0x2e, 0x24, 0x73, 0x73, 0x0d, 0x36, 0x2c, 0xd3, 0x01, 0x3e, 0xbe, 0x2b,
-	0x1d, 0x18, 0x31, 0xe6, 0xb2, 0x7c, 0xaa, 0xc0, 0xcc, 0x1f, 0x3d, 0xf9, 0x96, 0x3b, 0x1e, 0xc7,
-	0xb8, 0x36, 0xe4, 0x8e, 0x27, 0xa8, 0x8d, 0x3a, 0xfb, 0x99, 0xd7, 0x9b, 0x59, 0x01, 0x85, 0x4a,
-	0xfe, 0xb5, 0x51, 0xa7, 0x91, 0x79, 0x9d, 0x52, 0x9c, 0xec, 0x84, 0xdc, 0x88, 0xc9, 0x2e, 0x46,
-	0x7a, 0x8f, 0x9b, 0x03, 0x65, 0x0b, 0x65, 0xbd, 0x37, 0xbe, 0xc6, 0x7b, 0x06, 0xa6, 0x15, 0x58,
-	0x67, 0x13, 0xd4, 0xfe, 0xdf, 0x69, 0x9e, 0x9f, 0xd2, 0xed, 0xcd, 0x74, 0x73, 0x33, 0xcd, 0x82,
-	0xc1, 0x07, 0xfa, 0xb5, 0xc5, 0xc7, 0x59, 0x94, 0xfd, 0x86, 0xd2, 0x67, 0x7c, 0x10, 0x78, 0x19,
-	0x58, 0xad, 0x4a, 0x0b, 0x71, 0x1f, 0x37, 0xcc, 0xb7, 0xfe, 0x61, 0x92, 0x1d, 0xcc, 0xe0, 0xf8,
-	0x0b, 0xdd, 0xc6, 0xfa, 0x0f, 0x8b, 0x15, 0x41, 0xcb, 0x15, 0x41, 0x9f, 0x2b, 0x82, 0xde, 0xd6,
-	0x24, 0x5a, 0xae, 0x49, 0xf4, 0xbe, 0x26, 0xd1, 0xcb, 0xe5, 0x48, 0xba, 0x71, 0x95, 0x53, 0xa1,
-	0x0a, 0x26, 0xfc, 0xc3, 0x4c, 0xe6, 0xa2, 0xcb, 0xb5, 0xb6, 0xac, 0x50, 0xc3, 0xea, 0x15, 0x2c,
-	0xe3, 0x76, 0x5e, 0x8a, 0xae, 0xff, 0xad, 0xab, 0xd0, 0x78, 0x5e, 0xf7, 0x95, 0x5f, 0x7c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x9f, 0x59, 0xe8, 0x08, 0xc4, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4f, 0x02, 0x31,
+	0x14, 0xc6, 0xaf, 0x4a, 0x88, 0x14, 0xe3, 0x70, 0x3a, 0x9c, 0x18, 0x2b, 0xb9, 0x89, 0x85, 0x56,
+	0x34, 0x71, 0x35, 0x82, 0x8b, 0x8b, 0xc1, 0x8b, 0x93, 0x5b, 0xaf, 0xf7, 0x02, 0x0d, 0xde, 0xf5,
+	0x68, 0x7b, 0x24, 0xfc, 0x17, 0xfe, 0x59, 0x8c, 0x8c, 0x4e, 0xc6, 0xc0, 0x3f, 0x62, 0x68, 0x51,
+	0x1c, 0xd8, 0xbe, 0xbc, 0xf7, 0x7d, 0xbf, 0xbe, 0x7c, 0xc5, 0xa7, 0x52, 0x4c, 0xd9, 0xac, 0xc7,
+	0x4a, 0x2e, 0x26, 0x60, 0x69, 0xa9, 0x95, 0x55, 0x61, 0x5d, 0x8a, 0x29, 0x9d, 0xf5, 0x5a, 0x67,
+	0x23, 0x35, 0x52, 0x6e, 0xc4, 0x36, 0xca, 0x6f, 0x5b, 0x17, 0x16, 0x8a, 0x0c, 0x74, 0x2e, 0x0b,
+	0xcb, 0x78, 0x2a, 0x24, 0xb3, 0xf3, 0x12, 0x8c, 0x5f, 0xc6, 0x03, 0x7c, 0xfe, 0x54, 0x58, 0xd0,
+	0x62, 0xcc, 0x65, 0xf1, 0x52, 0x81, 0x9e, 0x0f, 0x1d, 0xf9, 0x91, 0x5b, 0x1e, 0x86, 0xb8, 0x96,
+	0x71, 0xcb, 0x23, 0xd4, 0x46, 0x9d, 0xe3, 0xa4, 0x96, 0x6d, 0x67, 0x39, 0xe4, 0x2a, 0x3a, 0x68,
+	0xa3, 0x4e, 0x23, 0x71, 0x3a, 0xa6, 0x38, 0xda, 0x0b, 0x79, 0x10, 0x93, 0x7d, 0x8c, 0xf8, 0x19,
+	0x37, 0x07, 0xca, 0xe4, 0xca, 0x38, 0x6f, 0x78, 0x8f, 0x8f, 0x34, 0x4c, 0x2b, 0x30, 0xd6, 0x44,
+	0xa8, 0x7d, 0xd8, 0x69, 0xde, 0x5c, 0xd2, 0xdd, 0xcd, 0x74, 0x73, 0x33, 0x4d, 0xbc, 0xc1, 0x05,
+	0xfa, 0xb5, 0xc5, 0xd7, 0x55, 0x90, 0xfc, 0x85, 0xe2, 0x57, 0x7c, 0xe2, 0x79, 0x09, 0x98, 0x52,
+	0x15, 0x06, 0xc2, 0x3e, 0x6e, 0xe8, 0xad, 0xfe, 0x65, 0x92, 0x3d, 0x4c, 0xef, 0xf8, 0x0f, 0xdd,
+	0xc5, 0xfa, 0xc3, 0xc5, 0x8a, 0xa0, 0xe5, 0x8a, 0xa0, 0xef, 0x15, 0x41, 0x1f, 0x6b, 0x12, 0x2c,
+	0xd7, 0x24, 0xf8, 0x5c, 0x93, 0xe0, 0xed, 0x6e, 0x24, 0xed, 0xb8, 0x4a, 0xa9, 0x50, 0x39, 0x13,
+	0xee, 0x61, 0x26, 0x53, 0xd1, 0xe5, 0x65, 0x69, 0x58, 0xae, 0xb2, 0xea, 0x1d, 0x0c, 0xe3, 0x66,
+	0x5e, 0x88, 0xae, 0xff, 0xad, 0x6b, 0x5f, 0x79, 0x5a, 0x77, 0x9d, 0xdf, 0xfe, 0x0c, 0x00, 0x35,
+	0xf6, 0x40, 0xc7, 0xc5, 0x01, 0x00, 0x00,
 }
 
 func (m *InterchainQueryPacketData) Marshal() (dAtA []byte, err error) {
@@ -28,7 +28,10 @@ func (p Params) Validate() error {
 	if err := validateEnabled(p.HostEnabled); err != nil {
 		return err
 	}
-	return validateAllowlist(p.AllowQueries)
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+	return validateStoreAllowlist(p.AllowStoreQueries)
 }
 
 func validateEnabled(i interface{}) error {
@@ -50,6 +53,27 @@ func validateAllowlist(i interface{}) error {
 		if strings.TrimSpace(path) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
 		}
+		if err := ValidateQueryPattern(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateStoreAllowlist(i interface{}) error {
+	allowStoreQueries, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, storeName := range allowStoreQueries {
+		if strings.TrimSpace(storeName) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowStoreQueries)
+		}
+		if _, ok := ParseStoreQueryPath(NewStoreQueryPath(storeName)); !ok {
+			return fmt.Errorf("invalid store name: %s", storeName)
+		}
 	}
 
 	return nil
@@ -3,7 +3,7 @@ package types_test
 import (
 	"testing"
 
-	"github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -11,3 +11,21 @@ func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
 }
+
+func TestValidateParamsAllowlistPatterns(t *testing.T) {
+	require.NoError(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/*", "/cosmos.staking.*", "!/cosmos.bank.v1beta1.Query/DenomOwners"}).Validate())
+	require.Error(t, types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/All*"}).Validate())
+	require.Error(t, types.NewParams(true, []string{"!"}).Validate())
+}
+
+func TestValidateParamsStoreAllowlist(t *testing.T) {
+	params := types.DefaultParams()
+	params.AllowStoreQueries = []string{"bank", "acc"}
+	require.NoError(t, params.Validate())
+
+	params.AllowStoreQueries = []string{" "}
+	require.Error(t, params.Validate())
+
+	params.AllowStoreQueries = []string{"bank/key"}
+	require.Error(t, params.Validate())
+}
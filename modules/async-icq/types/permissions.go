@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewQueryPermissions creates new query permissions
+func NewQueryPermissions(allowQueries, allowStoreQueries []string) QueryPermissions {
+	return QueryPermissions{
+		AllowQueries:      allowQueries,
+		AllowStoreQueries: allowStoreQueries,
+	}
+}
+
+// QueryPermissions returns the allowlists of the params, which apply to the channels and counterparty clients without
+// query permissions
+func (p Params) QueryPermissions() QueryPermissions {
+	return NewQueryPermissions(p.AllowQueries, p.AllowStoreQueries)
+}
+
+// Validate validates the allowlists of the query permissions
+func (p QueryPermissions) Validate() error {
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+	return validateStoreAllowlist(p.AllowStoreQueries)
+}
+
+// Validate performs basic validation of the ChannelQueryPermissions
+func (p ChannelQueryPermissions) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+	return p.Permissions.Validate()
+}
+
+// Validate performs basic validation of the ClientQueryPermissions
+func (p ClientQueryPermissions) Validate() error {
+	if err := host.ClientIdentifierValidator(p.ClientId); err != nil {
+		return err
+	}
+	return p.Permissions.Validate()
+}
+
+// validatePermissionsTarget checks that exactly one of the channel and client IDs of a message is set
+func validatePermissionsTarget(channelID, clientID string) error {
+	switch {
+	case channelID != "" && clientID != "":
+		return errors.Wrap(ErrInvalidPermissions, "only one of channel ID and client ID can be set")
+	case channelID != "":
+		return host.ChannelIdentifierValidator(channelID)
+	case clientID != "":
+		return host.ClientIdentifierValidator(clientID)
+	default:
+		return errors.Wrap(ErrInvalidPermissions, "channel ID or client ID must be set")
+	}
+}
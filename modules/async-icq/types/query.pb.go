@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,214 +112,2420 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "icq.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "icq.v1.QueryParamsResponse")
+// QueryPermissionsRequest is the request type for the Query/Permissions RPC method.
+type QueryPermissionsRequest struct {
 }
 
-func init() { proto.RegisterFile("icq/v1/query.proto", fileDescriptor_34e65615f053d386) }
+func (m *QueryPermissionsRequest) Reset()         { *m = QueryPermissionsRequest{} }
+func (m *QueryPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsRequest) ProtoMessage()    {}
+func (*QueryPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{2}
+}
+func (m *QueryPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsRequest.Merge(m, src)
+}
+func (m *QueryPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_34e65615f053d386 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0x4c, 0x2e, 0xd4,
-	0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0xcb, 0x4c, 0x2e, 0xd4, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f,
-	0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xa8,
-	0x92, 0x12, 0x80, 0xea, 0x04, 0x29, 0x06, 0x8b, 0x28, 0x89, 0x70, 0x09, 0x05, 0x82, 0x8c, 0x09,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0xb2, 0xe5, 0x12,
-	0x46, 0x11, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe3, 0x62, 0x2b, 0x00, 0x8b, 0x48,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1, 0xe9, 0x41, 0x6c, 0xd5, 0x83, 0xaa, 0x83, 0xca, 0x1a,
-	0x65, 0x70, 0xb1, 0x82, 0xb5, 0x0b, 0xc5, 0x73, 0xb1, 0x41, 0xa4, 0x84, 0xa4, 0x60, 0x4a, 0x31,
-	0x6d, 0x93, 0x92, 0xc6, 0x2a, 0x07, 0xb1, 0x53, 0x49, 0xa6, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x62,
-	0x42, 0x22, 0xfa, 0x89, 0xc5, 0x95, 0x79, 0xc9, 0xba, 0x50, 0x1f, 0x40, 0x6c, 0x72, 0xf2, 0x3f,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xcc, 0xa4, 0x64,
-	0xdd, 0xc4, 0x82, 0x82, 0x62, 0xfd, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x64, 0x13, 0x2d,
-	0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xc1, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0xa5, 0x54, 0x52, 0x64, 0x01, 0x00, 0x00,
+var xxx_messageInfo_QueryPermissionsRequest proto.InternalMessageInfo
+
+// QueryPermissionsResponse is the response type for the Query/Permissions RPC method.
+type QueryPermissionsResponse struct {
+	// channel_permissions defines the query permissions of the host channels.
+	ChannelPermissions []ChannelQueryPermissions `protobuf:"bytes,1,rep,name=channel_permissions,json=channelPermissions,proto3" json:"channel_permissions"`
+	// client_permissions defines the query permissions of the counterparty light clients.
+	ClientPermissions []ClientQueryPermissions `protobuf:"bytes,2,rep,name=client_permissions,json=clientPermissions,proto3" json:"client_permissions"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPermissionsResponse) Reset()         { *m = QueryPermissionsResponse{} }
+func (m *QueryPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsResponse) ProtoMessage()    {}
+func (*QueryPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{3}
+}
+func (m *QueryPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsResponse.Merge(m, src)
+}
+func (m *QueryPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPermissionsResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the ICQ module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPermissionsResponse) GetChannelPermissions() []ChannelQueryPermissions {
+	if m != nil {
+		return m.ChannelPermissions
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPermissionsResponse) GetClientPermissions() []ClientQueryPermissions {
+	if m != nil {
+		return m.ClientPermissions
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryQuotasRequest is the request type for the Query/Quotas RPC method.
+type QueryQuotasRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/icq.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQuotasRequest) Reset()         { *m = QueryQuotasRequest{} }
+func (m *QueryQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuotasRequest) ProtoMessage()    {}
+func (*QueryQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{4}
+}
+func (m *QueryQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuotasRequest.Merge(m, src)
+}
+func (m *QueryQuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuotasRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the ICQ module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryQuotasRequest proto.InternalMessageInfo
+
+// QueryQuotasResponse is the response type for the Query/Quotas RPC method.
+type QueryQuotasResponse struct {
+	// channel_quotas defines the query quotas of the host channels.
+	ChannelQuotas []ChannelQueryQuota `protobuf:"bytes,1,rep,name=channel_quotas,json=channelQuotas,proto3" json:"channel_quotas"`
+	// shared_stats defines the accounting of the current epoch of the channels without a query quota, which share the
+	// shared quota of the params.
+	SharedStats SharedQueryStats `protobuf:"bytes,2,opt,name=shared_stats,json=sharedStats,proto3" json:"shared_stats"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryQuotasResponse) Reset()         { *m = QueryQuotasResponse{} }
+func (m *QueryQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuotasResponse) ProtoMessage()    {}
+func (*QueryQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{5}
+}
+func (m *QueryQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuotasResponse.Merge(m, src)
+}
+func (m *QueryQuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuotasResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryQuotasResponse proto.InternalMessageInfo
+
+func (m *QueryQuotasResponse) GetChannelQuotas() []ChannelQueryQuota {
+	if m != nil {
+		return m.ChannelQuotas
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryQuotasResponse) GetSharedStats() SharedQueryStats {
+	if m != nil {
+		return m.SharedStats
+	}
+	return SharedQueryStats{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryChannelStatsRequest is the request type for the Query/ChannelStats RPC method.
+type QueryChannelStatsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelStatsRequest) Reset()         { *m = QueryChannelStatsRequest{} }
+func (m *QueryChannelStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelStatsRequest) ProtoMessage()    {}
+func (*QueryChannelStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{6}
+}
+func (m *QueryChannelStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryChannelStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelStatsRequest.Merge(m, src)
+}
+func (m *QueryChannelStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelStatsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelStatsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/icq.v1.Query/Params",
+	return ""
+}
+
+// QueryChannelStatsResponse is the response type for the Query/ChannelStats RPC method.
+type QueryChannelStatsResponse struct {
+	// epoch defines the current accounting epoch.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// stats defines the accounting of the current epoch.
+	Stats ChannelQueryStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+	// previous_stats defines the accounting of the previous epoch, if queries were received in it.
+	PreviousStats *ChannelQueryStats `protobuf:"bytes,3,opt,name=previous_stats,json=previousStats,proto3" json:"previous_stats,omitempty"`
+	// quota defines the query quota of the channel, if it is set.
+	Quota *QueryQuota `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (m *QueryChannelStatsResponse) Reset()         { *m = QueryChannelStatsResponse{} }
+func (m *QueryChannelStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelStatsResponse) ProtoMessage()    {}
+func (*QueryChannelStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{7}
+}
+func (m *QueryChannelStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryChannelStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelStatsResponse.Merge(m, src)
+}
+func (m *QueryChannelStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelStatsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelStatsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "icq.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "icq/v1/query.proto",
+func (m *QueryChannelStatsResponse) GetStats() ChannelQueryStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ChannelQueryStats{}
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelStatsResponse) GetPreviousStats() *ChannelQueryStats {
+	if m != nil {
+		return m.PreviousStats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelStatsResponse) GetQuota() *QueryQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryAllChannelStatsRequest is the request type for the Query/AllChannelStats RPC method.
+type QueryAllChannelStatsRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllChannelStatsRequest) Reset()         { *m = QueryAllChannelStatsRequest{} }
+func (m *QueryAllChannelStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelStatsRequest) ProtoMessage()    {}
+func (*QueryAllChannelStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{8}
+}
+func (m *QueryAllChannelStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllChannelStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelStatsRequest.Merge(m, src)
+}
+func (m *QueryAllChannelStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllChannelStatsRequest proto.InternalMessageInfo
+
+// QueryAllChannelStatsResponse is the response type for the Query/AllChannelStats RPC method.
+type QueryAllChannelStatsResponse struct {
+	// epoch defines the current accounting epoch.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// channel_stats defines the accounting of the current and previous epochs of the host channels.
+	ChannelStats []ChannelQueryStats `protobuf:"bytes,2,rep,name=channel_stats,json=channelStats,proto3" json:"channel_stats"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllChannelStatsResponse) Reset()         { *m = QueryAllChannelStatsResponse{} }
+func (m *QueryAllChannelStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelStatsResponse) ProtoMessage()    {}
+func (*QueryAllChannelStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{9}
+}
+func (m *QueryAllChannelStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelStatsResponse.Merge(m, src)
+}
+func (m *QueryAllChannelStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelStatsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryAllChannelStatsResponse) GetChannelStats() []ChannelQueryStats {
+	if m != nil {
+		return m.ChannelStats
+	}
+	return nil
+}
+
+// QueryAllowedQueriesRequest is the request type for the Query/AllowedQueries RPC method.
+type QueryAllowedQueriesRequest struct {
+}
+
+func (m *QueryAllowedQueriesRequest) Reset()         { *m = QueryAllowedQueriesRequest{} }
+func (m *QueryAllowedQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedQueriesRequest) ProtoMessage()    {}
+func (*QueryAllowedQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{10}
+}
+func (m *QueryAllowedQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedQueriesRequest.Merge(m, src)
+}
+func (m *QueryAllowedQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedQueriesRequest proto.InternalMessageInfo
+
+// QueryAllowedQueriesResponse is the response type for the Query/AllowedQueries RPC method.
+type QueryAllowedQueriesResponse struct {
+	// allowed_queries defines the entries of the allow_queries param, in their order.
+	AllowedQueries []AllowedQuery `protobuf:"bytes,1,rep,name=allowed_queries,json=allowedQueries,proto3" json:"allowed_queries"`
+}
+
+func (m *QueryAllowedQueriesResponse) Reset()         { *m = QueryAllowedQueriesResponse{} }
+func (m *QueryAllowedQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedQueriesResponse) ProtoMessage()    {}
+func (*QueryAllowedQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{11}
+}
+func (m *QueryAllowedQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedQueriesResponse.Merge(m, src)
+}
+func (m *QueryAllowedQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedQueriesResponse) GetAllowedQueries() []AllowedQuery {
+	if m != nil {
+		return m.AllowedQueries
+	}
+	return nil
+}
+
+// AllowedQuery defines an entry of the allow_queries param.
+type AllowedQuery struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// resolves is true if the entry matches at least one query path registered in the gRPC query router of the host.
+	Resolves bool `protobuf:"varint,2,opt,name=resolves,proto3" json:"resolves,omitempty"`
+}
+
+func (m *AllowedQuery) Reset()         { *m = AllowedQuery{} }
+func (m *AllowedQuery) String() string { return proto.CompactTextString(m) }
+func (*AllowedQuery) ProtoMessage()    {}
+func (*AllowedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34e65615f053d386, []int{12}
+}
+func (m *AllowedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedQuery.Merge(m, src)
+}
+func (m *AllowedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedQuery proto.InternalMessageInfo
+
+func (m *AllowedQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *AllowedQuery) GetResolves() bool {
+	if m != nil {
+		return m.Resolves
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "icq.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "icq.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPermissionsRequest)(nil), "icq.v1.QueryPermissionsRequest")
+	proto.RegisterType((*QueryPermissionsResponse)(nil), "icq.v1.QueryPermissionsResponse")
+	proto.RegisterType((*QueryQuotasRequest)(nil), "icq.v1.QueryQuotasRequest")
+	proto.RegisterType((*QueryQuotasResponse)(nil), "icq.v1.QueryQuotasResponse")
+	proto.RegisterType((*QueryChannelStatsRequest)(nil), "icq.v1.QueryChannelStatsRequest")
+	proto.RegisterType((*QueryChannelStatsResponse)(nil), "icq.v1.QueryChannelStatsResponse")
+	proto.RegisterType((*QueryAllChannelStatsRequest)(nil), "icq.v1.QueryAllChannelStatsRequest")
+	proto.RegisterType((*QueryAllChannelStatsResponse)(nil), "icq.v1.QueryAllChannelStatsResponse")
+	proto.RegisterType((*QueryAllowedQueriesRequest)(nil), "icq.v1.QueryAllowedQueriesRequest")
+	proto.RegisterType((*QueryAllowedQueriesResponse)(nil), "icq.v1.QueryAllowedQueriesResponse")
+	proto.RegisterType((*AllowedQuery)(nil), "icq.v1.AllowedQuery")
+}
+
+func init() { proto.RegisterFile("icq/v1/query.proto", fileDescriptor_34e65615f053d386) }
+
+var fileDescriptor_34e65615f053d386 = []byte{
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xdb, 0x26, 0x6a, 0x4f, 0xd2, 0xf4, 0xde, 0x49, 0xee, 0x25, 0x71, 0xd2, 0x24, 0x75,
+	0x01, 0x65, 0xd3, 0x98, 0x16, 0x81, 0xc4, 0x02, 0xa9, 0x3f, 0x08, 0x89, 0x5d, 0xeb, 0x4a, 0x2c,
+	0xd8, 0x44, 0x8e, 0x33, 0x4a, 0x2c, 0x39, 0x1e, 0xc7, 0xe3, 0x04, 0x05, 0x54, 0x09, 0xf1, 0x04,
+	0x48, 0xbc, 0x00, 0xef, 0xc1, 0x03, 0xd0, 0x65, 0x25, 0x58, 0xb0, 0x42, 0xa8, 0xe5, 0x41, 0x90,
+	0x67, 0xc6, 0xa9, 0x27, 0x71, 0x02, 0x3b, 0xcf, 0xf9, 0xf9, 0xbe, 0xf3, 0xcd, 0x39, 0x73, 0x0c,
+	0xc8, 0xb6, 0x86, 0xfa, 0x78, 0x5f, 0x1f, 0x8e, 0xb0, 0x3f, 0x69, 0x79, 0x3e, 0x09, 0x08, 0xca,
+	0xd8, 0xd6, 0xb0, 0x35, 0xde, 0x57, 0xab, 0x3d, 0x42, 0x7a, 0x0e, 0xd6, 0x4d, 0xcf, 0xd6, 0x4d,
+	0xd7, 0x25, 0x81, 0x19, 0xd8, 0xc4, 0xa5, 0x3c, 0x4a, 0x2d, 0xf6, 0x48, 0x8f, 0xb0, 0x4f, 0x3d,
+	0xfc, 0x12, 0xd6, 0x7f, 0x04, 0x5e, 0x08, 0xc1, 0x2c, 0x5a, 0x11, 0xd0, 0x59, 0x08, 0x7e, 0x6a,
+	0xfa, 0xe6, 0x80, 0x1a, 0x78, 0x38, 0xc2, 0x34, 0xd0, 0x9e, 0x42, 0x41, 0xb2, 0x52, 0x8f, 0xb8,
+	0x14, 0xa3, 0xfb, 0x90, 0xf1, 0x98, 0xa5, 0xa4, 0x34, 0x94, 0x66, 0xf6, 0x20, 0xdf, 0xe2, 0xb5,
+	0xb4, 0x44, 0x9c, 0xf0, 0x6a, 0x65, 0xb8, 0xc3, 0xd3, 0xb1, 0x3f, 0xb0, 0x29, 0x0d, 0xcb, 0x8a,
+	0x90, 0xbf, 0x28, 0x50, 0x9a, 0xf7, 0x09, 0xfc, 0x97, 0x50, 0xb0, 0xfa, 0xa6, 0xeb, 0x62, 0xa7,
+	0xed, 0xdd, 0xba, 0x4b, 0x4a, 0x63, 0xb5, 0x99, 0x3d, 0xa8, 0x47, 0x64, 0x27, 0x3c, 0x64, 0x16,
+	0xe5, 0x78, 0xed, 0xf2, 0x47, 0x3d, 0x65, 0x20, 0x81, 0x10, 0xf3, 0xa0, 0x73, 0x40, 0x96, 0x63,
+	0x63, 0x37, 0x90, 0x60, 0x57, 0x18, 0x6c, 0x6d, 0x0a, 0xcb, 0x22, 0x16, 0xa0, 0xfe, 0xcb, 0xf3,
+	0x63, 0x8e, 0xe9, 0xcd, 0x9d, 0x8d, 0x48, 0x60, 0x4e, 0xf5, 0x7d, 0x52, 0xa0, 0x20, 0x99, 0x85,
+	0xb4, 0xe7, 0x90, 0x8f, 0xa4, 0x0d, 0x99, 0x47, 0xa8, 0x2a, 0x27, 0xa9, 0x62, 0xb9, 0x82, 0x79,
+	0xd3, 0x8a, 0x1c, 0x61, 0x16, 0x3a, 0x82, 0x1c, 0xed, 0x9b, 0x3e, 0xee, 0xb6, 0x69, 0x60, 0x06,
+	0xa1, 0x88, 0xb0, 0x11, 0xa5, 0x08, 0xe5, 0x9c, 0xf9, 0x18, 0xc8, 0x79, 0xe8, 0x17, 0x20, 0x59,
+	0x9e, 0xc3, 0x4c, 0xda, 0x13, 0xd1, 0x01, 0xc1, 0xc8, 0x8c, 0xa2, 0x7c, 0xb4, 0x0d, 0x10, 0x95,
+	0x69, 0x77, 0x59, 0x97, 0x37, 0x8c, 0x0d, 0x61, 0x79, 0xd1, 0xd5, 0xbe, 0x29, 0x50, 0x4e, 0xc8,
+	0x15, 0x1a, 0x8b, 0x90, 0xc6, 0x1e, 0xb1, 0xfa, 0x2c, 0x6f, 0xcd, 0xe0, 0x07, 0xf4, 0x08, 0xd2,
+	0xf1, 0x52, 0x13, 0x05, 0xc7, 0x6b, 0xe5, 0xd1, 0xe8, 0x10, 0xf2, 0x9e, 0x8f, 0xc7, 0x36, 0x19,
+	0x51, 0x21, 0x75, 0xf5, 0x0f, 0xf9, 0xc6, 0x66, 0x94, 0xc0, 0x8e, 0xa8, 0x09, 0x69, 0x76, 0xd5,
+	0xa5, 0x35, 0x96, 0x88, 0xa2, 0xc4, 0xdb, 0x2b, 0x36, 0x78, 0x80, 0xb6, 0x0d, 0x15, 0x66, 0x3c,
+	0x72, 0x9c, 0x84, 0x4b, 0xd1, 0xde, 0x40, 0x35, 0xd9, 0xbd, 0x54, 0xf7, 0x33, 0x88, 0x5a, 0x37,
+	0x6d, 0xd5, 0xea, 0xdf, 0xe8, 0xcf, 0x59, 0x31, 0x0e, 0xad, 0x0a, 0x6a, 0xc4, 0x4d, 0x5e, 0xf3,
+	0xce, 0xda, 0x78, 0x5a, 0x59, 0x07, 0x2a, 0x89, 0x5e, 0x51, 0xd8, 0x09, 0x6c, 0x99, 0xdc, 0xd3,
+	0x1e, 0x72, 0x97, 0x98, 0xba, 0x62, 0x54, 0x44, 0x2c, 0x71, 0x22, 0xf8, 0xf3, 0xa6, 0x04, 0xa6,
+	0x1d, 0x42, 0x2e, 0x1e, 0x15, 0xaa, 0x65, 0xeb, 0x48, 0x4c, 0x07, 0x3f, 0x20, 0x15, 0xd6, 0x7d,
+	0x4c, 0x89, 0x33, 0xc6, 0xbc, 0xd1, 0xeb, 0xc6, 0xf4, 0x7c, 0xf0, 0x39, 0x0d, 0x69, 0x9e, 0xdb,
+	0x86, 0x0c, 0x5f, 0x15, 0x48, 0x95, 0xba, 0x21, 0x6d, 0x1f, 0xb5, 0x92, 0xe8, 0xe3, 0x9a, 0xb4,
+	0xea, 0xfb, 0xaf, 0xbf, 0x3e, 0xae, 0xfc, 0x8f, 0x8a, 0xba, 0x49, 0x27, 0xae, 0xb5, 0x27, 0x36,
+	0x1a, 0xdf, 0x3c, 0x88, 0x42, 0x36, 0xfe, 0xf0, 0xeb, 0x32, 0xd2, 0xdc, 0x3a, 0x52, 0x1b, 0x8b,
+	0x03, 0x04, 0xdf, 0x0e, 0xe3, 0xab, 0xa0, 0xf2, 0x0c, 0x5f, 0x8c, 0xa5, 0x0d, 0x19, 0xf1, 0x3a,
+	0xd5, 0xf9, 0x19, 0x5b, 0xa0, 0x4a, 0x5e, 0x0f, 0x8b, 0x54, 0xf1, 0x55, 0x81, 0x2e, 0x20, 0x17,
+	0x1f, 0x3c, 0x24, 0x57, 0x9d, 0x30, 0xb2, 0xea, 0xce, 0x92, 0x08, 0x41, 0xd9, 0x64, 0x94, 0x1a,
+	0x6a, 0xc8, 0x94, 0x6c, 0x56, 0xf5, 0xb7, 0xb7, 0x5b, 0xe0, 0x02, 0x4d, 0x60, 0x6b, 0x66, 0xf4,
+	0xd1, 0xae, 0x84, 0x9f, 0xfc, 0x6e, 0xd4, 0xbb, 0xcb, 0x83, 0x44, 0x1d, 0x15, 0x56, 0xc7, 0x7f,
+	0xa8, 0x90, 0x50, 0x07, 0x7a, 0xa7, 0x40, 0x5e, 0x1e, 0x6e, 0xa4, 0xcd, 0xa2, 0xce, 0xbf, 0x0b,
+	0x75, 0x77, 0x69, 0x8c, 0x20, 0xbe, 0xc7, 0x88, 0xeb, 0x68, 0x5b, 0x26, 0x9e, 0x79, 0x31, 0xc7,
+	0xa7, 0x97, 0xd7, 0x35, 0xe5, 0xea, 0xba, 0xa6, 0xfc, 0xbc, 0xae, 0x29, 0x1f, 0x6e, 0x6a, 0xa9,
+	0xab, 0x9b, 0x5a, 0xea, 0xfb, 0x4d, 0x2d, 0xf5, 0xea, 0x71, 0xcf, 0x0e, 0xfa, 0xa3, 0x4e, 0xcb,
+	0x22, 0x03, 0xdd, 0x22, 0x74, 0x40, 0xa8, 0x6e, 0x77, 0xac, 0x3d, 0xd3, 0xf3, 0xa8, 0x3e, 0x20,
+	0xdd, 0x91, 0x83, 0xa9, 0x04, 0xfd, 0x40, 0x0f, 0x26, 0x1e, 0xa6, 0x9d, 0x0c, 0xfb, 0xf5, 0x3e,
+	0xfc, 0x3d, 0x00, 0x94, 0xd8, 0x8d, 0x3d, 0xde, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the ICQ module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Permissions queries the query permissions of the channels and counterparty light clients.
+	Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
+	// Quotas queries the query quotas of the host channels.
+	Quotas(ctx context.Context, in *QueryQuotasRequest, opts ...grpc.CallOption) (*QueryQuotasResponse, error)
+	// ChannelStats queries the accounting of the queries received on a host channel, with its query quota.
+	ChannelStats(ctx context.Context, in *QueryChannelStatsRequest, opts ...grpc.CallOption) (*QueryChannelStatsResponse, error)
+	// AllChannelStats queries the accounting of the queries received on all host channels.
+	AllChannelStats(ctx context.Context, in *QueryAllChannelStatsRequest, opts ...grpc.CallOption) (*QueryAllChannelStatsResponse, error)
+	// AllowedQueries queries the entries of the allow_queries param, with whether they resolve to query paths
+	// registered on the host.
+	AllowedQueries(ctx context.Context, in *QueryAllowedQueriesRequest, opts ...grpc.CallOption) (*QueryAllowedQueriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Permissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/Permissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Quotas(ctx context.Context, in *QueryQuotasRequest, opts ...grpc.CallOption) (*QueryQuotasResponse, error) {
+	out := new(QueryQuotasResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/Quotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelStats(ctx context.Context, in *QueryChannelStatsRequest, opts ...grpc.CallOption) (*QueryChannelStatsResponse, error) {
+	out := new(QueryChannelStatsResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/ChannelStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllChannelStats(ctx context.Context, in *QueryAllChannelStatsRequest, opts ...grpc.CallOption) (*QueryAllChannelStatsResponse, error) {
+	out := new(QueryAllChannelStatsResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/AllChannelStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedQueries(ctx context.Context, in *QueryAllowedQueriesRequest, opts ...grpc.CallOption) (*QueryAllowedQueriesResponse, error) {
+	out := new(QueryAllowedQueriesResponse)
+	err := c.cc.Invoke(ctx, "/icq.v1.Query/AllowedQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the ICQ module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Permissions queries the query permissions of the channels and counterparty light clients.
+	Permissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
+	// Quotas queries the query quotas of the host channels.
+	Quotas(context.Context, *QueryQuotasRequest) (*QueryQuotasResponse, error)
+	// ChannelStats queries the accounting of the queries received on a host channel, with its query quota.
+	ChannelStats(context.Context, *QueryChannelStatsRequest) (*QueryChannelStatsResponse, error)
+	// AllChannelStats queries the accounting of the queries received on all host channels.
+	AllChannelStats(context.Context, *QueryAllChannelStatsRequest) (*QueryAllChannelStatsResponse, error)
+	// AllowedQueries queries the entries of the allow_queries param, with whether they resolve to query paths
+	// registered on the host.
+	AllowedQueries(context.Context, *QueryAllowedQueriesRequest) (*QueryAllowedQueriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Permissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Permissions not implemented")
+}
+func (*UnimplementedQueryServer) Quotas(ctx context.Context, req *QueryQuotasRequest) (*QueryQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quotas not implemented")
+}
+func (*UnimplementedQueryServer) ChannelStats(ctx context.Context, req *QueryChannelStatsRequest) (*QueryChannelStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelStats not implemented")
+}
+func (*UnimplementedQueryServer) AllChannelStats(ctx context.Context, req *QueryAllChannelStatsRequest) (*QueryAllChannelStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllChannelStats not implemented")
+}
+func (*UnimplementedQueryServer) AllowedQueries(ctx context.Context, req *QueryAllowedQueriesRequest) (*QueryAllowedQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedQueries not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Permissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Permissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/Permissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Permissions(ctx, req.(*QueryPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Quotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Quotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/Quotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Quotas(ctx, req.(*QueryQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/ChannelStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelStats(ctx, req.(*QueryChannelStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllChannelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllChannelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/AllChannelStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllChannelStats(ctx, req.(*QueryAllChannelStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/icq.v1.Query/AllowedQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedQueries(ctx, req.(*QueryAllowedQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "icq.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Permissions",
+			Handler:    _Query_Permissions_Handler,
+		},
+		{
+			MethodName: "Quotas",
+			Handler:    _Query_Quotas_Handler,
+		},
+		{
+			MethodName: "ChannelStats",
+			Handler:    _Query_ChannelStats_Handler,
+		},
+		{
+			MethodName: "AllChannelStats",
+			Handler:    _Query_AllChannelStats_Handler,
+		},
+		{
+			MethodName: "AllowedQueries",
+			Handler:    _Query_AllowedQueries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "icq/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientPermissions) > 0 {
+		for iNdEx := len(m.ClientPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelPermissions) > 0 {
+		for iNdEx := len(m.ChannelPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SharedStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelQuotas) > 0 {
+		for iNdEx := len(m.ChannelQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreviousStats != nil {
+		{
+			size, err := m.PreviousStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelStats) > 0 {
+		for iNdEx := len(m.ChannelStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedQueries) > 0 {
+		for iNdEx := len(m.AllowedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolves {
+		i--
+		if m.Resolves {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelPermissions) > 0 {
+		for _, e := range m.ChannelPermissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClientPermissions) > 0 {
+		for _, e := range m.ClientPermissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelQuotas) > 0 {
+		for _, e := range m.ChannelQuotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SharedStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PreviousStats != nil {
+		l = m.PreviousStats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChannelStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChannelStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.ChannelStats) > 0 {
+		for _, e := range m.ChannelStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowedQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedQueries) > 0 {
+		for _, e := range m.AllowedQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Resolves {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPermissions = append(m.ChannelPermissions, ChannelQueryPermissions{})
+			if err := m.ChannelPermissions[len(m.ChannelPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPermissions = append(m.ClientPermissions, ClientQueryPermissions{})
+			if err := m.ClientPermissions[len(m.ClientPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuotasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuotasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelQuotas = append(m.ChannelQuotas, ChannelQueryQuota{})
+			if err := m.ChannelQuotas[len(m.ChannelQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharedStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStats == nil {
+				m.PreviousStats = &ChannelQueryStats{}
+			}
+			if err := m.PreviousStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &QueryQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllChannelStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStats = append(m.ChannelStats, ChannelQueryStats{})
+			if err := m.ChannelStats[len(m.ChannelStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedQueriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -341,10 +2548,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedQueriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -368,7 +2575,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedQueriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -391,15 +2598,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedQueriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -426,13 +2633,113 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.AllowedQueries = append(m.AllowedQueries, AllowedQuery{})
+			if err := m.AllowedQueries[len(m.AllowedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolves", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolves = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewQueryQuota creates a new query quota
+func NewQueryQuota(maxQueries, maxGas uint64) QueryQuota {
+	return QueryQuota{
+		MaxQueries: maxQueries,
+		MaxGas:     maxGas,
+	}
+}
+
+// Validate checks that the query quota limits at least the queries or the gas of an epoch
+func (q QueryQuota) Validate() error {
+	if q.MaxQueries == 0 && q.MaxGas == 0 {
+		return errors.Wrap(ErrInvalidQuota, "quota must limit the queries or the gas")
+	}
+	return nil
+}
+
+// CheckStats returns an error if the queries served on the channel in the epoch have reached the quota
+func (q QueryQuota) CheckStats(stats ChannelQueryStats) error {
+	return errors.Wrapf(q.check(stats.QueriesServed, stats.GasConsumed, stats.Epoch), "channel %s", stats.ChannelId)
+}
+
+// CheckSharedStats returns an error if the queries served on the channels without a query quota in the epoch have
+// reached the quota
+func (q QueryQuota) CheckSharedStats(stats SharedQueryStats) error {
+	return errors.Wrap(q.check(stats.QueriesServed, stats.GasConsumed, stats.Epoch), "shared quota")
+}
+
+func (q QueryQuota) check(queries, gasConsumed, epoch uint64) error {
+	if q.MaxQueries != 0 && queries >= q.MaxQueries {
+		return errors.Wrapf(ErrQuotaExceeded, "%d queries served in epoch %d, max is %d", queries, epoch, q.MaxQueries)
+	}
+	if q.MaxGas != 0 && gasConsumed >= q.MaxGas {
+		return errors.Wrapf(ErrQuotaExceeded, "queries consumed %d gas in epoch %d, max is %d", gasConsumed, epoch, q.MaxGas)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the ChannelQueryQuota
+func (q ChannelQueryQuota) Validate() error {
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return err
+	}
+	return q.Quota.Validate()
+}
+
+// Validate performs basic validation of the ChannelQueryStats
+func (s ChannelQueryStats) Validate() error {
+	return host.ChannelIdentifierValidator(s.ChannelId)
+}
+
+// Epoch returns the accounting epoch of the block height
+func (p Params) Epoch(height int64) uint64 {
+	if p.EpochBlocks == 0 || height <= 0 {
+		return 0
+	}
+	return uint64(height) / p.EpochBlocks
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"math"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// MaxRecurringQueryInterval is the highest interval of a recurring query, in blocks, so that the height of its next
+// run cannot overflow
+const MaxRecurringQueryInterval = math.MaxUint32
+
+const (
+	// DefaultMaxRecurringQueriesPerBlock is the default maximum number of recurring query runs sent in a block
+	DefaultMaxRecurringQueriesPerBlock = 100
+	// DefaultMaxRecurringQueriesPerOwner is the default maximum number of recurring queries of an owner
+	DefaultMaxRecurringQueriesPerOwner = 10
+	// DefaultMaxRecurringQueryRequests is the default maximum number of requests of a recurring query
+	DefaultMaxRecurringQueryRequests = 10
+	// DefaultMaxRecurringQueryBytes is the default maximum total size of the requests of a recurring query
+	DefaultMaxRecurringQueryBytes = 10_000
+)
+
+var (
+	// DefaultRecurringQueryDeposit is the default flat deposit of a recurring query
+	DefaultRecurringQueryDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+	// DefaultRecurringQueryByteDeposit is the default deposit per byte of the requests of a recurring query
+	DefaultRecurringQueryByteDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+)
+
+// DefaultControllerParams is the default parameter configuration of the controller, which bounds the recurring
+// queries and takes a deposit growing with their size
+func DefaultControllerParams() ControllerParams {
+	return ControllerParams{
+		RecurringQueryDeposit:       DefaultRecurringQueryDeposit,
+		RecurringQueryByteDeposit:   DefaultRecurringQueryByteDeposit,
+		MaxRecurringQueriesPerBlock: DefaultMaxRecurringQueriesPerBlock,
+		MaxRecurringQueriesPerOwner: DefaultMaxRecurringQueriesPerOwner,
+		MaxRecurringQueryRequests:   DefaultMaxRecurringQueryRequests,
+		MaxRecurringQueryBytes:      DefaultMaxRecurringQueryBytes,
+	}
+}
+
+// Validate validates the controller parameters
+func (p ControllerParams) Validate() error {
+	if err := p.RecurringQueryDeposit.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidQuery, "invalid recurring query deposit: %v", err)
+	}
+	if err := p.RecurringQueryByteDeposit.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidQuery, "invalid recurring query byte deposit: %v", err)
+	}
+	return nil
+}
+
+// ValidateRecurringQueryRequests checks the requests of a recurring query against the limits of the params
+func (p ControllerParams) ValidateRecurringQueryRequests(reqs []abcitypes.RequestQuery) error {
+	if p.MaxRecurringQueryRequests != 0 && uint64(len(reqs)) > p.MaxRecurringQueryRequests {
+		return errors.Wrapf(ErrRecurringQueryLimit, "recurring query has %d requests, max is %d", len(reqs), p.MaxRecurringQueryRequests)
+	}
+	if size := RecurringQueryRequestsSize(reqs); p.MaxRecurringQueryBytes != 0 && size > p.MaxRecurringQueryBytes {
+		return errors.Wrapf(ErrRecurringQueryLimit, "recurring query requests have %d bytes, max is %d", size, p.MaxRecurringQueryBytes)
+	}
+	return nil
+}
+
+// RecurringQueryDepositFor returns the deposit of a recurring query with the requests: the flat deposit, and the
+// byte deposit for every byte of the requests
+func (p ControllerParams) RecurringQueryDepositFor(reqs []abcitypes.RequestQuery) sdk.Coins {
+	size := sdkmath.NewIntFromUint64(RecurringQueryRequestsSize(reqs))
+	return p.RecurringQueryDeposit.Add(p.RecurringQueryByteDeposit.MulInt(size)...)
+}
+
+// RecurringQueryRequestsSize returns the total size in bytes of the encoded requests of a recurring query
+func RecurringQueryRequestsSize(reqs []abcitypes.RequestQuery) uint64 {
+	var size uint64
+	for _, req := range reqs {
+		size += uint64(req.Size())
+	}
+	return size
+}
+
+// Validate performs basic validation of the RecurringQuery
+func (q RecurringQuery) Validate() error {
+	if q.Id == 0 {
+		return errors.Wrap(ErrInvalidQuery, "recurring query ID cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(q.Owner); err != nil {
+		return errors.Wrapf(ErrInvalidQuery, "invalid owner address: %v", err)
+	}
+	if err := ValidateRecurringQuerySchedule(q.ChannelId, q.Requests, q.Interval, q.MaxRuns, q.RelativeTimeout); err != nil {
+		return err
+	}
+	if q.Runs > q.MaxRuns {
+		return errors.Wrapf(ErrInvalidQuery, "recurring query ran %d times, max is %d", q.Runs, q.MaxRuns)
+	}
+	if q.HasRunsLeft() && q.NextRunHeight <= 0 {
+		return errors.Wrap(ErrInvalidQuery, "next run height must be positive")
+	}
+	if err := q.Deposit.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidQuery, "invalid deposit: %v", err)
+	}
+	return nil
+}
+
+// HasRunsLeft returns true if the recurring query has not been sent max runs times yet
+func (q RecurringQuery) HasRunsLeft() bool {
+	return q.Runs < q.MaxRuns
+}
+
+// Validate performs basic validation of the RecurringQueryResult
+func (r RecurringQueryResult) Validate() error {
+	if r.RecurringQueryId == 0 {
+		return errors.Wrap(ErrInvalidQuery, "recurring query ID cannot be 0")
+	}
+	if r.Run == 0 {
+		return errors.Wrap(ErrInvalidQuery, "run cannot be 0")
+	}
+	return nil
+}
+
+// ValidateRecurringQuerySchedule checks the channel, requests and schedule of a recurring query
+func ValidateRecurringQuerySchedule(channelID string, reqs []abcitypes.RequestQuery, interval, maxRuns, relativeTimeout uint64) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if len(reqs) == 0 {
+		return errors.Wrap(ErrInvalidQuery, "requests cannot be empty")
+	}
+	for _, req := range reqs {
+		if req.Path == "" {
+			return errors.Wrap(ErrInvalidQuery, "request path cannot be empty")
+		}
+	}
+	if interval == 0 {
+		return errors.Wrap(ErrInvalidQuery, "interval cannot be 0")
+	}
+	if interval > MaxRecurringQueryInterval {
+		return errors.Wrapf(ErrInvalidQuery, "interval cannot be greater than %d blocks", uint64(MaxRecurringQueryInterval))
+	}
+	if maxRuns == 0 {
+		return errors.Wrap(ErrInvalidQuery, "max runs cannot be 0")
+	}
+	if relativeTimeout == 0 {
+		return errors.Wrap(ErrInvalidQuery, "timeout cannot be 0")
+	}
+	return nil
+}
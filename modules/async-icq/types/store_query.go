@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+const (
+	// StoreQueryPathPrefix prefixes the raw store query paths, which query a key of a store with a proof
+	StoreQueryPathPrefix = "/store/"
+	// StoreQueryPathSuffix ends the raw store query paths
+	StoreQueryPathSuffix = "/key"
+)
+
+// NewStoreQueryPath returns the raw query path of the keys of the store
+func NewStoreQueryPath(storeName string) string {
+	return StoreQueryPathPrefix + storeName + StoreQueryPathSuffix
+}
+
+// ParseStoreQueryPath returns the store name of a raw "/store/<store name>/key" query path, and false if the path is
+// not a raw store query path
+func ParseStoreQueryPath(path string) (string, bool) {
+	storeName, ok := strings.CutPrefix(path, StoreQueryPathPrefix)
+	if !ok {
+		return "", false
+	}
+	storeName, ok = strings.CutSuffix(storeName, StoreQueryPathSuffix)
+	if !ok || storeName == "" || strings.Contains(storeName, "/") {
+		return "", false
+	}
+	return storeName, true
+}
+
+// VerifyStoreQueryResponse verifies the proof of the response to a raw store query of the key against the commitment
+// root of the host chain at the height following the response height, which commits to the state at the response
+// height. An empty value is verified as the absence of the key
+func VerifyStoreQueryResponse(root ibcexported.Root, storeName string, key []byte, resp abci.ResponseQuery) error {
+	if resp.Code != 0 {
+		return errors.Wrapf(ErrInvalidProof, "query failed with code %d", resp.Code)
+	}
+	if !bytes.Equal(resp.Key, key) {
+		return errors.Wrapf(ErrInvalidProof, "response key %X does not match the query key %X", resp.Key, key)
+	}
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return errors.Wrap(ErrInvalidProof, "response has no proof")
+	}
+
+	proof, err := commitmenttypes.ConvertProofs(resp.ProofOps)
+	if err != nil {
+		return errors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	path := commitmenttypes.NewMerklePath([]byte(storeName), key)
+	if len(resp.Value) == 0 {
+		err = proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+	} else {
+		err = proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, resp.Value)
+	}
+	if err != nil {
+		return errors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	return nil
+}
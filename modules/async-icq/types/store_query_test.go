@@ -0,0 +1,24 @@
+package types_test
+
+import "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+func (suite *TypesTestSuite) TestParseStoreQueryPath() {
+	testCases := []struct {
+		path         string
+		expStoreName string
+		expOk        bool
+	}{
+		{"/store/bank/key", "bank", true},
+		{types.NewStoreQueryPath("acc"), "acc", true},
+		{"/store//key", "", false},
+		{"/store/bank/subspace", "", false},
+		{"/store/bank/other/key", "", false},
+		{"/cosmos.bank.v1beta1.Query/AllBalances", "", false},
+	}
+
+	for _, tc := range testCases {
+		storeName, ok := types.ParseStoreQueryPath(tc.path)
+		suite.Require().Equal(tc.expOk, ok, tc.path)
+		suite.Require().Equal(tc.expStoreName, storeName, tc.path)
+	}
+}
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ api.IBCModule = (*IBCModule)(nil)
+
+// IBCModule implements the IBC v2 interface for interchain query host chains. Queries are received over the
+// clients of the counterparty chains, without a channel handshake
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface
+func (im IBCModule) OnSendPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot send a packet from a host, a host chain only answers queries")
+}
+
+// OnRecvPacket implements the IBCModule interface. The queries are executed with the permissions set for the
+// destination client ID
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	packet := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
+
+	if err := im.validatePayload(ctx, payload); err != nil {
+		keeper.EmitWriteErrorAcknowledgementEvent(ctx, packet, err)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	if err != nil {
+		// Emit an event including the error msg
+		keeper.EmitWriteErrorAcknowledgementEvent(ctx, packet, err)
+
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	keeper.EmitWriteSuccessAcknowledgementEvent(ctx, packet)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(txResponse).Acknowledgement(),
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host, a host chain does not send packets")
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	_ uint64,
+	_ []byte,
+	_ channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) error {
+	return errors.Wrap(types.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host, a host chain does not send packets")
+}
+
+// validatePayload checks the host is enabled and the payload is addressed to the host port, with the interchain
+// query version and encoding. There is no channel handshake in IBC v2, so these are checked for every packet
+func (im IBCModule) validatePayload(ctx sdk.Context, payload channeltypesv2.Payload) error {
+	if !im.keeper.IsHostEnabled(ctx) {
+		return types.ErrHostDisabled
+	}
+
+	if boundPort := im.keeper.GetPort(ctx); payload.DestinationPort != boundPort {
+		return errors.Wrapf(types.ErrInvalidHostPort, "expected %s, got %s", boundPort, payload.DestinationPort)
+	}
+	if payload.Version != types.Version {
+		return errors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", payload.Version, types.Version)
+	}
+	if payload.Encoding != types.EncodingJSON {
+		return errors.Wrapf(types.ErrInvalidEncoding, "got %s, expected %s", payload.Encoding, types.EncodingJSON)
+	}
+	return nil
+}
+
+// v2ToV1Packet converts the payload into the packet handled by the keeper, with the client IDs in place of the
+// channel IDs
+func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               payload.Value,
+	}
+}
@@ -0,0 +1,235 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/testing/simapp"
+	"github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	v2 "github.com/cosmos/ibc-apps/modules/async-icq/v10/v2"
+	"github.com/stretchr/testify/suite"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const allBalancesPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (suite *IBCModuleTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+// newAllBalancesPayload returns the payload of a query of the balances of the sender account of chainB
+func (suite *IBCModuleTestSuite) newAllBalancesPayload() channeltypesv2.Payload {
+	q := banktypes.QueryAllBalancesRequest{
+		Address: suite.chainB.SenderAccount.GetAddress().String(),
+	}
+	reqs := []abcitypes.RequestQuery{
+		{
+			Path: allBalancesPath,
+			Data: simapp.GetSimApp(suite.chainB).AppCodec().MustMarshal(&q),
+		},
+	}
+	data, err := types.SerializeCosmosQuery(reqs)
+	suite.Require().NoError(err)
+
+	packetData := types.InterchainQueryPacketData{Data: data}
+	return channeltypesv2.NewPayload(types.ControllerPortID, types.PortID, types.Version, types.EncodingJSON, packetData.GetBytes())
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacket() {
+	var (
+		path    *ibctesting.Path
+		payload channeltypesv2.Payload
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		expStatus channeltypesv2.PacketStatus
+	}{
+		{
+			"success",
+			func() {},
+			true,
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"host disabled",
+			func() {
+				params := types.NewParams(false, []string{allBalancesPath})
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"query not allowed",
+			func() {
+				params := types.NewParams(true, nil)
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"query allowed by the permissions of the client",
+			func() {
+				params := types.NewParams(true, nil)
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+				permissions := types.NewQueryPermissions([]string{allBalancesPath}, nil)
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetChannelQueryPermissions(suite.chainB.GetContext(), path.EndpointB.ClientID, permissions)
+			},
+			true,
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"query allowed by the permissions of the client",
+			func() {
+				params := types.NewParams(true, nil)
+				suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+				permissions := types.NewQueryPermissions([]string{allBalancesPath}, nil)
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetClientQueryPermissions(suite.chainB.GetContext(), path.EndpointB.ClientID, permissions)
+			},
+			true,
+			channeltypesv2.PacketStatus_Success,
+		},
+		{
+			"query denied by the permissions of the client",
+			func() {
+				permissions := types.NewQueryPermissions(nil, nil)
+				simapp.GetSimApp(suite.chainB).ICQKeeper.SetClientQueryPermissions(suite.chainB.GetContext(), path.EndpointB.ClientID, permissions)
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"invalid destination port",
+			func() {
+				payload.DestinationPort = ibctesting.MockPort
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"invalid version",
+			func() {
+				payload.Version = "icq-2"
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"invalid encoding",
+			func() {
+				payload.Encoding = "application/x-protobuf"
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+		{
+			"invalid packet data",
+			func() {
+				payload.Value = []byte("invalid packet data")
+			},
+			false,
+			channeltypesv2.PacketStatus_Failure,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			params := types.NewParams(true, []string{allBalancesPath})
+			suite.Require().NoError(simapp.GetSimApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params))
+
+			payload = suite.newAllBalancesPayload()
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainB.GetContext()
+			module := v2.NewIBCModule(simapp.GetSimApp(suite.chainB).ICQKeeper)
+			res := module.OnRecvPacket(ctx, path.EndpointA.ClientID, path.EndpointB.ClientID, 1, payload, suite.chainB.SenderAccount.GetAddress())
+
+			suite.Require().Equal(tc.expStatus, res.Status)
+			if tc.expPass {
+				var ack channeltypes.Acknowledgement
+				suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(res.Acknowledgement, &ack))
+				suite.Require().True(ack.Success())
+
+				var icqAck types.InterchainQueryPacketAck
+				suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &icqAck))
+				resps, err := types.DeserializeCosmosResponse(icqAck.Data)
+				suite.Require().NoError(err)
+				suite.Require().Len(resps, 1)
+
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypePacketSuccess {
+						found = true
+					}
+				}
+				suite.Require().True(found)
+			} else {
+				suite.Require().Empty(res.Acknowledgement)
+
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypePacketError {
+						found = true
+					}
+				}
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *IBCModuleTestSuite) TestInvalidChannelFlow() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupV2()
+
+	ctx := suite.chainB.GetContext()
+	module := v2.NewIBCModule(simapp.GetSimApp(suite.chainB).ICQKeeper)
+	payload := suite.newAllBalancesPayload()
+
+	err := module.OnSendPacket(ctx, path.EndpointB.ClientID, path.EndpointA.ClientID, 1, payload, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelFlow)
+
+	err = module.OnAcknowledgementPacket(ctx, path.EndpointB.ClientID, path.EndpointA.ClientID, 1, []byte("ack"), payload, nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelFlow)
+
+	err = module.OnTimeoutPacket(ctx, path.EndpointB.ClientID, path.EndpointA.ClientID, 1, payload, nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelFlow)
+}
@@ -0,0 +1,141 @@
+package wasmbinding
+
+import (
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// ICQMsg is the custom CosmosMsg contracts send to use the interchain query controller
+type ICQMsg struct {
+	// SendQuery sends an interchain query on behalf of the contract
+	SendQuery *SendQuery `json:"send_query,omitempty"`
+}
+
+// SendQuery sends the query requests to the host chain of a controller channel. The result of the query is
+// delivered to the contract with an icq_response or icq_timeout sudo message
+type SendQuery struct {
+	// ChannelID is the controller channel the query is sent on
+	ChannelID string `json:"channel_id"`
+	// Requests are the ABCI query requests sent to the host chain
+	Requests []QueryRequest `json:"requests"`
+	// TimeoutSeconds is the number of seconds after which the query times out
+	TimeoutSeconds uint64 `json:"timeout_seconds"`
+}
+
+// QueryRequest is an ABCI query request, whose data is the protobuf encoded request of the query path, or the key of
+// a raw "/store/<store name>/key" query path
+type QueryRequest struct {
+	Path string `json:"path"`
+	Data []byte `json:"data"`
+	// Height is the height of the query, 0 for the latest state of the host chain
+	Height int64 `json:"height,omitempty"`
+	// Prove requests a proof of the response to a raw store query
+	Prove bool `json:"prove,omitempty"`
+}
+
+// SendQueryResponse is the data returned to the contract by the SendQuery message
+type SendQueryResponse struct {
+	// Sequence identifies the query in the sudo message delivering its result, along with the channel
+	Sequence uint64 `json:"sequence"`
+}
+
+// SudoMsg is the sudo message delivering the result of a query to the contract which sent it
+type SudoMsg struct {
+	ICQResponse *ICQResponse `json:"icq_response,omitempty"`
+	ICQTimeout  *ICQTimeout  `json:"icq_timeout,omitempty"`
+}
+
+// ICQResponse is sent when the host chain acknowledges the query, either with the responses to its requests or
+// with an error
+type ICQResponse struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	// Response is the decoded CosmosResponse of the host chain, nil if the query failed
+	Response *CosmosResponse `json:"response,omitempty"`
+	// Error is the error the host chain acknowledged the query with, empty if the query succeeded
+	Error string `json:"error,omitempty"`
+}
+
+// ICQTimeout is sent when the query times out before being acknowledged
+type ICQTimeout struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+// CosmosResponse contains the responses to the requests of a query, in the order of the requests
+type CosmosResponse struct {
+	Responses []QueryResponse `json:"responses"`
+}
+
+// QueryResponse is an ABCI query response, whose value is the protobuf encoded response of the query path. The key
+// and proof ops are set for the raw store queries requesting a proof, so that the contract can have the response
+// verified against the light client of the host chain
+type QueryResponse struct {
+	Code      uint32    `json:"code"`
+	Codespace string    `json:"codespace,omitempty"`
+	Log       string    `json:"log,omitempty"`
+	Key       []byte    `json:"key,omitempty"`
+	Value     []byte    `json:"value"`
+	ProofOps  *ProofOps `json:"proof_ops,omitempty"`
+	Height    int64     `json:"height"`
+}
+
+// ProofOps is the Merkle proof of a raw store query response, from the key to the app hash of the host chain
+type ProofOps struct {
+	Ops []ProofOp `json:"ops"`
+}
+
+// ProofOp is a step of the Merkle proof of a raw store query response
+type ProofOp struct {
+	Type string `json:"type"`
+	Key  []byte `json:"key"`
+	Data []byte `json:"data"`
+}
+
+// toRequestQueries converts the query requests of a contract to ABCI query requests
+func toRequestQueries(reqs []QueryRequest) []abcitypes.RequestQuery {
+	requests := make([]abcitypes.RequestQuery, len(reqs))
+	for i, req := range reqs {
+		requests[i] = abcitypes.RequestQuery{
+			Path:   req.Path,
+			Data:   req.Data,
+			Height: req.Height,
+			Prove:  req.Prove,
+		}
+	}
+	return requests
+}
+
+// newCosmosResponse converts the ABCI query responses of the host chain to the response sent to the contract
+func newCosmosResponse(responses []abcitypes.ResponseQuery) *CosmosResponse {
+	res := &CosmosResponse{Responses: make([]QueryResponse, len(responses))}
+	for i, resp := range responses {
+		res.Responses[i] = QueryResponse{
+			Code:      resp.Code,
+			Codespace: resp.Codespace,
+			Log:       resp.Log,
+			Key:       resp.Key,
+			Value:     resp.Value,
+			ProofOps:  newProofOps(resp.ProofOps),
+			Height:    resp.Height,
+		}
+	}
+	return res
+}
+
+// newProofOps converts the proof of an ABCI query response, which is nil if the response has no proof
+func newProofOps(proofOps *cmtcrypto.ProofOps) *ProofOps {
+	if proofOps == nil {
+		return nil
+	}
+
+	res := &ProofOps{Ops: make([]ProofOp, len(proofOps.Ops))}
+	for i, op := range proofOps.Ops {
+		res.Ops[i] = ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+	return res
+}
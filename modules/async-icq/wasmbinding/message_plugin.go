@@ -0,0 +1,69 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	controllerkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v10/controller/keeper"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxTimeoutSeconds is the highest query timeout which does not overflow when converted to nanoseconds
+const maxTimeoutSeconds = math.MaxUint64 / uint64(time.Second)
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// CustomMessenger sends the interchain queries of the ICQMsg custom messages with the controller keeper, and
+// dispatches the other messages with the wrapped messenger
+type CustomMessenger struct {
+	wrapped          wasmkeeper.Messenger
+	controllerKeeper *controllerkeeper.Keeper
+}
+
+// CustomMessageDecorator returns the decorator wrapping the messenger of the wasm keeper with a CustomMessenger
+func CustomMessageDecorator(controllerKeeper *controllerkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:          old,
+			controllerKeeper: controllerKeeper,
+		}
+	}
+}
+
+// DispatchMsg executes the ICQMsg custom messages, and passes the other messages to the wrapped messenger
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// the custom messages of other bindings are passed to the wrapped messenger
+		var contractMsg ICQMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err == nil && contractMsg.SendQuery != nil {
+			return m.sendQuery(ctx, contractAddr, contractMsg.SendQuery)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// sendQuery sends the interchain query on behalf of the contract, returning its sequence to the contract
+func (m *CustomMessenger) sendQuery(ctx sdk.Context, contractAddr sdk.AccAddress, msg *SendQuery) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.TimeoutSeconds > maxTimeoutSeconds {
+		return nil, nil, nil, errorsmod.Wrapf(icqtypes.ErrInvalidQuery, "timeout cannot be greater than %d seconds", maxTimeoutSeconds)
+	}
+
+	sequence, err := m.controllerKeeper.SendQueryFrom(ctx, QueryCallbacksRoute, contractAddr, msg.ChannelID, toRequestQueries(msg.Requests), msg.TimeoutSeconds*uint64(time.Second))
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "send interchain query")
+	}
+
+	data, err := json.Marshal(SendQueryResponse{Sequence: sequence})
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "marshal send query response")
+	}
+	return nil, [][]byte{data}, nil, nil
+}
@@ -0,0 +1,124 @@
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+)
+
+// ContractKeeper defines the wasm keeper methods used to deliver the results of the queries to the contracts
+type ContractKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+var _ icqtypes.QueryCallbacks = QueryCallbacks{}
+
+// QueryCallbacks delivers the results of the queries sent by contracts to them, with an icq_response or
+// icq_timeout sudo message. The results of the queries sent by modules or accounts are ignored
+type QueryCallbacks struct {
+	contractKeeper ContractKeeper
+	gasLimit       uint64
+}
+
+// NewQueryCallbacks creates the QueryCallbacks delivering the results of the queries to the contracts. The sudo
+// calls are limited to gasLimit, so that a contract cannot consume the gas of the relayer transaction, where 0
+// means no limit
+func NewQueryCallbacks(contractKeeper ContractKeeper, gasLimit uint64) QueryCallbacks {
+	return QueryCallbacks{
+		contractKeeper: contractKeeper,
+		gasLimit:       gasLimit,
+	}
+}
+
+// OnQueryResponse sends the decoded responses of the host chain to the contract which sent the query
+func (c QueryCallbacks) OnQueryResponse(ctx sdk.Context, query icqtypes.PendingQuery, responses []abcitypes.ResponseQuery) error {
+	return c.sudo(ctx, query, SudoMsg{
+		ICQResponse: &ICQResponse{
+			ChannelID: query.ChannelId,
+			Sequence:  query.Sequence,
+			Response:  newCosmosResponse(responses),
+		},
+	})
+}
+
+// OnQueryError sends the error the host chain acknowledged the query with to the contract which sent it
+func (c QueryCallbacks) OnQueryError(ctx sdk.Context, query icqtypes.PendingQuery, ackErr string) error {
+	return c.sudo(ctx, query, SudoMsg{
+		ICQResponse: &ICQResponse{
+			ChannelID: query.ChannelId,
+			Sequence:  query.Sequence,
+			Error:     ackErr,
+		},
+	})
+}
+
+// OnQueryTimeout notifies the contract which sent the query of its timeout
+func (c QueryCallbacks) OnQueryTimeout(ctx sdk.Context, query icqtypes.PendingQuery) error {
+	return c.sudo(ctx, query, SudoMsg{
+		ICQTimeout: &ICQTimeout{
+			ChannelID: query.ChannelId,
+			Sequence:  query.Sequence,
+		},
+	})
+}
+
+// sudo calls the contract which sent the query with the sudo message, if the query was sent by a contract
+func (c QueryCallbacks) sudo(ctx sdk.Context, query icqtypes.PendingQuery, msg SudoMsg) error {
+	if query.Sender == "" {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(query.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "query sender: %v", err)
+	}
+	if !c.contractKeeper.HasContractInfo(ctx, contractAddr) {
+		return nil
+	}
+
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal sudo message")
+	}
+
+	return runWithGasLimit(ctx, c.gasLimit, func(ctx sdk.Context) error {
+		_, err := c.contractKeeper.Sudo(ctx, contractAddr, msgBytes)
+		return err
+	})
+}
+
+// runWithGasLimit runs fn with a child gas meter limited to gasLimit, and charges the gas it used to the gas meter
+// of ctx. Running out of the child's gas is returned as an ErrOutOfGas error instead of aborting the transaction
+// the query result is relayed in. A gasLimit of 0 runs fn with the gas meter of ctx
+func runWithGasLimit(ctx sdk.Context, gasLimit uint64, fn func(ctx sdk.Context) error) (err error) {
+	if gasLimit == 0 {
+		return fn(ctx)
+	}
+
+	childGasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(childGasMeter.GasConsumedToLimit(), "interchain query contract callback")
+		if r == nil {
+			return
+		}
+
+		// Only handle running out of the child's gas. Anything else (including the parent meter running out of
+		// gas above) keeps panicking
+		if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !childGasMeter.IsOutOfGas() {
+			panic(r)
+		}
+		err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "contract callback exceeded the gas limit of %d", gasLimit)
+	}()
+
+	return fn(ctx.WithGasMeter(childGasMeter))
+}
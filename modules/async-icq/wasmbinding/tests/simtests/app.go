@@ -0,0 +1,329 @@
+package simtests
+
+import (
+	"encoding/json"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	icq "github.com/cosmos/ibc-apps/modules/async-icq/v10"
+	icqcontroller "github.com/cosmos/ibc-apps/modules/async-icq/v10/controller"
+	icqcontrollerkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v10/controller/keeper"
+	icqkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v10/keeper"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/wasmbinding"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/upgrade"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/consensus"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	ibc "github.com/cosmos/ibc-go/v10/modules/core"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const appName = "icq-wasm-simapp"
+
+// maccPerms are the module account permissions of the App
+var maccPerms = map[string][]string{
+	authtypes.FeeCollectorName:     nil,
+	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	wasmtypes.ModuleName:           {authtypes.Burner},
+	icqtypes.ControllerModuleName:  nil,
+}
+
+var _ ibctesting.TestingApp = (*App)(nil)
+
+// App is a minimal chain running the interchain query host and controller along with wasm, whose contracts
+// send interchain queries with the wasm bindings
+type App struct {
+	*baseapp.BaseApp
+
+	appCodec          codec.Codec
+	txConfig          client.TxConfig
+	interfaceRegistry types.InterfaceRegistry
+
+	AccountKeeper         authkeeper.AccountKeeper
+	BankKeeper            bankkeeper.BaseKeeper
+	StakingKeeper         *stakingkeeper.Keeper
+	ConsensusParamsKeeper consensuskeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
+	IBCKeeper             *ibckeeper.Keeper
+	ICQKeeper             icqkeeper.Keeper
+	ICQControllerKeeper   icqcontrollerkeeper.Keeper
+	WasmKeeper            wasmkeeper.Keeper
+
+	ModuleManager *module.Manager
+}
+
+// NewApp returns a new App. The wasm options are those of the chain's contracts, e.g. the mocked wasm engine
+func NewApp(logger log.Logger, db dbm.DB, wasmDir string, wasmOpts []wasmkeeper.Option) *App {
+	interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec: address.Bech32Codec{
+				Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			},
+			ValidatorAddressCodec: address.Bech32Codec{
+				Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	appCodec := codec.NewProtoCodec(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(appCodec, authtx.DefaultSignModes)
+	std.RegisterInterfaces(interfaceRegistry)
+
+	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder())
+	bApp.SetInterfaceRegistry(interfaceRegistry)
+	bApp.SetTxEncoder(txConfig.TxEncoder())
+
+	keys := storetypes.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, consensustypes.StoreKey, upgradetypes.StoreKey,
+		ibcexported.StoreKey, icqtypes.StoreKey, icqtypes.ControllerStoreKey, wasmtypes.StoreKey,
+	)
+
+	app := &App{
+		BaseApp:           bApp,
+		appCodec:          appCodec,
+		txConfig:          txConfig,
+		interfaceRegistry: interfaceRegistry,
+	}
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	app.ConsensusParamsKeeper = consensuskeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[consensustypes.StoreKey]), authority, runtime.EventService{},
+	)
+	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
+
+	app.AccountKeeper = authkeeper.NewAccountKeeper(
+		appCodec, runtime.NewKVStoreService(keys[authtypes.StoreKey]), authtypes.ProtoBaseAccount, maccPerms,
+		authcodec.NewBech32Codec(sdk.Bech32MainPrefix), sdk.Bech32MainPrefix, authority,
+	)
+	app.BankKeeper = bankkeeper.NewBaseKeeper(
+		appCodec, runtime.NewKVStoreService(keys[banktypes.StoreKey]), app.AccountKeeper, blockedAddresses(), authority, logger,
+	)
+	app.StakingKeeper = stakingkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]), app.AccountKeeper, app.BankKeeper, authority,
+		authcodec.NewBech32Codec(sdk.Bech32PrefixValAddr), authcodec.NewBech32Codec(sdk.Bech32PrefixConsAddr),
+	)
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(
+		map[int64]bool{}, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), appCodec, wasmDir, bApp, authority,
+	)
+	app.IBCKeeper = ibckeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[ibcexported.StoreKey]), nil, app.UpgradeKeeper, authority,
+	)
+
+	app.ICQKeeper = icqkeeper.NewKeeper(
+		appCodec, keys[icqtypes.StoreKey], app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper, bApp.GRPCQueryRouter(), authority,
+	)
+	app.ICQKeeper.SetStoreQuerier(app.CommitMultiStore().(icqtypes.StoreQuerier))
+	app.ICQControllerKeeper = icqcontrollerkeeper.NewKeeper(
+		appCodec, keys[icqtypes.ControllerStoreKey], app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper, app.BankKeeper, authority,
+	)
+
+	// The contracts send interchain queries with the controller keeper, which delivers their results to the
+	// contracts once the wasm keeper is created
+	wasmOpts = append(wasmbinding.RegisterCustomPlugins(&app.ICQControllerKeeper), wasmOpts...)
+	app.WasmKeeper = wasmkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		nil,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		nil,
+		bApp.MsgServiceRouter(),
+		bApp.GRPCQueryRouter(),
+		wasmDir,
+		wasmtypes.DefaultNodeConfig(),
+		wasmtypes.VMConfig{},
+		wasmkeeper.BuiltInCapabilities(),
+		authority,
+		wasmOpts...,
+	)
+	app.ICQControllerKeeper.SetCallbacksRouter(icqtypes.NewQueryCallbacksRouter().
+		AddRoute(wasmbinding.QueryCallbacksRoute, wasmbinding.NewQueryCallbacks(&app.WasmKeeper, wasmbinding.DefaultCallbackGasLimit)))
+
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(icqtypes.PortID, icq.NewIBCModule(app.ICQKeeper))
+	ibcRouter.AddRoute(icqtypes.ControllerPortID, icqcontroller.NewIBCModule(app.ICQControllerKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
+	tmLightClientModule := ibctm.NewLightClientModule(appCodec, app.IBCKeeper.ClientKeeper.GetStoreProvider())
+	app.IBCKeeper.ClientKeeper.AddRoute(ibctm.ModuleName, &tmLightClientModule)
+
+	app.ModuleManager = module.NewManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, nil, nil),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, nil),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, nil),
+		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
+		ibc.NewAppModule(app.IBCKeeper),
+		ibctm.NewAppModule(tmLightClientModule),
+		icq.NewAppModule(app.ICQKeeper, nil),
+		icqcontroller.NewAppModule(app.ICQControllerKeeper),
+		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, bApp.MsgServiceRouter(), nil),
+	)
+	module.NewBasicManagerFromManager(app.ModuleManager, nil).RegisterInterfaces(interfaceRegistry)
+
+	moduleNames := []string{
+		authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, consensustypes.ModuleName,
+		upgradetypes.ModuleName, ibcexported.ModuleName, ibctm.ModuleName, icqtypes.ModuleName,
+		icqtypes.ControllerModuleName, wasmtypes.ModuleName,
+	}
+	app.ModuleManager.SetOrderPreBlockers(upgradetypes.ModuleName, authtypes.ModuleName)
+	app.ModuleManager.SetOrderBeginBlockers(moduleNames...)
+	app.ModuleManager.SetOrderEndBlockers(moduleNames...)
+	app.ModuleManager.SetOrderInitGenesis(moduleNames...)
+
+	configurator := module.NewConfigurator(appCodec, bApp.MsgServiceRouter(), bApp.GRPCQueryRouter())
+	if err := app.ModuleManager.RegisterServices(configurator); err != nil {
+		panic(err)
+	}
+
+	app.MountKVStores(keys)
+
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		SignModeHandler: txConfig.SignModeHandler(),
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	})
+	if err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(anteHandler)
+	app.SetInitChainer(app.InitChainer)
+	app.SetPreBlocker(app.PreBlocker)
+	app.SetBeginBlocker(app.BeginBlocker)
+	app.SetEndBlocker(app.EndBlocker)
+
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return app
+}
+
+// SetupTestingApp returns the ibctesting app creator of chains whose contracts run with the wasm options
+func SetupTestingApp(wasmDir string, wasmOpts ...wasmkeeper.Option) ibctesting.AppCreator {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), wasmDir, wasmOpts)
+		return app, app.DefaultGenesis()
+	}
+}
+
+// GetApp returns the App of the test chain
+func GetApp(chain *ibctesting.TestChain) *App {
+	app, ok := chain.App.(*App)
+	if !ok {
+		panic("not an icq wasm app")
+	}
+	return app
+}
+
+// InitChainer initializes the modules from the genesis state
+func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		return nil, err
+	}
+	if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()); err != nil {
+		return nil, err
+	}
+	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+}
+
+// PreBlocker runs the pre blockers of the modules
+func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	return app.ModuleManager.PreBlock(ctx)
+}
+
+// BeginBlocker runs the begin blockers of the modules
+func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	return app.ModuleManager.BeginBlock(ctx)
+}
+
+// EndBlocker runs the end blockers of the modules
+func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	return app.ModuleManager.EndBlock(ctx)
+}
+
+// DefaultGenesis returns the default genesis state of the modules
+func (app *App) DefaultGenesis() map[string]json.RawMessage {
+	return module.NewBasicManagerFromManager(app.ModuleManager, nil).DefaultGenesis(app.appCodec)
+}
+
+// GetBaseApp implements the TestingApp interface
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetIBCKeeper implements the TestingApp interface
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetTxConfig implements the TestingApp interface
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
+// AppCodec implements the TestingApp interface
+func (app *App) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+// blockedAddresses returns the module accounts which cannot receive funds
+func blockedAddresses() map[string]bool {
+	blocked := make(map[string]bool)
+	for acc := range maccPerms {
+		blocked[authtypes.NewModuleAddress(acc).String()] = true
+	}
+	return blocked
+}
@@ -0,0 +1,221 @@
+package simtests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/wasmbinding"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// The tester contract is implemented in Go and run by the mocked wasm engine of wasmd, so that the tests do not
+// depend on a compiled contract. It sends the interchain queries it is asked to with the custom ICQMsg, and
+// records the sudo messages delivering their results.
+//
+// Only the VM running the contract code is mocked: the custom messages and the sudo calls still go through the wasm
+// keeper of wasmd, and the bindings only exchange JSON with the contracts. So that the tests check that JSON rather
+// than a round trip through the Go types of the bindings, the tester can send a raw JSON query message, as a Rust
+// contract would serialize it, and records the raw JSON of the sudo messages it receives
+
+// testerGasUsed is the wasm gas used by the entry points of the tester contract
+const testerGasUsed = 1
+
+var (
+	historyKey    = []byte("history")
+	rawHistoryKey = []byte("raw_history")
+	behaviorKey   = []byte("behavior")
+)
+
+type TesterInstantiate struct{}
+
+type TesterExecute struct {
+	// Query sends the interchain query
+	Query *wasmbinding.SendQuery `json:"query,omitempty"`
+	// RawQuery sends the interchain query whose JSON is the send_query of the custom message, as is
+	RawQuery json.RawMessage `json:"raw_query,omitempty"`
+	// SetCallbackBehavior changes how the tester handles the sudo messages
+	SetCallbackBehavior *CallbackBehavior `json:"set_callback_behavior,omitempty"`
+}
+
+// CallbackBehavior sets how the tester handles the sudo messages
+type CallbackBehavior struct {
+	// Fail makes the tester return an error from its sudo entry point
+	Fail bool `json:"fail"`
+	// GasUsed is the wasm gas used by the sudo entry point, on top of testerGasUsed
+	GasUsed uint64 `json:"gas_used"`
+}
+
+type Empty struct{}
+
+type TesterQuery struct {
+	History *Empty `json:"history,omitempty"`
+}
+
+type HistoryResponse struct {
+	History []wasmbinding.SudoMsg `json:"history"`
+	// RawHistory is the JSON of the sudo messages, as the contract received them
+	RawHistory []json.RawMessage `json:"raw_history"`
+}
+
+// NewTesterEngine returns the mocked wasm engine running the tester contract
+func NewTesterEngine() *wasmtesting.MockWasmEngine {
+	engine := &wasmtesting.MockWasmEngine{
+		ExecuteFn: testerExecute,
+		SudoFn:    testerSudo,
+		QueryFn:   testerQuery,
+	}
+	wasmtesting.MakeInstantiable(engine)
+	return engine
+}
+
+func testerExecute(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	var msg TesterExecute
+	if err := json.Unmarshal(executeMsg, &msg); err != nil {
+		return &wasmvmtypes.ContractResult{Err: err.Error()}, testerGasUsed, nil
+	}
+
+	switch {
+	case msg.Query != nil || msg.RawQuery != nil:
+		var custom []byte
+		var err error
+		if msg.Query != nil {
+			custom, err = json.Marshal(wasmbinding.ICQMsg{SendQuery: msg.Query})
+		} else {
+			custom, err = json.Marshal(map[string]json.RawMessage{"send_query": msg.RawQuery})
+		}
+		if err != nil {
+			return nil, testerGasUsed, err
+		}
+		return &wasmvmtypes.ContractResult{
+			Ok: &wasmvmtypes.Response{
+				Messages: []wasmvmtypes.SubMsg{{
+					ReplyOn: wasmvmtypes.ReplyNever,
+					Msg:     wasmvmtypes.CosmosMsg{Custom: custom},
+				}},
+			},
+		}, testerGasUsed, nil
+	case msg.SetCallbackBehavior != nil:
+		bz, err := json.Marshal(msg.SetCallbackBehavior)
+		if err != nil {
+			return nil, testerGasUsed, err
+		}
+		store.Set(behaviorKey, bz)
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, testerGasUsed, nil
+	default:
+		return &wasmvmtypes.ContractResult{Err: "unknown execute message"}, testerGasUsed, nil
+	}
+}
+
+func testerSudo(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+	var behavior CallbackBehavior
+	if bz := store.Get(behaviorKey); bz != nil {
+		if err := json.Unmarshal(bz, &behavior); err != nil {
+			return nil, testerGasUsed, err
+		}
+	}
+	gasUsed := testerGasUsed + behavior.GasUsed
+	if behavior.Fail {
+		return &wasmvmtypes.ContractResult{Err: "callback failed"}, gasUsed, nil
+	}
+
+	var msg wasmbinding.SudoMsg
+	if err := json.Unmarshal(sudoMsg, &msg); err != nil {
+		return &wasmvmtypes.ContractResult{Err: err.Error()}, gasUsed, nil
+	}
+	history, rawHistory, err := loadHistory(store)
+	if err != nil {
+		return nil, gasUsed, err
+	}
+	bz, err := json.Marshal(append(history, msg))
+	if err != nil {
+		return nil, gasUsed, err
+	}
+	store.Set(historyKey, bz)
+	bz, err = json.Marshal(append(rawHistory, sudoMsg))
+	if err != nil {
+		return nil, gasUsed, err
+	}
+	store.Set(rawHistoryKey, bz)
+
+	return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasUsed, nil
+}
+
+func testerQuery(_ wasmvm.Checksum, _ wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+	var msg TesterQuery
+	if err := json.Unmarshal(queryMsg, &msg); err != nil || msg.History == nil {
+		return &wasmvmtypes.QueryResult{Err: "unknown query message"}, testerGasUsed, nil
+	}
+
+	history, rawHistory, err := loadHistory(store)
+	if err != nil {
+		return nil, testerGasUsed, err
+	}
+	bz, err := json.Marshal(HistoryResponse{History: history, RawHistory: rawHistory})
+	if err != nil {
+		return nil, testerGasUsed, err
+	}
+	return &wasmvmtypes.QueryResult{Ok: bz}, testerGasUsed, nil
+}
+
+func loadHistory(store wasmvm.KVStore) ([]wasmbinding.SudoMsg, []json.RawMessage, error) {
+	history := []wasmbinding.SudoMsg{}
+	if bz := store.Get(historyKey); bz != nil {
+		if err := json.Unmarshal(bz, &history); err != nil {
+			return nil, nil, err
+		}
+	}
+	rawHistory := []json.RawMessage{}
+	if bz := store.Get(rawHistoryKey); bz != nil {
+		if err := json.Unmarshal(bz, &rawHistory); err != nil {
+			return nil, nil, err
+		}
+	}
+	return history, rawHistory, nil
+}
+
+func Instantiate(t *testing.T, chain *ibctesting.TestChain, codeID uint64, msg any) sdk.AccAddress {
+	t.Helper()
+	res, err := chain.SendMsgs(WasmInstantiate(chain.SenderAccount.GetAddress(), codeID, msg))
+	require.NoError(t, err)
+	for _, event := range res.Events {
+		if event.Type != "instantiate" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "_contract_address" {
+				return sdk.MustAccAddressFromBech32(attr.Value)
+			}
+		}
+	}
+	t.Fatal("no instantiated contract")
+	return nil
+}
+
+func QueryCallbackHistory(t *testing.T, chain *ibctesting.TestChain, tester sdk.AccAddress) []wasmbinding.SudoMsg {
+	t.Helper()
+	return queryHistory(t, chain, tester).History
+}
+
+// QueryRawCallbackHistory returns the JSON of the sudo messages the tester contract received
+func QueryRawCallbackHistory(t *testing.T, chain *ibctesting.TestChain, tester sdk.AccAddress) []json.RawMessage {
+	t.Helper()
+	return queryHistory(t, chain, tester).RawHistory
+}
+
+func queryHistory(t *testing.T, chain *ibctesting.TestChain, tester sdk.AccAddress) HistoryResponse {
+	t.Helper()
+	query, err := json.Marshal(TesterQuery{History: &Empty{}})
+	require.NoError(t, err)
+	res, err := GetApp(chain).WasmKeeper.QuerySmart(chain.GetContext(), tester, query)
+	require.NoError(t, err)
+	var response HistoryResponse
+	require.NoError(t, json.Unmarshal(res, &response))
+	return response
+}
@@ -0,0 +1,172 @@
+package simtests
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/wasmbinding"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type Chain struct {
+	Chain  *ibctesting.TestChain
+	Tester sdk.AccAddress
+}
+
+type Suite struct {
+	t *testing.T
+
+	Coordinator *ibctesting.Coordinator
+	ChainA      Chain
+	ChainB      Chain
+}
+
+func SetupChain(t *testing.T, c *ibctesting.Coordinator, index int) Chain {
+	chain := c.GetChain(ibctesting.GetChainID(index))
+	_, err := chain.SendMsgs(WasmStoreCode(chain.SenderAccount.GetAddress(), testerCode))
+	require.NoError(t, err)
+
+	tester := Instantiate(t, chain, 1, TesterInstantiate{})
+
+	return Chain{
+		Chain:  chain,
+		Tester: tester,
+	}
+}
+
+func NewSuite(t *testing.T) Suite {
+	coordinator := ibctesting.NewCustomAppCoordinator(t, 2, SetupTestingApp(t.TempDir(), wasmkeeper.WithWasmEngine(NewTesterEngine())))
+	chainA := SetupChain(t, coordinator, 1)
+	chainB := SetupChain(t, coordinator, 2)
+
+	return Suite{
+		t:           t,
+		Coordinator: coordinator,
+		ChainA:      chainA,
+		ChainB:      chainB,
+	}
+}
+
+func ChannelConfig(port string) *ibctesting.ChannelConfig {
+	return &ibctesting.ChannelConfig{
+		PortID:  port,
+		Version: icqtypes.Version,
+		Order:   channeltypes.UNORDERED,
+	}
+}
+
+// AllowQueries sets the queries the chain answers as an interchain query host
+func (c *Chain) AllowQueries(t *testing.T, paths ...string) {
+	app := GetApp(c.Chain)
+	require.NoError(t, app.ICQKeeper.SetParams(c.Chain.GetContext(), icqtypes.NewParams(true, paths)))
+}
+
+// SetCallbackBehavior sets how the tester contract of the chain handles the sudo messages
+func (c *Chain) SetCallbackBehavior(t *testing.T, behavior CallbackBehavior) {
+	_, err := c.Chain.SendMsgs(WasmExecute(c.Chain.SenderAccount.GetAddress(), c.Tester, TesterExecute{
+		SetCallbackBehavior: &behavior,
+	}))
+	require.NoError(t, err)
+}
+
+// SetupPath opens an interchain query channel from the controller chain to the host chain
+func (s *Suite) SetupPath(controller, host *Chain) (*ibctesting.Path, error) {
+	path := ibctesting.NewPath(controller.Chain, host.Chain)
+	path.EndpointA.ChannelConfig = ChannelConfig(icqtypes.ControllerPortID)
+	path.EndpointB.ChannelConfig = ChannelConfig(icqtypes.PortID)
+
+	// the ibctesting version of Setup does not return an
+	// error, so we write it ourselves.
+	a, b := path.EndpointA, path.EndpointB
+	steps := []func() error{
+		a.CreateClient, b.CreateClient,
+		a.ConnOpenInit, b.ConnOpenTry, a.ConnOpenAck, b.ConnOpenConfirm, a.UpdateClient,
+		a.ChanOpenInit, b.ChanOpenTry, a.ChanOpenAck, b.ChanOpenConfirm, a.UpdateClient,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return nil, err
+		}
+	}
+	return path, nil
+}
+
+func (s *Suite) SetupDefaultPath(controller, host *Chain) *ibctesting.Path {
+	path, err := s.SetupPath(controller, host)
+	require.NoError(s.t, err)
+	return path
+}
+
+// SendQuery executes the tester contract of the controller chain to send the interchain query, returning the
+// packet of the query
+func (s *Suite) SendQuery(t *testing.T, controller *Chain, msg wasmbinding.SendQuery) (channeltypes.Packet, error) {
+	res, err := controller.Chain.SendMsgs(WasmExecute(controller.Chain.SenderAccount.GetAddress(), controller.Tester, TesterExecute{
+		Query: &msg,
+	}))
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet, nil
+}
+
+// SendRawQuery executes the tester contract of the controller chain to send the interchain query whose JSON is the
+// send_query of the custom message, returning the packet of the query
+func (s *Suite) SendRawQuery(t *testing.T, controller *Chain, msg json.RawMessage) (channeltypes.Packet, error) {
+	res, err := controller.Chain.SendMsgs(WasmExecute(controller.Chain.SenderAccount.GetAddress(), controller.Tester, TesterExecute{
+		RawQuery: msg,
+	}))
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet, nil
+}
+
+// RelayQuery relays the query packet to the host chain and its acknowledgement back, returning the result of the
+// acknowledgement transaction, where the contract callback runs
+func (s *Suite) RelayQuery(path *ibctesting.Path, packet channeltypes.Packet) (*abci.ExecTxResult, error) {
+	if err := path.EndpointB.UpdateClient(); err != nil {
+		return nil, err
+	}
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	if err != nil {
+		return nil, err
+	}
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	if err != nil {
+		return nil, err
+	}
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return nil, err
+	}
+	return path.EndpointA.AcknowledgePacketWithResult(packet, ack)
+}
+
+// RoundtripQuery sends the interchain query from the tester contract of the controller chain, relays it, and
+// returns the sudo message the tester received
+func (s *Suite) RoundtripQuery(t *testing.T, path *ibctesting.Path, controller *Chain, msg wasmbinding.SendQuery) (wasmbinding.SudoMsg, error) {
+	startCallbacks := QueryCallbackHistory(t, controller.Chain, controller.Tester)
+	packet, err := s.SendQuery(t, controller, msg)
+	if err != nil {
+		return wasmbinding.SudoMsg{}, err
+	}
+	if _, err := s.RelayQuery(path, packet); err != nil {
+		return wasmbinding.SudoMsg{}, err
+	}
+	callbacks := QueryCallbackHistory(t, controller.Chain, controller.Tester)
+	require.Equal(t, len(startCallbacks)+1, len(callbacks), "no new callbacks")
+
+	return callbacks[len(callbacks)-1], nil
+}
@@ -0,0 +1,266 @@
+package simtests
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v10/types"
+	"github.com/cosmos/ibc-apps/modules/async-icq/wasmbinding"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+const allBalancesPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+func allBalancesQuery(t *testing.T, chain *Chain, channelID string) wasmbinding.SendQuery {
+	data, err := (&banktypes.QueryAllBalancesRequest{Address: chain.Chain.SenderAccount.GetAddress().String()}).Marshal()
+	require.NoError(t, err)
+	return wasmbinding.SendQuery{
+		ChannelID:      channelID,
+		Requests:       []wasmbinding.QueryRequest{{Path: allBalancesPath, Data: data}},
+		TimeoutSeconds: 100,
+	}
+}
+
+// callbackError returns the error of the icq_callback_error event, if any
+func callbackError(events []abci.Event) (string, bool) {
+	for _, event := range events {
+		if event.Type != icqtypes.EventTypeCallbackError {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == icqtypes.AttributeKeyAckError {
+				return attr.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func TestQueryResponse(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+	suite.ChainB.AllowQueries(t, allBalancesPath)
+
+	callback, err := suite.RoundtripQuery(t, path, &suite.ChainA, allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID))
+	require.NoError(t, err)
+	require.Nil(t, callback.ICQTimeout)
+	require.NotNil(t, callback.ICQResponse)
+	require.Equal(t, path.EndpointA.ChannelID, callback.ICQResponse.ChannelID)
+	require.Equal(t, uint64(1), callback.ICQResponse.Sequence)
+	require.Empty(t, callback.ICQResponse.Error)
+	require.NotNil(t, callback.ICQResponse.Response)
+	require.Len(t, callback.ICQResponse.Response.Responses, 1)
+
+	response := callback.ICQResponse.Response.Responses[0]
+	require.Zero(t, response.Code)
+	var balances banktypes.QueryAllBalancesResponse
+	require.NoError(t, balances.Unmarshal(response.Value))
+	require.False(t, balances.Balances.IsZero(), "the balances of the host chain are returned")
+
+	app := GetApp(suite.ChainA.Chain)
+	_, found := app.ICQControllerKeeper.GetPendingQuery(suite.ChainA.Chain.GetContext(), path.EndpointA.ChannelID, 1)
+	require.False(t, found, "the pending query is removed")
+}
+
+func TestStoreQueryProof(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+	params := icqtypes.NewParams(true, nil)
+	params.AllowStoreQueries = []string{banktypes.StoreKey}
+	require.NoError(t, GetApp(suite.ChainB.Chain).ICQKeeper.SetParams(suite.ChainB.Chain.GetContext(), params))
+
+	// the balance of the bank store, keyed by the length prefixed address and the denom
+	addr := suite.ChainB.Chain.SenderAccount.GetAddress()
+	key := append(append(bytes.Clone(banktypes.BalancesPrefix.Bytes()), address.MustLengthPrefix(addr)...), sdk.DefaultBondDenom...)
+
+	// the query is sent as a Rust contract serializes it, with the key as a base64 Binary
+	packet, err := suite.SendRawQuery(t, &suite.ChainA, json.RawMessage(fmt.Sprintf(
+		`{"channel_id":%q,"requests":[{"path":%q,"data":%q,"prove":true}],"timeout_seconds":100}`,
+		path.EndpointA.ChannelID, icqtypes.NewStoreQueryPath(banktypes.StoreKey), base64.StdEncoding.EncodeToString(key),
+	)))
+	require.NoError(t, err)
+	_, err = suite.RelayQuery(path, packet)
+	require.NoError(t, err)
+
+	callbacks := QueryCallbackHistory(t, suite.ChainA.Chain, suite.ChainA.Tester)
+	require.Len(t, callbacks, 1)
+	require.NotNil(t, callbacks[0].ICQResponse)
+	require.Empty(t, callbacks[0].ICQResponse.Error)
+	response := callbacks[0].ICQResponse.Response.Responses[0]
+	require.Zero(t, response.Code)
+	require.Equal(t, key, response.Key)
+	require.NotEmpty(t, response.Value)
+	require.NotNil(t, response.ProofOps)
+	require.NotEmpty(t, response.ProofOps.Ops)
+
+	// the sudo message has the key and proof ops in the JSON a Rust contract deserializes
+	var raw struct {
+		ICQResponse struct {
+			Response struct {
+				Responses []struct {
+					Key      string `json:"key"`
+					ProofOps struct {
+						Ops []struct {
+							Type string `json:"type"`
+							Key  string `json:"key"`
+							Data string `json:"data"`
+						} `json:"ops"`
+					} `json:"proof_ops"`
+				} `json:"responses"`
+			} `json:"response"`
+		} `json:"icq_response"`
+	}
+	rawCallbacks := QueryRawCallbackHistory(t, suite.ChainA.Chain, suite.ChainA.Tester)
+	require.Len(t, rawCallbacks, 1)
+	require.NoError(t, json.Unmarshal(rawCallbacks[0], &raw))
+	require.Len(t, raw.ICQResponse.Response.Responses, 1)
+	rawResponse := raw.ICQResponse.Response.Responses[0]
+	require.Equal(t, base64.StdEncoding.EncodeToString(key), rawResponse.Key)
+	require.Len(t, rawResponse.ProofOps.Ops, len(response.ProofOps.Ops))
+	for i, op := range rawResponse.ProofOps.Ops {
+		require.Equal(t, response.ProofOps.Ops[i].Type, op.Type)
+		require.Equal(t, base64.StdEncoding.EncodeToString(response.ProofOps.Ops[i].Data), op.Data)
+	}
+}
+
+func TestQuerySender(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+
+	packet, err := suite.SendQuery(t, &suite.ChainA, allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID))
+	require.NoError(t, err)
+
+	app := GetApp(suite.ChainA.Chain)
+	query, found := app.ICQControllerKeeper.GetPendingQuery(suite.ChainA.Chain.GetContext(), path.EndpointA.ChannelID, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, suite.ChainA.Tester.String(), query.Sender)
+	require.Equal(t, packet.TimeoutTimestamp, query.TimeoutTimestamp)
+}
+
+func TestQueryError(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+
+	// the host chain does not allow any query
+	callback, err := suite.RoundtripQuery(t, path, &suite.ChainA, allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID))
+	require.NoError(t, err)
+	require.NotNil(t, callback.ICQResponse)
+	require.Equal(t, uint64(1), callback.ICQResponse.Sequence)
+	require.Nil(t, callback.ICQResponse.Response)
+	require.NotEmpty(t, callback.ICQResponse.Error)
+}
+
+func TestQueryTimeout(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+	suite.ChainB.AllowQueries(t, allBalancesPath)
+
+	msg := allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID)
+	msg.TimeoutSeconds = 10
+	packet, err := suite.SendQuery(t, &suite.ChainA, msg)
+	require.NoError(t, err)
+
+	suite.Coordinator.IncrementTimeBy(time.Minute)
+	suite.Coordinator.CommitBlock(suite.ChainB.Chain)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	callbacks := QueryCallbackHistory(t, suite.ChainA.Chain, suite.ChainA.Tester)
+	require.Len(t, callbacks, 1)
+	require.Nil(t, callbacks[0].ICQResponse)
+	require.Equal(t, &wasmbinding.ICQTimeout{
+		ChannelID: path.EndpointA.ChannelID,
+		Sequence:  packet.Sequence,
+	}, callbacks[0].ICQTimeout)
+}
+
+func TestSendQueryErrors(t *testing.T) {
+	suite := NewSuite(t)
+	path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *wasmbinding.SendQuery)
+	}{
+		{
+			"unknown channel",
+			func(msg *wasmbinding.SendQuery) {
+				msg.ChannelID = "channel-100"
+			},
+		},
+		{
+			"no requests",
+			func(msg *wasmbinding.SendQuery) {
+				msg.Requests = nil
+			},
+		},
+		{
+			"timeout overflows",
+			func(msg *wasmbinding.SendQuery) {
+				msg.TimeoutSeconds = ^uint64(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID)
+			tc.malleate(&msg)
+			_, err := suite.SendQuery(t, &suite.ChainA, msg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCallbackErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		behavior CallbackBehavior
+		expErr   string
+	}{
+		{
+			"contract returns an error",
+			CallbackBehavior{Fail: true},
+			"callback failed",
+		},
+		{
+			"contract runs out of gas",
+			CallbackBehavior{GasUsed: 1 << 60},
+			"out of gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewSuite(t)
+			path := suite.SetupDefaultPath(&suite.ChainA, &suite.ChainB)
+			suite.ChainB.AllowQueries(t, allBalancesPath)
+			suite.ChainA.SetCallbackBehavior(t, tc.behavior)
+
+			packet, err := suite.SendQuery(t, &suite.ChainA, allBalancesQuery(t, &suite.ChainB, path.EndpointA.ChannelID))
+			require.NoError(t, err)
+
+			// the acknowledgement is processed even though the callback fails
+			res, err := suite.RelayQuery(path, packet)
+			require.NoError(t, err)
+			callbackErr, found := callbackError(res.Events)
+			require.True(t, found)
+			require.Contains(t, callbackErr, tc.expErr)
+			require.Empty(t, QueryCallbackHistory(t, suite.ChainA.Chain, suite.ChainA.Tester))
+
+			app := GetApp(suite.ChainA.Chain)
+			_, found = app.ICQControllerKeeper.GetPendingQuery(suite.ChainA.Chain.GetContext(), path.EndpointA.ChannelID, packet.Sequence)
+			require.False(t, found)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package simtests
+
+import (
+	"encoding/json"
+
+	wasm "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testerCode is the code stored for the tester contract. The mocked wasm engine only needs it to look like wasm
+var testerCode = []byte("\x00asm icq tester")
+
+// `WasmMsg::StoreCode` with the account as the sender.
+func WasmStoreCode(sender sdk.AccAddress, code []byte) sdk.Msg {
+	return &wasm.MsgStoreCode{
+		Sender:       sender.String(),
+		WASMByteCode: code,
+	}
+}
+
+// `WasmMsg::Execute` with the account as the sender.
+func WasmExecute(sender, contract sdk.AccAddress, msg any, funds ...sdk.Coin) sdk.Msg {
+	msgstr, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return &wasm.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Msg:      wasm.RawContractMessage(msgstr),
+		Funds:    funds,
+	}
+}
+
+// `WasmMsg::Instantiate` with the account as the sender.
+func WasmInstantiate(sender sdk.AccAddress, codeID uint64, msg any, funds ...sdk.Coin) sdk.Msg {
+	msgstr, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return &wasm.MsgInstantiateContract{
+		Sender: sender.String(),
+		CodeID: codeID,
+		Label:  "icq tester",
+		Msg:    msgstr,
+		Funds:  funds,
+	}
+}
@@ -0,0 +1,26 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	controllerkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v10/controller/keeper"
+)
+
+// QueryCallbacksRoute is the module the queries of the contracts are sent on behalf of, under which the
+// QueryCallbacks of the bindings must be routed
+const QueryCallbacksRoute = wasmtypes.ModuleName
+
+// DefaultCallbackGasLimit is the default gas limit of the sudo calls delivering the results of the queries
+const DefaultCallbackGasLimit uint64 = 1_000_000
+
+// RegisterCustomPlugins returns the wasm keeper options letting the contracts send interchain queries with the
+// controller keeper. The results of the queries are delivered to the contracts by the QueryCallbacks, which must
+// be routed on the controller keeper once the wasm keeper is created:
+//
+//	controllerKeeper.SetCallbacksRouter(icqtypes.NewQueryCallbacksRouter().
+//		AddRoute(wasmbinding.QueryCallbacksRoute, wasmbinding.NewQueryCallbacks(wasmKeeper, wasmbinding.DefaultCallbackGasLimit)))
+func RegisterCustomPlugins(controllerKeeper *controllerkeeper.Keeper) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(controllerKeeper)),
+	}
+}
@@ -2,14 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
-	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
-	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -39,35 +39,160 @@ func GetQueryCmd() *cobra.Command {
 	cmd.Short = fmt.Sprintf("Querying commands for the %s module", types.ModuleName)
 	cmd.AddCommand(
 		GetCmdWasmSender(),
+		GetCmdPacketCallback(),
+		GetCmdCallbacksByContract(),
+		GetCmdParams(),
 	)
 	return cmd
 }
 
-// GetCmdWasmSender return pool params.
+// GetCmdWasmSender returns the intermediate sender for a channel and original sender
 func GetCmdWasmSender() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "wasm-sender <channelID> <originalSender>",
-		Short: "Generate the local address for a wasm hooks sender",
+		Short: "Query the local address for a wasm hooks sender",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Generate the local address for a wasm hooks sender.
+			fmt.Sprintf(`Query the local address for a wasm hooks sender.
 Example:
-$ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu
+$ %s query %s wasm-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu
 `,
-				version.AppName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			channelID := args[0]
-			originalSender := args[1]
-			// ToDo: Make this flexible as an arg
-			prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-			senderBech32, err := keeper.DeriveIntermediateSender(channelID, originalSender, prefix)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			fmt.Println(senderBech32)
-			return nil
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWasmSenderRequest{
+				Channel:        args[0],
+				OriginalSender: args[1],
+			}
+			res, err := queryClient.WasmSender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPacketCallback returns the contract registered for the ack or timeout of a packet
+func GetCmdPacketCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-callback <channelID> <sequence>",
+		Short: "Query the contract registered for the ack or timeout of a packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contract registered for the ack or timeout of a packet.
+Example:
+$ %s query %s packet-callback channel-42 12
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[1], err)
+			}
+
+			req := &types.QueryPacketCallbackRequest{
+				Channel:  args[0],
+				Sequence: sequence,
+			}
+			res, err := queryClient.PacketCallback(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCallbacksByContract returns the pending packet callbacks of a contract
+func GetCmdCallbacksByContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "callbacks-by-contract <contract>",
+		Short: "Query the pending packet callbacks registered for a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending packet callbacks registered for a contract.
+Example:
+$ %s query %s callbacks-by-contract juno14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9skjuwg8
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCallbacksByContractRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.CallbacksByContract(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "callbacks-by-contract")
+
+	return cmd
+}
+
+// GetCmdParams returns the module params
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the ibc-hooks params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
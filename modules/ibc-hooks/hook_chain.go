@@ -0,0 +1,327 @@
+package ibc_hooks
+
+import (
+	"fmt"
+
+	// external libraries
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	// ibc-go
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ChainedHooks is a member of a HookChain. When several members override the same callback, the override of
+// the member with the highest OverridePriority is used
+type ChainedHooks struct {
+	Hooks            Hooks
+	OverridePriority int
+}
+
+// HookChain combines several Hooks into one, so that they can be used by the same ICS4Middleware and IBCMiddleware.
+// For each callback, the Before and After hooks of every member run, in the order of the members. Only one
+// override can run, so the override with the highest priority replaces the call to the underlying app or channel.
+// If no member overrides the callback, the underlying app or channel is called
+type HookChain struct {
+	members []ChainedHooks
+}
+
+var (
+	_ OnChanOpenInitOverrideHooks          = HookChain{}
+	_ OnChanOpenTryOverrideHooks           = HookChain{}
+	_ OnChanOpenAckOverrideHooks           = HookChain{}
+	_ OnChanOpenConfirmOverrideHooks       = HookChain{}
+	_ OnChanCloseInitOverrideHooks         = HookChain{}
+	_ OnChanCloseConfirmOverrideHooks      = HookChain{}
+	_ OnRecvPacketOverrideHooks            = HookChain{}
+	_ OnAcknowledgementPacketOverrideHooks = HookChain{}
+	_ OnTimeoutPacketOverrideHooks         = HookChain{}
+	_ SendPacketOverrideHooks              = HookChain{}
+	_ WriteAcknowledgementOverrideHooks    = HookChain{}
+	_ GetAppVersionOverrideHooks           = HookChain{}
+)
+
+// NewHookChain creates a HookChain running the members in the given order. It panics if two members overriding the
+// same callback share the same override priority, as it would not be possible to decide which override to run.
+// Members that do not override a callback, like before and after hooks, do not compete for it
+func NewHookChain(members ...ChainedHooks) HookChain {
+	c := HookChain{members: members}
+	requireUniqueOverridePriorities[OnChanOpenInitOverrideHooks](c, "OnChanOpenInit")
+	requireUniqueOverridePriorities[OnChanOpenTryOverrideHooks](c, "OnChanOpenTry")
+	requireUniqueOverridePriorities[OnChanOpenAckOverrideHooks](c, "OnChanOpenAck")
+	requireUniqueOverridePriorities[OnChanOpenConfirmOverrideHooks](c, "OnChanOpenConfirm")
+	requireUniqueOverridePriorities[OnChanCloseInitOverrideHooks](c, "OnChanCloseInit")
+	requireUniqueOverridePriorities[OnChanCloseConfirmOverrideHooks](c, "OnChanCloseConfirm")
+	requireUniqueOverridePriorities[OnRecvPacketOverrideHooks](c, "OnRecvPacket")
+	requireUniqueOverridePriorities[OnAcknowledgementPacketOverrideHooks](c, "OnAcknowledgementPacket")
+	requireUniqueOverridePriorities[OnTimeoutPacketOverrideHooks](c, "OnTimeoutPacket")
+	requireUniqueOverridePriorities[SendPacketOverrideHooks](c, "SendPacket")
+	requireUniqueOverridePriorities[WriteAcknowledgementOverrideHooks](c, "WriteAcknowledgement")
+	requireUniqueOverridePriorities[GetAppVersionOverrideHooks](c, "GetAppVersion")
+	return c
+}
+
+// requireUniqueOverridePriorities panics if two members implementing the override of type T share the same priority
+func requireUniqueOverridePriorities[T any](c HookChain, callback string) {
+	priorities := make(map[int]bool)
+	for _, member := range c.members {
+		if _, ok := member.Hooks.(T); !ok {
+			continue
+		}
+		if priorities[member.OverridePriority] {
+			panic(fmt.Sprintf("hook chain: duplicate override priority %d for %s", member.OverridePriority, callback))
+		}
+		priorities[member.OverridePriority] = true
+	}
+}
+
+// chainOverride returns the override of type T of the member with the highest priority, if any
+func chainOverride[T any](c HookChain) (override T, found bool) {
+	priority := 0
+	for _, member := range c.members {
+		hook, ok := member.Hooks.(T)
+		if !ok || (found && member.OverridePriority < priority) {
+			continue
+		}
+		override, priority, found = hook, member.OverridePriority, true
+	}
+	return override, found
+}
+
+// chainEach calls fn with the hooks of type T of every member, in order
+func chainEach[T any](c HookChain, fn func(hook T)) {
+	for _, member := range c.members {
+		if hook, ok := member.Hooks.(T); ok {
+			fn(hook)
+		}
+	}
+}
+
+func (c HookChain) OnChanOpenInitOverride(im IBCMiddleware, ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, counterparty channeltypes.Counterparty, version string) (string, error) {
+	chainEach(c, func(hook OnChanOpenInitBeforeHooks) {
+		hook.OnChanOpenInitBeforeHook(ctx, order, connectionHops, portID, channelID, counterparty, version)
+	})
+
+	var (
+		finalVersion string
+		err          error
+	)
+	if hook, ok := chainOverride[OnChanOpenInitOverrideHooks](c); ok {
+		finalVersion, err = hook.OnChanOpenInitOverride(im, ctx, order, connectionHops, portID, channelID, counterparty, version)
+	} else {
+		finalVersion, err = im.App.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+	}
+
+	chainEach(c, func(hook OnChanOpenInitAfterHooks) {
+		hook.OnChanOpenInitAfterHook(ctx, order, connectionHops, portID, channelID, counterparty, version, finalVersion, err)
+	})
+	return finalVersion, err
+}
+
+func (c HookChain) OnChanOpenTryOverride(im IBCMiddleware, ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, counterparty channeltypes.Counterparty, counterpartyVersion string) (string, error) {
+	chainEach(c, func(hook OnChanOpenTryBeforeHooks) {
+		hook.OnChanOpenTryBeforeHook(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+	})
+
+	var (
+		version string
+		err     error
+	)
+	if hook, ok := chainOverride[OnChanOpenTryOverrideHooks](c); ok {
+		version, err = hook.OnChanOpenTryOverride(im, ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+	} else {
+		version, err = im.App.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+	}
+
+	chainEach(c, func(hook OnChanOpenTryAfterHooks) {
+		hook.OnChanOpenTryAfterHook(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion, version, err)
+	})
+	return version, err
+}
+
+func (c HookChain) OnChanOpenAckOverride(im IBCMiddleware, ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	chainEach(c, func(hook OnChanOpenAckBeforeHooks) {
+		hook.OnChanOpenAckBeforeHook(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnChanOpenAckOverrideHooks](c); ok {
+		err = hook.OnChanOpenAckOverride(im, ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	} else {
+		err = im.App.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	}
+
+	chainEach(c, func(hook OnChanOpenAckAfterHooks) {
+		hook.OnChanOpenAckAfterHook(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion, err)
+	})
+	return err
+}
+
+func (c HookChain) OnChanOpenConfirmOverride(im IBCMiddleware, ctx sdk.Context, portID, channelID string) error {
+	chainEach(c, func(hook OnChanOpenConfirmBeforeHooks) {
+		hook.OnChanOpenConfirmBeforeHook(ctx, portID, channelID)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnChanOpenConfirmOverrideHooks](c); ok {
+		err = hook.OnChanOpenConfirmOverride(im, ctx, portID, channelID)
+	} else {
+		err = im.App.OnChanOpenConfirm(ctx, portID, channelID)
+	}
+
+	chainEach(c, func(hook OnChanOpenConfirmAfterHooks) {
+		hook.OnChanOpenConfirmAfterHook(ctx, portID, channelID, err)
+	})
+	return err
+}
+
+func (c HookChain) OnChanCloseInitOverride(im IBCMiddleware, ctx sdk.Context, portID, channelID string) error {
+	chainEach(c, func(hook OnChanCloseInitBeforeHooks) {
+		hook.OnChanCloseInitBeforeHook(ctx, portID, channelID)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnChanCloseInitOverrideHooks](c); ok {
+		err = hook.OnChanCloseInitOverride(im, ctx, portID, channelID)
+	} else {
+		err = im.App.OnChanCloseInit(ctx, portID, channelID)
+	}
+
+	chainEach(c, func(hook OnChanCloseInitAfterHooks) {
+		hook.OnChanCloseInitAfterHook(ctx, portID, channelID, err)
+	})
+	return err
+}
+
+func (c HookChain) OnChanCloseConfirmOverride(im IBCMiddleware, ctx sdk.Context, portID, channelID string) error {
+	chainEach(c, func(hook OnChanCloseConfirmBeforeHooks) {
+		hook.OnChanCloseConfirmBeforeHook(ctx, portID, channelID)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnChanCloseConfirmOverrideHooks](c); ok {
+		err = hook.OnChanCloseConfirmOverride(im, ctx, portID, channelID)
+	} else {
+		err = im.App.OnChanCloseConfirm(ctx, portID, channelID)
+	}
+
+	chainEach(c, func(hook OnChanCloseConfirmAfterHooks) {
+		hook.OnChanCloseConfirmAfterHook(ctx, portID, channelID, err)
+	})
+	return err
+}
+
+func (c HookChain) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	chainEach(c, func(hook OnRecvPacketBeforeHooks) {
+		hook.OnRecvPacketBeforeHook(ctx, channelVersion, packet, relayer)
+	})
+
+	var ack ibcexported.Acknowledgement
+	if hook, ok := chainOverride[OnRecvPacketOverrideHooks](c); ok {
+		ack = hook.OnRecvPacketOverride(im, ctx, channelVersion, packet, relayer)
+	} else {
+		ack = im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	chainEach(c, func(hook OnRecvPacketAfterHooks) {
+		hook.OnRecvPacketAfterHook(ctx, channelVersion, packet, relayer, ack)
+	})
+	return ack
+}
+
+func (c HookChain) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	chainEach(c, func(hook OnAcknowledgementPacketBeforeHooks) {
+		hook.OnAcknowledgementPacketBeforeHook(ctx, channelVersion, packet, acknowledgement, relayer)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnAcknowledgementPacketOverrideHooks](c); ok {
+		err = hook.OnAcknowledgementPacketOverride(im, ctx, channelVersion, packet, acknowledgement, relayer)
+	} else {
+		err = im.App.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+	}
+
+	chainEach(c, func(hook OnAcknowledgementPacketAfterHooks) {
+		hook.OnAcknowledgementPacketAfterHook(ctx, channelVersion, packet, acknowledgement, relayer, err)
+	})
+	return err
+}
+
+func (c HookChain) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	chainEach(c, func(hook OnTimeoutPacketBeforeHooks) {
+		hook.OnTimeoutPacketBeforeHook(ctx, channelVersion, packet, relayer)
+	})
+
+	var err error
+	if hook, ok := chainOverride[OnTimeoutPacketOverrideHooks](c); ok {
+		err = hook.OnTimeoutPacketOverride(im, ctx, channelVersion, packet, relayer)
+	} else {
+		err = im.App.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	chainEach(c, func(hook OnTimeoutPacketAfterHooks) {
+		hook.OnTimeoutPacketAfterHook(ctx, channelVersion, packet, relayer, err)
+	})
+	return err
+}
+
+func (c HookChain) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	chainEach(c, func(hook SendPacketBeforeHooks) {
+		hook.SendPacketBeforeHook(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	})
+
+	var (
+		seq uint64
+		err error
+	)
+	if hook, ok := chainOverride[SendPacketOverrideHooks](c); ok {
+		seq, err = hook.SendPacketOverride(i, ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	} else {
+		seq, err = i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	chainEach(c, func(hook SendPacketAfterHooks) {
+		hook.SendPacketAfterHook(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, err)
+	})
+	return seq, err
+}
+
+func (c HookChain) WriteAcknowledgementOverride(i ICS4Middleware, ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	chainEach(c, func(hook WriteAcknowledgementBeforeHooks) {
+		hook.WriteAcknowledgementBeforeHook(ctx, packet, ack)
+	})
+
+	var err error
+	if hook, ok := chainOverride[WriteAcknowledgementOverrideHooks](c); ok {
+		err = hook.WriteAcknowledgementOverride(i, ctx, packet, ack)
+	} else {
+		err = i.channel.WriteAcknowledgement(ctx, packet, ack)
+	}
+
+	chainEach(c, func(hook WriteAcknowledgementAfterHooks) {
+		hook.WriteAcknowledgementAfterHook(ctx, packet, ack, err)
+	})
+	return err
+}
+
+func (c HookChain) GetAppVersionOverride(i ICS4Middleware, ctx sdk.Context, portID, channelID string) (string, bool) {
+	chainEach(c, func(hook GetAppVersionBeforeHooks) {
+		hook.GetAppVersionBeforeHook(ctx, portID, channelID)
+	})
+
+	var (
+		version string
+		found   bool
+	)
+	if hook, ok := chainOverride[GetAppVersionOverrideHooks](c); ok {
+		version, found = hook.GetAppVersionOverride(i, ctx, portID, channelID)
+	} else {
+		version, found = i.channel.GetAppVersion(ctx, portID, channelID)
+	}
+
+	chainEach(c, func(hook GetAppVersionAfterHooks) {
+		hook.GetAppVersionAfterHook(ctx, portID, channelID, version, found)
+	})
+	return version, found
+}
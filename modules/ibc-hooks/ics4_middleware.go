@@ -4,11 +4,10 @@ import (
 	// external libraries
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
-	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	// ibc-go
-	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 var _ porttypes.ICS4Wrapper = &ICS4Middleware{}
@@ -27,35 +26,35 @@ func NewICS4Middleware(channel porttypes.ICS4Wrapper, hooks Hooks) ICS4Middlewar
 	}
 }
 
-func (i ICS4Middleware) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
+func (i ICS4Middleware) SendPacket(ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
 	if hook, ok := i.Hooks.(SendPacketOverrideHooks); ok {
-		return hook.SendPacketOverride(i, ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+		return hook.SendPacketOverride(i, ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
 	if hook, ok := i.Hooks.(SendPacketBeforeHooks); ok {
-		hook.SendPacketBeforeHook(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+		hook.SendPacketBeforeHook(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	seq, err := i.channel.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	seq, err := i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 
 	if hook, ok := i.Hooks.(SendPacketAfterHooks); ok {
-		hook.SendPacketAfterHook(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, err)
+		hook.SendPacketAfterHook(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, err)
 	}
 
 	return seq, err
 }
 
-func (i ICS4Middleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+func (i ICS4Middleware) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
 	if hook, ok := i.Hooks.(WriteAcknowledgementOverrideHooks); ok {
-		return hook.WriteAcknowledgementOverride(i, ctx, chanCap, packet, ack)
+		return hook.WriteAcknowledgementOverride(i, ctx, packet, ack)
 	}
 
 	if hook, ok := i.Hooks.(WriteAcknowledgementBeforeHooks); ok {
-		hook.WriteAcknowledgementBeforeHook(ctx, chanCap, packet, ack)
+		hook.WriteAcknowledgementBeforeHook(ctx, packet, ack)
 	}
-	err := i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	err := i.channel.WriteAcknowledgement(ctx, packet, ack)
 	if hook, ok := i.Hooks.(WriteAcknowledgementAfterHooks); ok {
-		hook.WriteAcknowledgementAfterHook(ctx, chanCap, packet, ack, err)
+		hook.WriteAcknowledgementAfterHook(ctx, packet, ack, err)
 	}
 
 	return err
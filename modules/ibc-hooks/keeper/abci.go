@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BeginBlocker retries the failed ack callbacks in the queue, bounded by the per block gas budget and the
+// ack callback gas limit params.
+// Callbacks that keep failing are dropped once they reach the max number of attempts
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	if k.contractKeeper == nil {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	retryGasBudget := storetypes.Gas(params.AckCallbackRetryGasBudget)
+	remainingGas := retryGasBudget
+
+	// The queue is read one callback at a time, so that the callbacks beyond the block's budget are never loaded
+	var nextKey []byte
+	for remainingGas > 0 {
+		pending, key, found := k.nextPendingAckCallback(ctx, nextKey)
+		if !found {
+			return
+		}
+		nextKey = append(key, 0x00)
+
+		// Each retry is bounded by the ack callback gas limit (and the gas limit requested in the memo, if lower),
+		// and by what's left of the block's budget
+		callbackGasLimit := MinGasLimit(params.AckCallbackGasLimit, pending.GasLimit)
+		gasLimit := remainingGas
+		limitedByBudget := callbackGasLimit == 0 || remainingGas < callbackGasLimit
+		if !limitedByBudget {
+			gasLimit = callbackGasLimit
+		}
+
+		gasUsed, outOfGas, err := k.retryAckCallback(ctx, pending, gasLimit)
+		remainingGas -= min(gasUsed, remainingGas)
+
+		if err == nil {
+			k.RemovePendingAckCallback(ctx, pending.Channel, pending.Sequence)
+			k.emitAckCallbackEvent(ctx, types.EventTypeAckCallbackRetried, pending)
+			continue
+		}
+
+		// If the callback ran out of the gas left in this block's budget, but may have succeeded with the full
+		// budget, it is retried next block without counting the attempt
+		if outOfGas && limitedByBudget && gasLimit < retryGasBudget {
+			return
+		}
+
+		pending.Attempts++
+		pending.LastError = err.Error()
+		if pending.Attempts >= params.MaxAckCallbackAttempts {
+			k.RemovePendingAckCallback(ctx, pending.Channel, pending.Sequence)
+			k.emitAckCallbackEvent(ctx, types.EventTypeAckCallbackDropped, pending)
+			continue
+		}
+
+		k.SetPendingAckCallback(ctx, pending)
+		k.emitAckCallbackEvent(ctx, types.EventTypeAckCallbackRetryFail, pending)
+	}
+}
+
+// nextPendingAckCallback returns the first callback of the retry queue whose key is at or after start, along with
+// its key. The iterator is closed before returning, since the queue is modified while the callback is retried
+func (k Keeper) nextPendingAckCallback(ctx sdk.Context, start []byte) (types.PendingAckCallback, []byte, bool) {
+	iterator := k.ackCallbackRetryStore(ctx).Iterator(start, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PendingAckCallback{}, nil, false
+	}
+
+	var pending types.PendingAckCallback
+	if err := pending.Unmarshal(iterator.Value()); err != nil {
+		panic(err)
+	}
+	return pending, bytes.Clone(iterator.Key()), true
+}
+
+// retryAckCallback sends the ack sudo message to the contract with a gas meter limited to gasLimit.
+// The contract's state changes are only committed if the call succeeds
+func (k Keeper) retryAckCallback(ctx sdk.Context, pending types.PendingAckCallback, gasLimit storetypes.Gas) (gasUsed storetypes.Gas, outOfGas bool, err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(pending.Contract)
+	if err != nil {
+		return 0, false, err
+	}
+
+	sudoMsg, err := types.NewIBCAckSudoMsg(pending.Channel, pending.Sequence, pending.Ack, pending.Success)
+	if err != nil {
+		return 0, false, err
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	// A panicking contract call must not halt the chain from BeginBlock: it is counted as a failed attempt
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumedToLimit()
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				outOfGas = true
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "ack callback ran out of gas (limit %d)", gasLimit)
+				return
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "ack callback panicked: %v", r)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, sudoMsg); err != nil {
+		return gasMeter.GasConsumedToLimit(), false, err
+	}
+	writeFn()
+
+	return gasMeter.GasConsumedToLimit(), false, nil
+}
+
+func (k Keeper) emitAckCallbackEvent(ctx sdk.Context, eventType string, pending types.PendingAckCallback) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyContract, pending.Contract),
+			sdk.NewAttribute(types.AttributeKeyChannel, pending.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(pending.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(uint64(pending.Attempts), 10)),
+			sdk.NewAttribute(types.AttributeKeyError, pending.LastError),
+		),
+	)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RunWithGasLimit runs fn with a child gas meter limited to gasLimit, and charges the gas it used to the
+// gas meter of ctx. Running out of the child's gas is returned as an ErrOutOfGas error instead of aborting
+// the whole transaction. A gasLimit of 0 runs fn with the gas meter of ctx
+func RunWithGasLimit(ctx sdk.Context, gasLimit uint64, descriptor string, fn func(ctx sdk.Context) error) (err error) {
+	if gasLimit == 0 {
+		return fn(ctx)
+	}
+
+	childGasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(childGasMeter.GasConsumedToLimit(), descriptor)
+		if r == nil {
+			return
+		}
+
+		// Only handle running out of the child's gas. Anything else (including the parent meter running
+		// out of gas above) keeps panicking
+		if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !childGasMeter.IsOutOfGas() {
+			panic(r)
+		}
+		err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "%s exceeded the gas limit of %d", descriptor, gasLimit)
+	}()
+
+	return fn(ctx.WithGasMeter(childGasMeter))
+}
+
+// MinGasLimit returns the lowest of two gas limits, where 0 means no limit
+func MinGasLimit(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	return min(a, b)
+}
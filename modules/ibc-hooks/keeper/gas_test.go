@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMinGasLimit(t *testing.T) {
+	require.Equal(t, uint64(0), keeper.MinGasLimit(0, 0))
+	require.Equal(t, uint64(100), keeper.MinGasLimit(0, 100))
+	require.Equal(t, uint64(100), keeper.MinGasLimit(100, 0))
+	require.Equal(t, uint64(50), keeper.MinGasLimit(100, 50))
+}
+
+func TestRunWithGasLimit(t *testing.T) {
+	_, ctx := setupKeeper(t)
+
+	t.Run("within the limit", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
+		err := keeper.RunWithGasLimit(ctx, 1_000, "test", func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(600, "test")
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, storetypes.Gas(600), ctx.GasMeter().GasConsumed(), "gas should be charged to the parent")
+	})
+
+	t.Run("out of gas", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
+		err := keeper.RunWithGasLimit(ctx, 1_000, "test", func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(1_500, "test")
+			return nil
+		})
+		require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+		require.Equal(t, storetypes.Gas(1_000), ctx.GasMeter().GasConsumed(), "only the limit should be charged")
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
+		err := keeper.RunWithGasLimit(ctx, 0, "test", func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(5_000, "test")
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, storetypes.Gas(5_000), ctx.GasMeter().GasConsumed())
+	})
+
+	t.Run("errors are returned", func(t *testing.T) {
+		expectedErr := errors.New("contract error")
+		err := keeper.RunWithGasLimit(ctx, 1_000, "test", func(ctx sdk.Context) error {
+			return expectedErr
+		})
+		require.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("parent out of gas keeps panicking", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewGasMeter(500))
+		require.Panics(t, func() {
+			_ = keeper.RunWithGasLimit(ctx, 1_000, "test", func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(800, "test")
+				return nil
+			})
+		})
+	})
+
+	t.Run("other panics are not recovered", func(t *testing.T) {
+		require.PanicsWithValue(t, "unexpected", func() {
+			_ = keeper.RunWithGasLimit(ctx, 1_000, "test", func(ctx sdk.Context) error {
+				panic("unexpected")
+			})
+		})
+	})
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis restores the callbacks of the packets that were pending at export, the retry queue and the params
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, callback := range genState.PacketCallbacks {
+		k.StorePacketCallback(ctx, callback.Channel, callback.Sequence, callback.Contract)
+		k.SetPacketCallbackGasLimit(ctx, callback.Channel, callback.Sequence, callback.GasLimit)
+	}
+	for _, pending := range genState.PendingAckCallbacks {
+		k.SetPendingAckCallback(ctx, pending)
+	}
+}
+
+// ExportGenesis returns the callbacks of all pending packets, along with the ack callbacks waiting to be retried and the params
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		PacketCallbacks:     k.GetAllPacketCallbacks(ctx),
+		PendingAckCallbacks: k.GetAllPendingAckCallbacks(ctx),
+		Params:              k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewTestLogger(t))
+	return keeper.NewKeeper(storeKey, authority), ctx
+}
+
+func TestGenesis(t *testing.T) {
+	contractA := sdk.AccAddress([]byte("contract_a__________")).String()
+	contractB := sdk.AccAddress([]byte("contract_b__________")).String()
+
+	genState := types.GenesisState{
+		PacketCallbacks: []types.PacketCallback{
+			{Channel: "07-tendermint-0", Sequence: 3, Contract: contractB},
+			{Channel: "channel-0", Sequence: 1, Contract: contractA},
+			{Channel: "channel-0", Sequence: 2, Contract: contractB, GasLimit: 500_000},
+			{Channel: "channel-12", Sequence: 7, Contract: contractA},
+		},
+		PendingAckCallbacks: []types.PendingAckCallback{
+			{Channel: "channel-0", Sequence: 5, Contract: contractA, Ack: []byte(`{"result":"AQ=="}`), Success: true, Attempts: 2, LastError: "failed"},
+		},
+		Params: types.NewParams(true, 100, 200, 0),
+	}
+	require.NoError(t, genState.Validate())
+
+	k, ctx := setupKeeper(t)
+	k.InitGenesis(ctx, genState)
+
+	// The callbacks should be available to the hooks after import
+	require.Equal(t, contractA, k.GetPacketCallback(ctx, "channel-0", 1))
+	require.Equal(t, contractB, k.GetPacketCallback(ctx, "07-tendermint-0", 3))
+
+	// Exporting should return the same callbacks
+	exported := k.ExportGenesis(ctx)
+	require.ElementsMatch(t, genState.PacketCallbacks, exported.PacketCallbacks)
+	require.Equal(t, genState.PendingAckCallbacks, exported.PendingAckCallbacks)
+	require.Equal(t, genState.Params, exported.Params)
+
+	// And the exported state should survive a JSON round trip into a fresh store
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(exported)
+	require.NoError(t, err)
+
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &imported))
+	require.NoError(t, imported.Validate())
+
+	newKeeper, newCtx := setupKeeper(t)
+	newKeeper.InitGenesis(newCtx, imported)
+	require.ElementsMatch(t, genState.PacketCallbacks, newKeeper.ExportGenesis(newCtx).PacketCallbacks)
+	require.Equal(t, genState.PendingAckCallbacks, newKeeper.ExportGenesis(newCtx).PendingAckCallbacks)
+}
+
+func TestGenesis_Empty(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	require.Empty(t, k.ExportGenesis(ctx).PacketCallbacks)
+	require.Empty(t, k.ExportGenesis(ctx).PendingAckCallbacks)
+	require.Equal(t, types.DefaultParams(), k.ExportGenesis(ctx).Params)
+}
+
+func TestParsePacketKey(t *testing.T) {
+	channel, sequence, err := keeper.ParsePacketKey(keeper.GetPacketKey("channel-4", 12))
+	require.NoError(t, err)
+	require.Equal(t, "channel-4", channel)
+	require.Equal(t, uint64(12), sequence)
+
+	_, _, err = keeper.ParsePacketKey([]byte("channel-4"))
+	require.ErrorContains(t, err, "invalid packet key")
+
+	_, _, err = keeper.ParsePacketKey([]byte("channel-4::abc"))
+	require.ErrorContains(t, err, "invalid sequence in packet key")
+}
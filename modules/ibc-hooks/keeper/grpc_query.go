@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Query the module parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Query the contract registered for the ack or timeout of a packet
+func (k Keeper) PacketCallback(c context.Context, req *types.QueryPacketCallbackRequest) (*types.QueryPacketCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contract := k.GetPacketCallback(ctx, req.Channel, req.Sequence)
+	if contract == "" {
+		return nil, status.Errorf(codes.NotFound, "no callback registered for channel %s and sequence %d", req.Channel, req.Sequence)
+	}
+	return &types.QueryPacketCallbackResponse{
+		Contract: contract,
+		GasLimit: k.GetPacketCallbackGasLimit(ctx, req.Channel, req.Sequence),
+	}, nil
+}
+
+// Query the pending packet callbacks of a contract
+func (k Keeper) CallbacksByContract(c context.Context, req *types.QueryCallbacksByContractRequest) (*types.QueryCallbacksByContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := k.callbacksByContractStore(ctx, req.Contract)
+
+	callbacks := []types.PacketCallback{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		channel, sequence, err := ParsePacketKey(key)
+		if err != nil {
+			return err
+		}
+		callbacks = append(callbacks, types.PacketCallback{
+			Channel:  channel,
+			Sequence: sequence,
+			Contract: req.Contract,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCallbacksByContractResponse{Callbacks: callbacks, Pagination: pageRes}, nil
+}
+
+// Query the intermediate sender used as the contract caller for packets received from the original sender
+func (k Keeper) WasmSender(c context.Context, req *types.QueryWasmSenderRequest) (*types.QueryWasmSenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Channel == "" || req.OriginalSender == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel and original sender cannot be empty")
+	}
+
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	address, err := DeriveIntermediateSender(req.Channel, req.OriginalSender, prefix)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryWasmSenderResponse{Address: address}, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestQueryPacketCallback(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	k.StorePacketCallback(ctx, "channel-0", 1, contract)
+
+	res, err := k.PacketCallback(ctx, &types.QueryPacketCallbackRequest{Channel: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, contract, res.Contract)
+	require.Zero(t, res.GasLimit)
+
+	// The gas limit requested in the memo is returned with the contract
+	k.SetPacketCallbackGasLimit(ctx, "channel-0", 1, 100_000)
+	res, err = k.PacketCallback(ctx, &types.QueryPacketCallbackRequest{Channel: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000), res.GasLimit)
+
+	// It is removed along with the callback
+	k.DeletePacketCallback(ctx, "channel-0", 1)
+	require.Zero(t, k.GetPacketCallbackGasLimit(ctx, "channel-0", 1))
+
+	_, err = k.PacketCallback(ctx, &types.QueryPacketCallbackRequest{Channel: "channel-0", Sequence: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.PacketCallback(ctx, &types.QueryPacketCallbackRequest{Sequence: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryCallbacksByContract(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	contractA := sdk.AccAddress([]byte("contract_a__________")).String()
+	contractB := sdk.AccAddress([]byte("contract_b__________")).String()
+
+	k.StorePacketCallback(ctx, "channel-0", 1, contractA)
+	k.StorePacketCallback(ctx, "channel-0", 2, contractA)
+	k.StorePacketCallback(ctx, "channel-1", 1, contractA)
+	k.StorePacketCallback(ctx, "channel-0", 3, contractB)
+
+	// Query the first page
+	res, err := k.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{
+		Contract:   contractA,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{Channel: "channel-0", Sequence: 1, Contract: contractA},
+		{Channel: "channel-0", Sequence: 2, Contract: contractA},
+	}, res.Callbacks)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// Query the second page
+	res, err = k.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{
+		Contract:   contractA,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{Channel: "channel-1", Sequence: 1, Contract: contractA},
+	}, res.Callbacks)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// Replacing the contract of a callback should move it in the index
+	k.StorePacketCallback(ctx, "channel-0", 1, contractB)
+	// And deleting a callback should remove it from the index
+	k.DeletePacketCallback(ctx, "channel-0", 2)
+
+	res, err = k.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{Contract: contractA})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{Channel: "channel-1", Sequence: 1, Contract: contractA},
+	}, res.Callbacks)
+
+	res, err = k.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{Contract: contractB})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{Channel: "channel-0", Sequence: 1, Contract: contractB},
+		{Channel: "channel-0", Sequence: 3, Contract: contractB},
+	}, res.Callbacks)
+
+	_, err = k.CallbacksByContract(ctx, &types.QueryCallbacksByContractRequest{Contract: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryWasmSender(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	originalSender := "juno12smx2wdlyttvyzvzg54y2vnqwq2qjatezqwqxu"
+
+	res, err := k.WasmSender(ctx, &types.QueryWasmSenderRequest{Channel: "channel-42", OriginalSender: originalSender})
+	require.NoError(t, err)
+
+	expected, err := keeper.DeriveIntermediateSender("channel-42", originalSender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Address)
+
+	_, err = k.WasmSender(ctx, &types.QueryWasmSenderRequest{Channel: "channel-42"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
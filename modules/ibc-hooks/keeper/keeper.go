@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,19 +17,35 @@ import (
 
 type (
 	Keeper struct {
-		storeKey storetypes.StoreKey
+		storeKey  storetypes.StoreKey
+		authority string
+
+		contractKeeper types.ContractKeeper
 	}
 )
 
 // NewKeeper returns a new instance of the x/ibchooks keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
+	authority string,
 ) Keeper {
 	return Keeper{
-		storeKey: storeKey,
+		storeKey:  storeKey,
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetContractKeeper sets the keeper used to retry failed ack callbacks. The wasm keeper depends on the ICS4
+// wrapper built from this keeper, so it can only be set once the wasm keeper has been created
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -36,22 +55,111 @@ func GetPacketKey(channel string, packetSequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
 }
 
+// ParsePacketKey splits a key built with GetPacketKey back into its channel and sequence
+func ParsePacketKey(key []byte) (channel string, packetSequence uint64, err error) {
+	keyStr := string(key)
+	separator := strings.LastIndex(keyStr, "::")
+	if separator == -1 {
+		return "", 0, fmt.Errorf("invalid packet key %s", keyStr)
+	}
+
+	packetSequence, err = strconv.ParseUint(keyStr[separator+2:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid sequence in packet key %s: %w", keyStr, err)
+	}
+	return keyStr[:separator], packetSequence, nil
+}
+
+func (k Keeper) packetCallbackStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketCallbackKeyPrefix)
+}
+
+func (k Keeper) callbacksByContractStore(ctx sdk.Context, contract string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCallbacksByContractPrefix(contract))
+}
+
+func (k Keeper) callbackGasLimitStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.CallbackGasLimitKeyPrefix)
+}
+
 // StorePacketCallback stores which contract will be listening for the ack or timeout of a packet
 func (k Keeper) StorePacketCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(GetPacketKey(channel, packetSequence), []byte(contract))
+	store := k.packetCallbackStore(ctx)
+	packetKey := GetPacketKey(channel, packetSequence)
+
+	// Remove the index entry and gas limit of the previous contract, if the callback is being replaced
+	if previousContract := store.Get(packetKey); previousContract != nil {
+		k.callbacksByContractStore(ctx, string(previousContract)).Delete(packetKey)
+		k.callbackGasLimitStore(ctx).Delete(packetKey)
+	}
+
+	store.Set(packetKey, []byte(contract))
+	k.callbacksByContractStore(ctx, contract).Set(packetKey, []byte{})
 }
 
 // GetPacketCallback returns the bech32 addr of the contract that is expecting a callback from a packet
 func (k Keeper) GetPacketCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
-	store := ctx.KVStore(k.storeKey)
+	store := k.packetCallbackStore(ctx)
 	return string(store.Get(GetPacketKey(channel, packetSequence)))
 }
 
+// GetAllPacketCallbacks returns every callback that is still waiting for the ack or timeout of its packet
+func (k Keeper) GetAllPacketCallbacks(ctx sdk.Context) []types.PacketCallback {
+	store := k.packetCallbackStore(ctx)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	callbacks := []types.PacketCallback{}
+	for ; iterator.Valid(); iterator.Next() {
+		channel, sequence, err := ParsePacketKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		callbacks = append(callbacks, types.PacketCallback{
+			Channel:  channel,
+			Sequence: sequence,
+			Contract: string(iterator.Value()),
+			GasLimit: k.GetPacketCallbackGasLimit(ctx, channel, sequence),
+		})
+	}
+	return callbacks
+}
+
 // DeletePacketCallback deletes the callback from storage once it has been processed
 func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(GetPacketKey(channel, packetSequence))
+	store := k.packetCallbackStore(ctx)
+	packetKey := GetPacketKey(channel, packetSequence)
+
+	contract := store.Get(packetKey)
+	if contract == nil {
+		return
+	}
+
+	store.Delete(packetKey)
+	k.callbacksByContractStore(ctx, string(contract)).Delete(packetKey)
+	k.callbackGasLimitStore(ctx).Delete(packetKey)
+}
+
+// SetPacketCallbackGasLimit stores the gas limit requested in the memo for the callback of a packet.
+// A gas limit of 0 removes it
+func (k Keeper) SetPacketCallbackGasLimit(ctx sdk.Context, channel string, packetSequence uint64, gasLimit uint64) {
+	store := k.callbackGasLimitStore(ctx)
+	packetKey := GetPacketKey(channel, packetSequence)
+	if gasLimit == 0 {
+		store.Delete(packetKey)
+		return
+	}
+	store.Set(packetKey, sdk.Uint64ToBigEndian(gasLimit))
+}
+
+// GetPacketCallbackGasLimit returns the gas limit requested in the memo for the callback of a packet, or 0 if
+// none was requested
+func (k Keeper) GetPacketCallbackGasLimit(ctx sdk.Context, channel string, packetSequence uint64) uint64 {
+	bz := k.callbackGasLimitStore(ctx).Get(GetPacketKey(channel, packetSequence))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
 }
 
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
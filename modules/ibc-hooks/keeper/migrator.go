@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	v2 "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/migrations/v2"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the module state from the consensus version 1 to
+// version 2, and sets the default params which were introduced in version 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.Migrate(ctx, m.keeper.storeKey); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
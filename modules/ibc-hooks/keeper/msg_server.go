@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ibc-hooks MsgServer interface
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Returns the acknowledgement of a failed ack callback to the contract that registered it, and removes
+// the callback from the retry queue
+func (k msgServer) PullAckCallback(goCtx context.Context, msg *types.MsgPullAckCallback) (*types.MsgPullAckCallbackResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pending, found := k.GetPendingAckCallback(ctx, msg.Channel, msg.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoPendingAck, "channel %s, sequence %d", msg.Channel, msg.Sequence)
+	}
+	if pending.Contract != msg.Contract {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "callback of channel %s, sequence %d was registered by %s",
+			msg.Channel, msg.Sequence, pending.Contract)
+	}
+
+	k.RemovePendingAckCallback(ctx, msg.Channel, msg.Sequence)
+	k.emitAckCallbackEvent(ctx, types.EventTypeAckCallbackPulled, pending)
+
+	return &types.MsgPullAckCallbackResponse{Ack: pending.Ack, Success: pending.Success}, nil
+}
+
+// Updates the module parameters. Only the module authority (x/gov) can update them
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
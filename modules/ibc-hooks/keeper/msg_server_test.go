@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgPullAckCallback(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	otherContract := sdk.AccAddress([]byte("other_contract______")).String()
+	ack := []byte(`{"result":"AQ=="}`)
+
+	k.SetPendingAckCallback(ctx, types.PendingAckCallback{
+		Channel:   "channel-0",
+		Sequence:  1,
+		Contract:  contract,
+		Ack:       ack,
+		Success:   true,
+		Attempts:  1,
+		LastError: "failed",
+	})
+
+	// Only the contract that registered the callback can pull it
+	_, err := msgServer.PullAckCallback(ctx, &types.MsgPullAckCallback{Contract: otherContract, Channel: "channel-0", Sequence: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// The callback must exist
+	_, err = msgServer.PullAckCallback(ctx, &types.MsgPullAckCallback{Contract: contract, Channel: "channel-0", Sequence: 2})
+	require.ErrorIs(t, err, types.ErrNoPendingAck)
+
+	// Pull the callback
+	res, err := msgServer.PullAckCallback(ctx, &types.MsgPullAckCallback{Contract: contract, Channel: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, ack, res.Ack)
+	require.True(t, res.Success)
+
+	// And it should no longer be in the queue
+	_, found := k.GetPendingAckCallback(ctx, "channel-0", 1)
+	require.False(t, found)
+
+	// Invalid messages are rejected
+	_, err = msgServer.PullAckCallback(ctx, &types.MsgPullAckCallback{Contract: "invalid", Channel: "channel-0", Sequence: 1})
+	require.ErrorContains(t, err, "invalid contract address")
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	newParams := types.NewParams(true, 500_000, 250_000, 0)
+
+	// Only the authority can update the params
+	notAuthority := sdk.AccAddress([]byte("not_authority_______")).String()
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: notAuthority, Params: newParams})
+	require.ErrorContains(t, err, "invalid authority")
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: newParams})
+	require.NoError(t, err)
+	require.Equal(t, newParams, k.GetParams(ctx))
+
+	// The params should be available through the query
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, newParams, res.Params)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the module parameters. If they were never set, the default parameters are returned
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz, err := params.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) ackCallbackRetryStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.AckCallbackRetryKeyPrefix)
+}
+
+// SetPendingAckCallback adds or updates an ack callback in the retry queue
+func (k Keeper) SetPendingAckCallback(ctx sdk.Context, pending types.PendingAckCallback) {
+	bz, err := pending.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	k.ackCallbackRetryStore(ctx).Set(GetPacketKey(pending.Channel, pending.Sequence), bz)
+}
+
+// GetPendingAckCallback returns the queued ack callback of a packet, if any
+func (k Keeper) GetPendingAckCallback(ctx sdk.Context, channel string, packetSequence uint64) (types.PendingAckCallback, bool) {
+	bz := k.ackCallbackRetryStore(ctx).Get(GetPacketKey(channel, packetSequence))
+	if bz == nil {
+		return types.PendingAckCallback{}, false
+	}
+
+	var pending types.PendingAckCallback
+	if err := pending.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return pending, true
+}
+
+// RemovePendingAckCallback removes an ack callback from the retry queue
+func (k Keeper) RemovePendingAckCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+	k.ackCallbackRetryStore(ctx).Delete(GetPacketKey(channel, packetSequence))
+}
+
+// GetAllPendingAckCallbacks returns every ack callback in the retry queue
+func (k Keeper) GetAllPendingAckCallbacks(ctx sdk.Context) []types.PendingAckCallback {
+	iterator := k.ackCallbackRetryStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingCallbacks := []types.PendingAckCallback{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingAckCallback
+		if err := pending.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		pendingCallbacks = append(pendingCallbacks, pending)
+	}
+	return pendingCallbacks
+}
+
+// QueueFailedAckCallback moves the callback of a packet to the retry queue after its ack sudo call failed
+func (k Keeper) QueueFailedAckCallback(ctx sdk.Context, channel string, packetSequence uint64, contract string, acknowledgement []byte, success bool, callbackErr error) {
+	k.SetPendingAckCallback(ctx, types.PendingAckCallback{
+		Channel:   channel,
+		Sequence:  packetSequence,
+		Contract:  contract,
+		Ack:       acknowledgement,
+		Success:   success,
+		Attempts:  1,
+		LastError: callbackErr.Error(),
+		GasLimit:  k.GetPacketCallbackGasLimit(ctx, channel, packetSequence),
+	})
+	k.DeletePacketCallback(ctx, channel, packetSequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAckCallbackQueued,
+			sdk.NewAttribute(types.AttributeKeyContract, contract),
+			sdk.NewAttribute(types.AttributeKeyChannel, channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packetSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, callbackErr.Error()),
+		),
+	)
+}
@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"bytes"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/ibchooks module state from the consensus version 1 to
+// version 2. In version 1, the packet callbacks were stored at the root of the
+// hooks-for-ibc store under "{channel}::{sequence}". They are now stored under
+// the PacketCallbackKeyPrefix so that the store can hold other data, and are
+// indexed by contract under the CallbacksByContractKeyPrefix
+func Migrate(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// Collect the legacy callbacks first, since the store can't be modified while iterating
+	type callback struct {
+		key   []byte
+		value []byte
+	}
+	var legacyCallbacks []callback
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.HasPrefix(iterator.Key(), types.PacketCallbackKeyPrefix) ||
+			bytes.HasPrefix(iterator.Key(), types.CallbacksByContractKeyPrefix) {
+			continue
+		}
+		legacyCallbacks = append(legacyCallbacks, callback{key: iterator.Key(), value: iterator.Value()})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, legacyCallback := range legacyCallbacks {
+		newKey := append(bytes.Clone(types.PacketCallbackKeyPrefix), legacyCallback.key...)
+		store.Set(newKey, legacyCallback.value)
+		store.Delete(legacyCallback.key)
+
+		indexKey := append(types.GetCallbacksByContractPrefix(string(legacyCallback.value)), legacyCallback.key...)
+		store.Set(indexKey, []byte{})
+	}
+
+	ctx.Logger().Info("Migrated ibc-hooks packet callbacks", "num callbacks", len(legacyCallbacks))
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	v2 "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/migrations/v2"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewTestLogger(t))
+	k := keeper.NewKeeper(storeKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	// Store callbacks in the legacy format, at the root of the store
+	legacyCallbacks := map[string]string{
+		"channel-0::1":  "contract-1",
+		"channel-0::2":  "contract-2",
+		"channel-12::7": "contract-3",
+	}
+	store := ctx.KVStore(storeKey)
+	for key, contract := range legacyCallbacks {
+		store.Set([]byte(key), []byte(contract))
+	}
+
+	// Before the migration, the keeper should not find the callbacks
+	require.Empty(t, k.GetPacketCallback(ctx, "channel-0", 1))
+
+	require.NoError(t, v2.Migrate(ctx, storeKey))
+
+	// After the migration, the callbacks should be found under the new prefix
+	require.Equal(t, "contract-1", k.GetPacketCallback(ctx, "channel-0", 1))
+	require.Equal(t, "contract-2", k.GetPacketCallback(ctx, "channel-0", 2))
+	require.Equal(t, "contract-3", k.GetPacketCallback(ctx, "channel-12", 7))
+
+	// The callbacks should also be indexed by contract
+	require.True(t, prefix.NewStore(store, types.GetCallbacksByContractPrefix("contract-2")).Has([]byte("channel-0::2")))
+	require.True(t, prefix.NewStore(store, types.GetCallbacksByContractPrefix("contract-3")).Has([]byte("channel-12::7")))
+
+	// And the legacy keys should have been removed
+	for key := range legacyCallbacks {
+		require.False(t, store.Has([]byte(key)), "legacy key %s should have been deleted", key)
+	}
+
+	// Running the migration again should be a no-op
+	require.NoError(t, v2.Migrate(ctx, storeKey))
+	require.Equal(t, "contract-1", k.GetPacketCallback(ctx, "channel-0", 1))
+}
@@ -0,0 +1,221 @@
+package ibc_hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	errors "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ OnRecvPacketOverrideHooks = MsgRouterHooks{}
+
+// MsgAck is the unversioned acknowledgement of a packet whose msg memo was executed. Packets can request the
+// versioned types.ContractAcknowledgement instead with the ack_version key of their memo
+type MsgAck struct {
+	MsgResult []byte `json:"msg_result"`
+	IbcAck    []byte `json:"ibc_ack"`
+}
+
+// MsgRouterHooks executes the SDK message in the msg key of the memo of received packets, for chains without
+// CosmWasm. The message is executed through the MsgServiceRouter, and must be signed by the intermediate sender,
+// which receives the funds sent with the packet. Only the message types allowed by the params can be executed
+type MsgRouterHooks struct {
+	router              baseapp.MessageRouter
+	cdc                 codec.Codec
+	ibcHooksKeeper      *keeper.Keeper
+	bech32PrefixAccAddr string
+	packetDataRegistry  *PacketDataRegistry
+}
+
+func NewMsgRouterHooks(ibcHooksKeeper *keeper.Keeper, router baseapp.MessageRouter, cdc codec.Codec, bech32PrefixAccAddr string) MsgRouterHooks {
+	return MsgRouterHooks{
+		router:              router,
+		cdc:                 cdc,
+		ibcHooksKeeper:      ibcHooksKeeper,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+		packetDataRegistry:  DefaultPacketDataRegistry(),
+	}
+}
+
+// PacketDataRegistry returns the registry of the apps the hooks run for
+func (h MsgRouterHooks) PacketDataRegistry() *PacketDataRegistry {
+	return h.packetDataRegistry
+}
+
+func (h MsgRouterHooks) ProperlyConfigured() bool {
+	return h.router != nil && h.cdc != nil && h.ibcHooksKeeper != nil
+}
+
+func (h MsgRouterHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if !h.ProperlyConfigured() {
+		// Not configured
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	unmarshaler, ok := h.packetDataRegistry.GetUnmarshaler(packet.GetDestPort(), channelVersion)
+	if !ok {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	data, err := unmarshaler.UnmarshalPacketData(packet.GetSourcePort(), packet.GetData())
+	if err != nil {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	isMsgRouted, metadata := jsonStringHasKey(data.Memo, types.MsgRouterKey)
+	if !isMsgRouted {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	// Calculate the intermediate sender, which must be the signer of the message, based on the packet's channel and sender
+	channel := packet.GetDestChannel()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, data.Sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, data.Sender, err.Error()))
+	}
+
+	msg, err := h.ValidateAndParseMsg(ctx, channel, metadata[types.MsgRouterKey], senderBech32)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+	ackVersion, err := parseMsgAckVersion(metadata)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	// The funds sent on this packet are transferred to the intermediate sender, so that the message can use them
+	bz, err := unmarshaler.SetReceiver(packet.GetData(), senderBech32)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	// Execute the receive
+	ack := im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	// Execute the message, keeping track of the gas it uses and the events it emits for the ack
+	gasBefore := ctx.GasMeter().GasConsumed()
+	eventsBefore := len(ctx.EventManager().Events())
+	result, err := h.ExecuteMsg(ctx, msg)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgExecution, err.Error())
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	events := ctx.EventManager().Events()[eventsBefore:]
+	bz, err = NewMsgAck(ackVersion, gasUsed, events, result.Data, ack.Acknowledgement())
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// parseMsgAckVersion returns the ContractAcknowledgement version requested by the ack_version key of the memo, or
+// an empty version for the unversioned MsgAck
+func parseMsgAckVersion(metadata map[string]interface{}) (string, error) {
+	ackVersion, ok := metadata[types.AckVersionKey]
+	if !ok {
+		return "", nil
+	}
+	version, isString := ackVersion.(string)
+	if !isString || !types.IsSupportedAckVersion(version) {
+		return "", fmt.Errorf(types.ErrBadMetadataFormatMsg, ackVersion, "ack_version is not a supported ack version")
+	}
+	return version, nil
+}
+
+// NewMsgAck encodes the result of the ack of a packet whose msg memo was executed, in the ack version requested by
+// the packet. The versioned ContractAcknowledgement has no contract, and holds the message response as its result
+func NewMsgAck(ackVersion string, gasUsed uint64, events sdk.Events, msgResult, ibcAck []byte) ([]byte, error) {
+	switch ackVersion {
+	case "":
+		return json.Marshal(MsgAck{MsgResult: msgResult, IbcAck: ibcAck})
+	case types.ContractAckVersion1:
+		return types.MarshalContractAcknowledgement(types.NewContractAcknowledgement("", gasUsed, events, msgResult, ibcAck))
+	default:
+		return nil, fmt.Errorf("unsupported contract acknowledgement version %q", ackVersion)
+	}
+}
+
+// ValidateAndParseMsg decodes the Any-encoded message of the msg memo key of a packet received on the given
+// channel, and checks that the params allow it to be executed and that its only signer is the intermediate sender
+func (h MsgRouterHooks) ValidateAndParseMsg(ctx sdk.Context, channel string, msgRaw interface{}, senderBech32 string) (sdk.Msg, error) {
+	params := h.ibcHooksKeeper.GetParams(ctx)
+	if !params.ChannelEnabled(channel) {
+		return nil, errors.Wrapf(types.ErrHooksDisabled, "cannot use hooks on %s", channel)
+	}
+
+	if _, ok := msgRaw.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf(types.ErrBadMetadataFormatMsg, msgRaw, "msg is not a valid JSON map object")
+	}
+	msgBytes, err := json.Marshal(msgRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if err := h.cdc.UnmarshalInterfaceJSON(msgBytes, &msg); err != nil {
+		return nil, errors.Wrap(err, "cannot decode msg")
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if !params.MsgAllowed(typeURL) {
+		return nil, errors.Wrapf(types.ErrMsgNotAllowed, "%s cannot be executed by a hook", typeURL)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(senderBech32)
+	if err != nil {
+		return nil, err
+	}
+	signers, _, err := h.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 || !bytes.Equal(signers[0], sender) {
+		return nil, errors.Wrapf(types.ErrBadSender, "the only signer of the msg must be the intermediate sender %s", senderBech32)
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// ExecuteMsg executes the message through the MsgServiceRouter, limited to the recv gas limit param
+func (h MsgRouterHooks) ExecuteMsg(ctx sdk.Context, msg sdk.Msg) (result *sdk.Result, err error) {
+	handler := h.router.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+	}
+
+	gasLimit := h.ibcHooksKeeper.GetParams(ctx).RecvGasLimit
+	err = keeper.RunWithGasLimit(ctx, gasLimit, "ibc hooks msg execution", func(ctx sdk.Context) error {
+		var execErr error
+		result, execErr = handler(ctx, msg)
+		return execErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The handler runs with its own event manager, so its events are emitted here
+	for _, event := range result.GetEvents() {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+	return result, nil
+}
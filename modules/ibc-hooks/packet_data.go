@@ -0,0 +1,224 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// PacketData is the information the hooks need from the data of a packet, regardless of the app that sent it
+type PacketData struct {
+	// Sender of the packet on the source chain
+	Sender string
+	// Receiver of the packet on the destination chain. Empty for apps without a receiver, like interchain accounts
+	Receiver string
+	// Memo of the packet, where the wasm and ibc_callback keys are read from
+	Memo string
+	// Tokens sent with the packet, in the denom of the source chain. Empty for apps that do not send fungible tokens
+	Tokens []transfertypes.Token
+}
+
+// PacketDataUnmarshaler decodes the packet data of an IBC app so that the hooks can run for its packets
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData extracts the hook information from the packet data sent from the source port
+	UnmarshalPacketData(sourcePort string, bz []byte) (PacketData, error)
+	// SetMemo returns the packet data with the memo replaced
+	SetMemo(bz []byte, memo string) ([]byte, error)
+	// SetReceiver returns the packet data with the receiver replaced by the intermediate sender, so that the
+	// tokens sent with the packet can be forwarded to the contract. Apps that do not send fungible tokens
+	// should return the packet data unchanged
+	SetReceiver(bz []byte, receiver string) ([]byte, error)
+}
+
+// PacketDataRegistry holds the PacketDataUnmarshaler of each app the hooks run for, keyed by the app version of
+// the channel, the port the app is bound to, or the prefix of the ports the app is bound to
+type PacketDataRegistry struct {
+	byVersion map[string]PacketDataUnmarshaler
+	byPort    map[string]PacketDataUnmarshaler
+	// byPortPrefix is sorted from the longest prefix to the shortest, so that the most specific prefix matches first
+	byPortPrefix []portPrefixUnmarshaler
+}
+
+// portPrefixUnmarshaler is the unmarshaler registered for the ports with a prefix
+type portPrefixUnmarshaler struct {
+	prefix      string
+	unmarshaler PacketDataUnmarshaler
+}
+
+// NewPacketDataRegistry returns an empty registry
+func NewPacketDataRegistry() *PacketDataRegistry {
+	return &PacketDataRegistry{
+		byVersion: make(map[string]PacketDataUnmarshaler),
+		byPort:    make(map[string]PacketDataUnmarshaler),
+	}
+}
+
+// DefaultPacketDataRegistry returns a registry with the ICS-20 transfer, interchain accounts and ICS-721 NFT
+// transfer apps registered
+func DefaultPacketDataRegistry() *PacketDataRegistry {
+	registry := NewPacketDataRegistry()
+
+	registry.RegisterVersion(transfertypes.V1, ICS20PacketDataUnmarshaler{})
+	registry.RegisterPort(transfertypes.PortID, ICS20PacketDataUnmarshaler{})
+
+	registry.RegisterPort(icatypes.HostPortID, ICAPacketDataUnmarshaler{})
+	registry.RegisterPortPrefix(icatypes.ControllerPortPrefix, ICAPacketDataUnmarshaler{})
+
+	registry.RegisterVersion(types.ICS721Version, ICS721PacketDataUnmarshaler{})
+	registry.RegisterPort(types.ICS721PortID, ICS721PacketDataUnmarshaler{})
+
+	return registry
+}
+
+// RegisterVersion registers the unmarshaler for the packets of channels with the given app version
+func (r *PacketDataRegistry) RegisterVersion(version string, unmarshaler PacketDataUnmarshaler) {
+	r.byVersion[version] = unmarshaler
+}
+
+// RegisterPort registers the unmarshaler for the packets of the app bound to the given port
+func (r *PacketDataRegistry) RegisterPort(portID string, unmarshaler PacketDataUnmarshaler) {
+	r.byPort[portID] = unmarshaler
+}
+
+// RegisterPortPrefix registers the unmarshaler for the packets of the apps bound to ports with the given prefix.
+// When several prefixes match a port, the longest one is used
+func (r *PacketDataRegistry) RegisterPortPrefix(portPrefix string, unmarshaler PacketDataUnmarshaler) {
+	for i, registered := range r.byPortPrefix {
+		if registered.prefix == portPrefix {
+			r.byPortPrefix[i].unmarshaler = unmarshaler
+			return
+		}
+	}
+
+	r.byPortPrefix = append(r.byPortPrefix, portPrefixUnmarshaler{prefix: portPrefix, unmarshaler: unmarshaler})
+	sort.SliceStable(r.byPortPrefix, func(i, j int) bool {
+		return len(r.byPortPrefix[i].prefix) > len(r.byPortPrefix[j].prefix)
+	})
+}
+
+// GetUnmarshaler returns the unmarshaler for the packets of the local app bound to the port. The app version of
+// the channel is checked first, as it identifies the app regardless of the port it is bound to, and can be left
+// empty when it is not known
+func (r *PacketDataRegistry) GetUnmarshaler(portID, version string) (PacketDataUnmarshaler, bool) {
+	if unmarshaler, ok := r.byVersion[version]; ok && version != "" {
+		return unmarshaler, true
+	}
+	if unmarshaler, ok := r.byPort[portID]; ok {
+		return unmarshaler, true
+	}
+	for _, registered := range r.byPortPrefix {
+		if strings.HasPrefix(portID, registered.prefix) {
+			return registered.unmarshaler, true
+		}
+	}
+	return nil, false
+}
+
+// ICS20PacketDataUnmarshaler decodes ICS-20 v1 transfer packets
+type ICS20PacketDataUnmarshaler struct{}
+
+func (ICS20PacketDataUnmarshaler) UnmarshalPacketData(_ string, bz []byte) (PacketData, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return PacketData{}, err
+	}
+	if data.Receiver == "" {
+		return PacketData{}, errors.New("ICS20 packet receiver cannot be empty")
+	}
+
+	return PacketData{
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+		Tokens: []transfertypes.Token{{
+			Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+			Amount: data.Amount,
+		}},
+	}, nil
+}
+
+func (ICS20PacketDataUnmarshaler) SetMemo(bz []byte, memo string) ([]byte, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return nil, err
+	}
+	data.Memo = memo
+	return json.Marshal(data)
+}
+
+func (ICS20PacketDataUnmarshaler) SetReceiver(bz []byte, receiver string) ([]byte, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return nil, err
+	}
+	data.Receiver = receiver
+	return json.Marshal(data)
+}
+
+// ICAPacketDataUnmarshaler decodes interchain accounts packets. The sender is the owner of the interchain account,
+// taken from the controller port, and there is no receiver, so the ack of the host is not wrapped in a contract ack
+type ICAPacketDataUnmarshaler struct{}
+
+func (ICAPacketDataUnmarshaler) UnmarshalPacketData(sourcePort string, bz []byte) (PacketData, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return PacketData{}, err
+	}
+
+	return PacketData{
+		Sender: data.GetPacketSender(sourcePort),
+		Memo:   data.Memo,
+	}, nil
+}
+
+func (ICAPacketDataUnmarshaler) SetMemo(bz []byte, memo string) ([]byte, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, err
+	}
+	data.Memo = memo
+	return icatypes.ModuleCdc.MarshalJSON(&data)
+}
+
+func (ICAPacketDataUnmarshaler) SetReceiver(bz []byte, _ string) ([]byte, error) {
+	return bz, nil
+}
+
+// ICS721PacketDataUnmarshaler decodes ICS-721 NFT transfer packets. The NFTs are not redirected to the intermediate
+// sender, so they are received directly by the contract, which must be the receiver of the packet
+type ICS721PacketDataUnmarshaler struct{}
+
+func (ICS721PacketDataUnmarshaler) UnmarshalPacketData(_ string, bz []byte) (PacketData, error) {
+	var data types.NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return PacketData{}, err
+	}
+	if data.Receiver == "" {
+		return PacketData{}, errors.New("ICS721 packet receiver cannot be empty")
+	}
+
+	return PacketData{
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+	}, nil
+}
+
+func (ICS721PacketDataUnmarshaler) SetMemo(bz []byte, memo string) ([]byte, error) {
+	var data types.NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return nil, err
+	}
+	data.Memo = memo
+	return json.Marshal(data)
+}
+
+func (ICS721PacketDataUnmarshaler) SetReceiver(bz []byte, _ string) ([]byte, error) {
+	return bz, nil
+}
@@ -0,0 +1,199 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/tests/unit/mocks"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+// sendADR8CallbackPacket sends a transfer with an ADR-8 src_callback memo through the ICS4 middleware
+func (suite *HooksTestSuite) sendADR8CallbackPacket(wasmHooks ibc_hooks.WasmHooks, callback string) uint64 {
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, wasmHooks)
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "stake",
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.CounterContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"src_callback": %s}`, callback),
+	}.GetBytes()
+
+	seq, err := ics4Middleware.SendPacket(suite.Ctx, "transfer", "channel-0", ibcclienttypes.NewHeight(1, 1), 1, data)
+	suite.Require().NoError(err)
+	return seq
+}
+
+func (suite *HooksTestSuite) enableADR8Callbacks() {
+	params := types.DefaultParams()
+	params.Adr8CallbacksEnabled = true
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *HooksTestSuite) TestADR8CallbackDisabled() {
+	suite.SetupEnv()
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	seq := suite.sendADR8CallbackPacket(wasmHooks, fmt.Sprintf(`{"address": %q}`, suite.CounterContractAddr.String()))
+
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+}
+
+func (suite *HooksTestSuite) TestADR8CallbackAck() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	seq := suite.sendADR8CallbackPacket(wasmHooks, fmt.Sprintf(`{"address": %q, "gas_limit": "500000"}`, suite.CounterContractAddr.String()))
+
+	suite.Require().Equal(suite.CounterContractAddr.String(), suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+	suite.Require().Equal(uint64(500_000), suite.App.IBCHooksKeeper.GetPacketCallbackGasLimit(suite.Ctx, "channel-0", seq))
+
+	// The ack is dispatched to the same ibc_lifecycle_complete entrypoint as ibc_callback
+	err := wasmHooks.SendAckCallback(suite.Ctx, "channel-0", seq, ibcmock.MockAcknowledgement.Acknowledgement(), true)
+	suite.Require().NoError(err)
+
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, suite.CounterContractAddr.String())),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":1}`, string(count))
+
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+	suite.Require().Zero(suite.App.IBCHooksKeeper.GetPacketCallbackGasLimit(suite.Ctx, "channel-0", seq))
+}
+
+func (suite *HooksTestSuite) TestADR8CallbackGasLimit() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	seq := suite.sendADR8CallbackPacket(wasmHooks, fmt.Sprintf(`{"address": %q, "gas_limit": "%d"}`, suite.CounterContractAddr.String(), tinyGasLimit))
+
+	// The gas limit of the memo is lower than the param, so the callback runs out of gas and is queued
+	err := wasmHooks.SendTimeoutCallback(suite.Ctx, "channel-0", seq)
+	suite.Require().NoError(err)
+	suite.requireNoCounter(suite.CounterContractAddr.String())
+	suite.Require().True(suite.eventEmitted("ibc-timeout-callback-error"))
+}
+
+func (suite *HooksTestSuite) TestADR8CallbackInvalid() {
+	testCases := []struct {
+		name     string
+		callback string
+	}{
+		{"not an object", `"cosmos1"`},
+		{"invalid address", `{"address": "contract"}`},
+		{"gas limit not a string", fmt.Sprintf(`{"address": %q, "gas_limit": 100}`, "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr")},
+		{"invalid gas limit", fmt.Sprintf(`{"address": %q, "gas_limit": "-1"}`, "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupEnv()
+			suite.enableADR8Callbacks()
+
+			wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+			seq := suite.sendADR8CallbackPacket(wasmHooks, tc.callback)
+
+			// The callback is ignored, and the packet sent
+			suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+		})
+	}
+}
+
+// destinationCallbackError returns the error of the ibc-dest-callback-error event, if any was emitted
+func (suite *HooksTestSuite) destinationCallbackError() (string, bool) {
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type != "ibc-dest-callback-error" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "error" {
+				return attr.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (suite *HooksTestSuite) TestADR8DestinationCallbackDisabled() {
+	suite.SetupEnv()
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(`{"dest_callback": {"address": %q}}`, suite.CounterContractAddr.String()))
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	_, found := suite.destinationCallbackError()
+	suite.Require().False(found, "the callback should not have been sent")
+}
+
+func (suite *HooksTestSuite) TestADR8DestinationCallback() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+
+	// The counter contract does not handle the ibc_recv sudo message, so the callback is sent but fails
+	ack := suite.recvEchoPacket(fmt.Sprintf(`{"dest_callback": {"address": %q}}`, suite.CounterContractAddr.String()))
+	suite.Require().True(ack.Success(), "a failed destination callback should not fail the packet")
+
+	callbackErr, found := suite.destinationCallbackError()
+	suite.Require().True(found)
+	suite.Require().Contains(callbackErr, "ibc_recv")
+	suite.requireNoCounter(suite.CounterContractAddr.String())
+}
+
+func (suite *HooksTestSuite) TestADR8DestinationCallbackGasLimit() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(`{"dest_callback": {"address": %q, "gas_limit": "%d"}}`, suite.CounterContractAddr.String(), tinyGasLimit))
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	callbackErr, found := suite.destinationCallbackError()
+	suite.Require().True(found)
+	suite.Require().Contains(callbackErr, "out of gas")
+}
+
+func (suite *HooksTestSuite) TestADR8DestinationCallbackNotAllowed() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+
+	params := suite.App.IBCHooksKeeper.GetParams(suite.Ctx)
+	params.DeniedContracts = []string{suite.CounterContractAddr.String()}
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(`{"dest_callback": {"address": %q}}`, suite.CounterContractAddr.String()))
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	callbackErr, found := suite.destinationCallbackError()
+	suite.Require().True(found)
+	suite.Require().Contains(callbackErr, "cannot be the target of a hook")
+}
+
+func (suite *HooksTestSuite) TestADR8DestinationCallbackV2() {
+	suite.SetupEnv()
+	suite.enableADR8Callbacks()
+	suite.fundV2Escrow()
+
+	payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+		Denom:    fmt.Sprintf("transfer/%s/stake", v2SourceClient),
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.EchoContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"dest_callback": {"address": %q}}`, suite.CounterContractAddr.String()),
+	})
+
+	res := suite.newV2Middleware().OnRecvPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, payload, suite.TestAddress.GetAddress())
+	suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+
+	callbackErr, found := suite.destinationCallbackError()
+	suite.Require().True(found)
+	suite.Require().Contains(callbackErr, "ibc_recv")
+}
@@ -0,0 +1,104 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// recvEchoPacket receives a transfer to the echo contract with the given wasm memo
+func (suite *HooksTestSuite) recvEchoPacket(wasmMemo string) ibcexported.Acknowledgement {
+	recvPacket := channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    "transfer/channel-0/stake",
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: suite.EchoContractAddr.String(),
+			Memo:     wasmMemo,
+		}.GetBytes(),
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+	}
+
+	// send funds to the escrow address to simulate a transfer from the ibc module
+	escrowAddress := transfertypes.GetEscrowAddress(recvPacket.GetDestPort(), recvPacket.GetDestChannel())
+	testEscrowAmount := sdk.NewInt64Coin("stake", 2)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	suite.App.TransferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	ics4Middleware := ibc_hooks.NewICS4Middleware(suite.App.IBCKeeper.ChannelKeeper, wasmHooks)
+	ibcmiddleware := ibc_hooks.NewIBCMiddleware(ibctransfer.NewIBCModule(suite.App.TransferKeeper), &ics4Middleware)
+
+	return ibcmiddleware.OnRecvPacket(suite.Ctx, transfertypes.V1, recvPacket, suite.TestAddress.GetAddress())
+}
+
+func (suite *HooksTestSuite) TestContractAcknowledgementVersion1() {
+	suite.SetupEnv()
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(
+		`{"wasm":{"contract": "%s", "msg":{"echo":{"msg":"test"}}, "ack_version": "%s"}}`,
+		suite.EchoContractAddr.String(), types.ContractAckVersion1,
+	))
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	contractAck, err := types.DecodeContractAcknowledgement(ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ContractAckVersion1, contractAck.Version)
+	suite.Require().Equal(suite.EchoContractAddr.String(), contractAck.Contract)
+	suite.Require().NotZero(contractAck.GasUsed)
+	suite.Require().Equal("this should echo", string(contractAck.ContractResult))
+
+	// The events are the ones of the contract execution only, not the ones of the transfer
+	var eventTypes []string
+	for _, event := range contractAck.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Require().Contains(eventTypes, "wasm")
+	suite.Require().NotContains(eventTypes, transfertypes.EventTypePacket)
+
+	innerAck, err := contractAck.InnerAcknowledgement()
+	suite.Require().NoError(err)
+	suite.Require().True(innerAck.Success())
+	suite.Require().Equal([]byte{byte(1)}, innerAck.GetResult())
+}
+
+func (suite *HooksTestSuite) TestContractAcknowledgementUnversioned() {
+	suite.SetupEnv()
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"echo":{"msg":"test"}}}}`, suite.EchoContractAddr.String()))
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// The unversioned ack can be decoded with the same helpers, without the new fields
+	contractAck, err := types.DecodeContractAcknowledgement(ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Empty(contractAck.Version)
+	suite.Require().Empty(contractAck.Contract)
+	suite.Require().Equal("this should echo", string(contractAck.ContractResult))
+
+	innerAck, err := contractAck.InnerAcknowledgement()
+	suite.Require().NoError(err)
+	suite.Require().True(innerAck.Success())
+}
+
+func (suite *HooksTestSuite) TestContractAcknowledgementUnsupportedVersion() {
+	suite.SetupEnv()
+
+	ack := suite.recvEchoPacket(fmt.Sprintf(
+		`{"wasm":{"contract": "%s", "msg":{"echo":{"msg":"test"}}, "ack_version": "ibchooks-ack-99"}}`,
+		suite.EchoContractAddr.String(),
+	))
+	suite.Require().False(ack.Success())
+
+	_, err := types.DecodeContractAcknowledgement(ack.Acknowledgement())
+	suite.Require().ErrorContains(err, "error acknowledgement")
+}
@@ -0,0 +1,86 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+// tinyGasLimit is too low for any contract call to complete
+const tinyGasLimit = 1_000
+
+// requireNoCounter asserts that the counter contract has no state for the address, i.e. the callback was not applied
+func (suite *HooksTestSuite) requireNoCounter(addr string) {
+	_, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, addr)),
+	)
+	suite.Require().ErrorContains(err, "not found")
+}
+
+func (suite *HooksTestSuite) TestRecvGasLimitReturnsErrorAck() {
+	suite.SetupEnv()
+	suite.fundV2Escrow()
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, types.NewParams(true, tinyGasLimit, 0, 0))
+
+	payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+		Denom:    fmt.Sprintf("transfer/%s/stake", v2SourceClient),
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.CounterContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+	})
+
+	res := suite.newV2Middleware().OnRecvPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, payload, suite.TestAddress.GetAddress())
+	suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+	suite.Require().True(ibc_hooks.IsJsonAckError(res.Acknowledgement))
+}
+
+func (suite *HooksTestSuite) TestAckCallbackGasLimitQueuesCallback() {
+	suite.SetupEnv()
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, types.NewParams(true, 0, tinyGasLimit, 0))
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	suite.App.IBCHooksKeeper.StorePacketCallback(suite.Ctx, "channel-0", 1, suite.CounterContractAddr.String())
+
+	err := wasmHooks.SendAckCallback(suite.Ctx, "channel-0", 1, ibcmock.MockAcknowledgement.Acknowledgement(), true)
+	suite.Require().NoError(err, "running out of gas should not fail the acknowledgement")
+
+	// The contract state should not have been updated, and the callback should be queued
+	suite.requireNoCounter(suite.CounterContractAddr.String())
+
+	pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Contains(pending.LastError, "out of gas")
+	suite.Require().True(suite.eventEmitted(types.EventTypeAckCallbackQueued))
+}
+
+func (suite *HooksTestSuite) TestTimeoutCallbackGasLimitEmitsEvent() {
+	suite.SetupEnv()
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, types.NewParams(true, 0, 0, tinyGasLimit))
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	suite.App.IBCHooksKeeper.StorePacketCallback(suite.Ctx, "channel-0", 1, suite.CounterContractAddr.String())
+
+	err := wasmHooks.SendTimeoutCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().NoError(err, "running out of gas should not fail the timeout")
+
+	suite.requireNoCounter(suite.CounterContractAddr.String())
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", 1))
+	suite.Require().True(suite.eventEmitted("ibc-timeout-callback-error"))
+}
+
+func (suite *HooksTestSuite) eventEmitted(eventType string) bool {
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/tests/unit/mocks"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+// auditHooks records the hooks it runs in a log shared with the other hooks of a chain
+type auditHooks struct {
+	name string
+	log  *[]string
+}
+
+func (h auditHooks) OnRecvPacketBeforeHook(_ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) {
+	*h.log = append(*h.log, h.name+" before recv")
+}
+
+func (h auditHooks) OnRecvPacketAfterHook(_ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress, ack ibcexported.Acknowledgement) {
+	*h.log = append(*h.log, fmt.Sprintf("%s after recv success=%t", h.name, ack.Success()))
+}
+
+func (h auditHooks) SendPacketBeforeHook(_ sdk.Context, _ string, _ string, _ ibcclienttypes.Height, _ uint64, _ []byte) {
+	*h.log = append(*h.log, h.name+" before send")
+}
+
+func (h auditHooks) SendPacketAfterHook(_ sdk.Context, _ string, _ string, _ ibcclienttypes.Height, _ uint64, _ []byte, err error) {
+	*h.log = append(*h.log, fmt.Sprintf("%s after send err=%v", h.name, err))
+}
+
+// overrideHooks overrides the recv of packets, recording it in the log shared with the other hooks of a chain
+type overrideHooks struct {
+	name string
+	log  *[]string
+}
+
+func (h overrideHooks) OnRecvPacketOverride(_ ibc_hooks.IBCMiddleware, _ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	*h.log = append(*h.log, h.name+" override recv")
+	return channeltypes.NewResultAcknowledgement([]byte(h.name))
+}
+
+// newHookChainMiddleware wraps a mock app with the hook chain. The packets received by the app are recorded in the log
+func newHookChainMiddleware(chain ibc_hooks.HookChain, log *[]string) ibc_hooks.IBCMiddleware {
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, chain)
+
+	mockApp := ibcmock.NewIBCApp(ibcmock.PortID)
+	mockApp.OnRecvPacket = func(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+		*log = append(*log, "app recv")
+		return ibcmock.MockAcknowledgement
+	}
+	appModule := ibcmock.NewAppModule()
+	return ibc_hooks.NewIBCMiddleware(ibcmock.NewIBCModule(&appModule, mockApp), &ics4Middleware)
+}
+
+func (suite *HooksTestSuite) TestHookChainBeforeAfterOrder() {
+	suite.SetupEnv()
+
+	var log []string
+	chain := ibc_hooks.NewHookChain(
+		ibc_hooks.ChainedHooks{Hooks: auditHooks{name: "first", log: &log}, OverridePriority: 0},
+		ibc_hooks.ChainedHooks{Hooks: auditHooks{name: "second", log: &log}, OverridePriority: 1},
+	)
+	middleware := newHookChainMiddleware(chain, &log)
+
+	ack := middleware.OnRecvPacket(suite.Ctx, ibcmock.Version, channeltypes.Packet{}, suite.TestAddress.GetAddress())
+	suite.Require().Equal(ibcmock.MockAcknowledgement, ack)
+	suite.Require().Equal([]string{
+		"first before recv",
+		"second before recv",
+		"app recv",
+		"first after recv success=true",
+		"second after recv success=true",
+	}, log)
+
+	// The ICS4 hooks are chained too
+	log = nil
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, chain)
+	seq, err := ics4Middleware.SendPacket(suite.Ctx, ibcmock.PortID, "channel-0", ibcclienttypes.NewHeight(1, 1), 1, []byte("data"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), seq)
+	suite.Require().Equal([]string{
+		"first before send",
+		"second before send",
+		"first after send err=<nil>",
+		"second after send err=<nil>",
+	}, log)
+}
+
+func (suite *HooksTestSuite) TestHookChainOverridePriority() {
+	suite.SetupEnv()
+
+	var log []string
+	chain := ibc_hooks.NewHookChain(
+		ibc_hooks.ChainedHooks{Hooks: overrideHooks{name: "high", log: &log}, OverridePriority: 10},
+		ibc_hooks.ChainedHooks{Hooks: auditHooks{name: "audit", log: &log}, OverridePriority: 0},
+		ibc_hooks.ChainedHooks{Hooks: overrideHooks{name: "low", log: &log}, OverridePriority: -1},
+	)
+	middleware := newHookChainMiddleware(chain, &log)
+
+	// Only the override with the highest priority runs, instead of the app, and the other hooks still run
+	ack := middleware.OnRecvPacket(suite.Ctx, ibcmock.Version, channeltypes.Packet{}, suite.TestAddress.GetAddress())
+	suite.Require().Equal([]byte(`{"result":"aGlnaA=="}`), ack.Acknowledgement())
+	suite.Require().Equal([]string{
+		"audit before recv",
+		"high override recv",
+		"audit after recv success=true",
+	}, log)
+}
+
+func (suite *HooksTestSuite) TestHookChainDuplicatePriority() {
+	var log []string
+	suite.Require().Panics(func() {
+		ibc_hooks.NewHookChain(
+			ibc_hooks.ChainedHooks{Hooks: overrideHooks{name: "first", log: &log}, OverridePriority: 1},
+			ibc_hooks.ChainedHooks{Hooks: overrideHooks{name: "second", log: &log}, OverridePriority: 1},
+		)
+	})
+
+	// Members that do not override the same callback can share a priority
+	suite.Require().NotPanics(func() {
+		ibc_hooks.NewHookChain(
+			ibc_hooks.ChainedHooks{Hooks: overrideHooks{name: "first", log: &log}, OverridePriority: 1},
+			ibc_hooks.ChainedHooks{Hooks: auditHooks{name: "second", log: &log}, OverridePriority: 1},
+		)
+	})
+}
+
+func (suite *HooksTestSuite) TestHookChainWithWasmHooks() {
+	suite.SetupEnv()
+
+	var log []string
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	// The audit hooks have no overrides, so they can use the default priority along with the wasm hooks
+	chain := ibc_hooks.NewHookChain(
+		ibc_hooks.ChainedHooks{Hooks: wasmHooks},
+		ibc_hooks.ChainedHooks{Hooks: auditHooks{name: "audit", log: &log}},
+	)
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, chain)
+
+	mockApp := ibcmock.NewIBCApp(icatypes.HostPortID)
+	mockApp.OnRecvPacket = func(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+		log = append(log, "app recv")
+		return ibcmock.MockAcknowledgement
+	}
+	appModule := ibcmock.NewAppModule()
+	middleware := ibc_hooks.NewIBCMiddleware(ibcmock.NewIBCModule(&appModule, mockApp), &ics4Middleware)
+
+	owner := suite.TestAddress.GetAddress().String()
+	packet := channeltypes.Packet{
+		Data: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("tx"),
+			Memo: fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+		}.GetBytes(),
+		Sequence:           1,
+		SourcePort:         icatypes.ControllerPortPrefix + owner,
+		SourceChannel:      "channel-0",
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: "channel-1",
+	}
+
+	ack := middleware.OnRecvPacket(suite.Ctx, icatypes.NewDefaultMetadataString("connection-0", "connection-1"), packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// The wasm hooks override the recv and call the contract, and the audit hooks run around it
+	suite.Require().Equal([]string{
+		"audit before recv",
+		"app recv",
+		"audit after recv success=true",
+	}, log)
+
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", owner, "cosmos")
+	suite.Require().NoError(err)
+	suite.requireCounterIncremented(senderBech32)
+}
@@ -0,0 +1,171 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+var msgRouterRecipient = sdk.AccAddress([]byte("recipient___________"))
+
+// newMsgRouterMiddleware wraps the transfer module with the msg router hooks
+func (suite *HooksTestSuite) newMsgRouterMiddleware() ibc_hooks.IBCMiddleware {
+	hooks := ibc_hooks.NewMsgRouterHooks(&suite.App.IBCHooksKeeper, suite.App.MsgServiceRouter(), suite.App.AppCodec(), "cosmos")
+	ics4Middleware := ibc_hooks.NewICS4Middleware(suite.App.IBCKeeper.ChannelKeeper, hooks)
+	return ibc_hooks.NewIBCMiddleware(ibctransfer.NewIBCModule(suite.App.TransferKeeper), &ics4Middleware)
+}
+
+// newMsgRouterPacket returns a transfer packet returning stake to this chain, with the given memo
+func (suite *HooksTestSuite) newMsgRouterPacket(memo string) channeltypes.Packet {
+	// send funds to the escrow address to simulate a transfer from the ibc module
+	escrowAddress := transfertypes.GetEscrowAddress("transfer", "channel-1")
+	testEscrowAmount := sdk.NewInt64Coin("stake", 2)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.Require().NoError(err)
+	suite.App.TransferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+
+	return channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    "transfer/channel-0/stake",
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: msgRouterRecipient.String(),
+			Memo:     memo,
+		}.GetBytes(),
+		Sequence:           1,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+}
+
+// msgSendMemo returns a memo with a MsgSend of 1stake to the recipient
+func msgSendMemo(fromAddress string) string {
+	return fmt.Sprintf(`{"msg": {"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": %q, "to_address": %q, "amount": [{"denom": "stake", "amount": "1"}]}}`,
+		fromAddress, msgRouterRecipient.String())
+}
+
+func (suite *HooksTestSuite) allowMsgSend() {
+	params := types.DefaultParams()
+	params.AllowedMsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *HooksTestSuite) TestMsgRouterHooks() {
+	suite.SetupEnv()
+	suite.allowMsgSend()
+
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", suite.TestAddress.GetAddress().String(), "cosmos")
+	suite.Require().NoError(err)
+
+	packet := suite.newMsgRouterPacket(msgSendMemo(senderBech32))
+	ack := suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// The funds were received by the intermediate sender, which sent them to the recipient
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 1), suite.App.BankKeeper.GetBalance(suite.Ctx, msgRouterRecipient, "stake"))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32(senderBech32), "stake").IsZero())
+}
+
+func (suite *HooksTestSuite) TestMsgRouterHooksAckVersion() {
+	suite.SetupEnv()
+	suite.allowMsgSend()
+
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", suite.TestAddress.GetAddress().String(), "cosmos")
+	suite.Require().NoError(err)
+
+	// Without a version, the ack is the unversioned MsgAck
+	packet := suite.newMsgRouterPacket(msgSendMemo(senderBech32))
+	ack := suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	var channelAck channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &channelAck))
+	var msgAck ibc_hooks.MsgAck
+	suite.Require().NoError(json.Unmarshal(channelAck.GetResult(), &msgAck))
+	suite.Require().NotEmpty(msgAck.IbcAck)
+
+	// The versioned ContractAcknowledgement can be requested next to the msg key
+	memo := strings.TrimSuffix(msgSendMemo(senderBech32), "}") + fmt.Sprintf(`, "ack_version": %q}`, types.ContractAckVersion1)
+	packet = suite.newMsgRouterPacket(memo)
+	ack = suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	contractAck, err := types.DecodeContractAcknowledgement(ack.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ContractAckVersion1, contractAck.Version)
+	suite.Require().Empty(contractAck.Contract)
+	suite.Require().NotZero(contractAck.GasUsed)
+	suite.Require().NotEmpty(contractAck.Events)
+
+	innerAck, err := contractAck.InnerAcknowledgement()
+	suite.Require().NoError(err)
+	suite.Require().True(innerAck.Success())
+
+	// Unsupported versions receive an error ack, without executing the message
+	memo = strings.TrimSuffix(msgSendMemo(senderBech32), "}") + `, "ack_version": "ibchooks-ack-99"}`
+	packet = suite.newMsgRouterPacket(memo)
+	ack = suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 2), suite.App.BankKeeper.GetBalance(suite.Ctx, msgRouterRecipient, "stake"))
+}
+
+func (suite *HooksTestSuite) TestMsgRouterHooksErrors() {
+	testCases := []struct {
+		name       string
+		allowSend  bool
+		fromSender bool
+		memo       string
+	}{
+		{name: "message type not allowed", fromSender: true},
+		{name: "signer is not the intermediate sender", allowSend: true},
+		{name: "msg is not an object", allowSend: true, memo: `{"msg": "send"}`},
+		{name: "unknown message type", allowSend: true, memo: `{"msg": {"@type": "/unknown.MsgUnknown"}}`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupEnv()
+			if tc.allowSend {
+				suite.allowMsgSend()
+			}
+
+			senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", suite.TestAddress.GetAddress().String(), "cosmos")
+			suite.Require().NoError(err)
+
+			memo := tc.memo
+			if memo == "" && tc.fromSender {
+				memo = msgSendMemo(senderBech32)
+			} else if memo == "" {
+				memo = msgSendMemo(suite.TestAddress.GetAddress().String())
+			}
+
+			packet := suite.newMsgRouterPacket(memo)
+			ack := suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+			suite.Require().False(ack.Success())
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, msgRouterRecipient, "stake").IsZero())
+		})
+	}
+}
+
+func (suite *HooksTestSuite) TestMsgRouterHooksWithoutMsg() {
+	suite.SetupEnv()
+
+	// Packets without the msg key are regular transfers
+	packet := suite.newMsgRouterPacket("")
+	ack := suite.newMsgRouterMiddleware().OnRecvPacket(suite.Ctx, transfertypes.V1, packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 1), suite.App.BankKeeper.GetBalance(suite.Ctx, msgRouterRecipient, "stake"))
+}
@@ -0,0 +1,244 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/tests/unit/mocks"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+// newMockAppMiddleware wraps a mock app with the wasm hooks. The packets received by the app are recorded
+func (suite *HooksTestSuite) newMockAppMiddleware(portID string, received *[]channeltypes.Packet) ibc_hooks.IBCMiddleware {
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, wasmHooks)
+
+	mockApp := ibcmock.NewIBCApp(portID)
+	mockApp.OnRecvPacket = func(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+		*received = append(*received, packet)
+		return ibcmock.MockAcknowledgement
+	}
+	appModule := ibcmock.NewAppModule()
+	return ibc_hooks.NewIBCMiddleware(ibcmock.NewIBCModule(&appModule, mockApp), &ics4Middleware)
+}
+
+// requireCounterIncremented asserts that the counter contract has been called by the address
+func (suite *HooksTestSuite) requireCounterIncremented(addr string) {
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, addr)),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":0}`, string(count))
+}
+
+func (suite *HooksTestSuite) TestPacketDataRegistry() {
+	registry := ibc_hooks.DefaultPacketDataRegistry()
+
+	testCases := []struct {
+		name     string
+		portID   string
+		version  string
+		expected ibc_hooks.PacketDataUnmarshaler
+	}{
+		{"transfer by version", "custom-transfer", transfertypes.V1, ibc_hooks.ICS20PacketDataUnmarshaler{}},
+		{"transfer by port", transfertypes.PortID, "", ibc_hooks.ICS20PacketDataUnmarshaler{}},
+		{"ica host", icatypes.HostPortID, `{"version":"ics27-1"}`, ibc_hooks.ICAPacketDataUnmarshaler{}},
+		{"ica controller", icatypes.ControllerPortPrefix + "owner", "", ibc_hooks.ICAPacketDataUnmarshaler{}},
+		{"nft transfer by version", "wasm.contract", types.ICS721Version, ibc_hooks.ICS721PacketDataUnmarshaler{}},
+		{"nft transfer by port", types.ICS721PortID, "", ibc_hooks.ICS721PacketDataUnmarshaler{}},
+		{"unknown app", "mock", "mock-version", nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			unmarshaler, found := registry.GetUnmarshaler(tc.portID, tc.version)
+			suite.Require().Equal(tc.expected != nil, found)
+			suite.Require().Equal(tc.expected, unmarshaler)
+		})
+	}
+
+	// Other apps can be registered
+	registry.RegisterPort("mock", ibc_hooks.ICS20PacketDataUnmarshaler{})
+	_, found := registry.GetUnmarshaler("mock", "mock-version")
+	suite.Require().True(found)
+
+	// The longest matching port prefix is used, regardless of the order the prefixes were registered in
+	registry.RegisterPortPrefix("wasm.", ibc_hooks.ICS20PacketDataUnmarshaler{})
+	registry.RegisterPortPrefix("wasm.nft", ibc_hooks.ICS721PacketDataUnmarshaler{})
+	registry.RegisterPortPrefix("w", ibc_hooks.ICAPacketDataUnmarshaler{})
+	for i := 0; i < 10; i++ {
+		unmarshaler, found := registry.GetUnmarshaler("wasm.nft-contract", "")
+		suite.Require().True(found)
+		suite.Require().Equal(ibc_hooks.ICS721PacketDataUnmarshaler{}, unmarshaler)
+	}
+
+	unmarshaler, found := registry.GetUnmarshaler("wasm.contract", "")
+	suite.Require().True(found)
+	suite.Require().Equal(ibc_hooks.ICS20PacketDataUnmarshaler{}, unmarshaler)
+
+	// Registering a prefix again replaces its unmarshaler
+	registry.RegisterPortPrefix("wasm.", ibc_hooks.ICAPacketDataUnmarshaler{})
+	unmarshaler, found = registry.GetUnmarshaler("wasm.contract", "")
+	suite.Require().True(found)
+	suite.Require().Equal(ibc_hooks.ICAPacketDataUnmarshaler{}, unmarshaler)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketICA() {
+	suite.SetupEnv()
+
+	var received []channeltypes.Packet
+	middleware := suite.newMockAppMiddleware(icatypes.HostPortID, &received)
+
+	owner := suite.TestAddress.GetAddress().String()
+	packet := channeltypes.Packet{
+		Data: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("tx"),
+			// There is no receiver, so any contract can be called
+			Memo: fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+		}.GetBytes(),
+		Sequence:           1,
+		SourcePort:         icatypes.ControllerPortPrefix + owner,
+		SourceChannel:      "channel-0",
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: "channel-1",
+	}
+
+	ack := middleware.OnRecvPacket(suite.Ctx, icatypes.NewDefaultMetadataString("connection-0", "connection-1"), packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success())
+
+	// The ack of the host is not wrapped in a contract ack, so the controller can still decode it
+	suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), ack.Acknowledgement())
+
+	// The packet is passed unchanged to the app
+	suite.Require().Len(received, 1)
+	suite.Require().Equal(packet.Data, received[0].Data)
+
+	// The contract is called by the intermediate sender of the owner of the interchain account
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", owner, "cosmos")
+	suite.Require().NoError(err)
+	suite.requireCounterIncremented(senderBech32)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketICAAckVersion() {
+	suite.SetupEnv()
+
+	var received []channeltypes.Packet
+	middleware := suite.newMockAppMiddleware(icatypes.HostPortID, &received)
+
+	packet := channeltypes.Packet{
+		Data: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("tx"),
+			Memo: fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}, "ack_version": %q}}`, suite.CounterContractAddr.String(), types.ContractAckVersion1),
+		}.GetBytes(),
+		Sequence:           1,
+		SourcePort:         icatypes.ControllerPortPrefix + suite.TestAddress.GetAddress().String(),
+		SourceChannel:      "channel-0",
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: "channel-1",
+	}
+
+	// Packets without a receiver never get a contract ack, so they cannot request one
+	ack := middleware.OnRecvPacket(suite.Ctx, icatypes.NewDefaultMetadataString("connection-0", "connection-1"), packet, suite.TestAddress.GetAddress())
+	suite.Require().False(ack.Success())
+	suite.Require().Empty(received)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketNFT() {
+	suite.SetupEnv()
+
+	var received []channeltypes.Packet
+	middleware := suite.newMockAppMiddleware(types.ICS721PortID, &received)
+
+	sender := suite.TestAddress.GetAddress().String()
+	data := types.NonFungibleTokenPacketData{
+		ClassId:  "class",
+		TokenIds: []string{"1"},
+		Sender:   sender,
+		Receiver: suite.CounterContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+	}
+	bz, err := json.Marshal(data)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		Data:               bz,
+		Sequence:           1,
+		SourcePort:         types.ICS721PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.ICS721PortID,
+		DestinationChannel: "channel-1",
+	}
+
+	ack := middleware.OnRecvPacket(suite.Ctx, types.ICS721Version, packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success())
+
+	// The NFTs are not redirected to the intermediate sender, so the contract receives them
+	suite.Require().Len(received, 1)
+	suite.Require().Equal(packet.Data, received[0].Data)
+
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender("channel-1", sender, "cosmos")
+	suite.Require().NoError(err)
+	suite.requireCounterIncremented(senderBech32)
+}
+
+func (suite *HooksTestSuite) TestOnRecvPacketUnknownApp() {
+	suite.SetupEnv()
+
+	var received []channeltypes.Packet
+	middleware := suite.newMockAppMiddleware("mock", &received)
+
+	// The data would be a valid ICS20 packet, but the app is not registered
+	packet := channeltypes.Packet{
+		Data: transfertypes.FungibleTokenPacketData{
+			Denom:    "stake",
+			Amount:   "1",
+			Sender:   suite.TestAddress.GetAddress().String(),
+			Receiver: suite.CounterContractAddr.String(),
+			Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+		}.GetBytes(),
+		Sequence:           1,
+		SourcePort:         "mock",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "mock",
+		DestinationChannel: "channel-1",
+	}
+
+	ack := middleware.OnRecvPacket(suite.Ctx, "mock-version", packet, suite.TestAddress.GetAddress())
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), ack.Acknowledgement(), "the contract should not be called")
+	suite.Require().Len(received, 1)
+	suite.Require().Equal(packet.Data, received[0].Data)
+}
+
+func (suite *HooksTestSuite) TestSendPacketCallbackICA() {
+	suite.SetupEnv()
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, wasmHooks)
+
+	sourcePort := icatypes.ControllerPortPrefix + suite.TestAddress.GetAddress().String()
+	data := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("tx"),
+		Memo: fmt.Sprintf(`{"ibc_callback": "%s"}`, suite.CounterContractAddr),
+	}.GetBytes()
+
+	seq, err := ics4Middleware.SendPacket(suite.Ctx, sourcePort, "channel-0", ibcclienttypes.NewHeight(1, 1), 1, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.CounterContractAddr.String(), suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+}
@@ -0,0 +1,176 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/tests/unit/mocks"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+)
+
+// counterCodeID is the code ID of the counter contract, which is stored first in SetupEnv
+const counterCodeID = 1
+
+func (suite *HooksTestSuite) TestRecvPacketParams() {
+	testCases := []struct {
+		name          string
+		updateParams  func(params *types.Params)
+		expectSuccess bool
+	}{
+		{
+			name:          "default params",
+			updateParams:  func(params *types.Params) {},
+			expectSuccess: true,
+		},
+		{
+			name:         "hooks disabled",
+			updateParams: func(params *types.Params) { params.HooksEnabled = false },
+		},
+		{
+			name: "hooks disabled on the destination client",
+			updateParams: func(params *types.Params) {
+				params.DisabledChannels = []string{v2DestinationClient}
+			},
+		},
+		{
+			name: "hooks disabled on another client",
+			updateParams: func(params *types.Params) {
+				params.DisabledChannels = []string{v2SourceClient}
+			},
+			expectSuccess: true,
+		},
+		{
+			name: "contract allowed",
+			updateParams: func(params *types.Params) {
+				params.AllowedContracts = []string{suite.CounterContractAddr.String()}
+			},
+			expectSuccess: true,
+		},
+		{
+			name: "contract not in the allowlist",
+			updateParams: func(params *types.Params) {
+				params.AllowedContracts = []string{suite.EchoContractAddr.String()}
+			},
+		},
+		{
+			name: "code ID allowed",
+			updateParams: func(params *types.Params) {
+				params.AllowedCodeIds = []uint64{counterCodeID}
+			},
+			expectSuccess: true,
+		},
+		{
+			name: "contract denied",
+			updateParams: func(params *types.Params) {
+				params.DeniedContracts = []string{suite.CounterContractAddr.String()}
+			},
+		},
+		{
+			name: "code ID denied takes priority over the allowlist",
+			updateParams: func(params *types.Params) {
+				params.AllowedContracts = []string{suite.CounterContractAddr.String()}
+				params.DeniedCodeIds = []uint64{counterCodeID}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupEnv()
+			suite.fundV2Escrow()
+
+			params := types.DefaultParams()
+			tc.updateParams(&params)
+			suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+
+			payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+				Denom:    fmt.Sprintf("transfer/%s/stake", v2SourceClient),
+				Amount:   "1",
+				Sender:   suite.TestAddress.GetAddress().String(),
+				Receiver: suite.CounterContractAddr.String(),
+				Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+			})
+
+			res := suite.newV2Middleware().OnRecvPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, payload, suite.TestAddress.GetAddress())
+			if tc.expectSuccess {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+			} else {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+				suite.Require().True(ibc_hooks.IsJsonAckError(res.Acknowledgement))
+			}
+		})
+	}
+}
+
+func (suite *HooksTestSuite) TestSendPacketParams() {
+	testCases := []struct {
+		name         string
+		updateParams func(params *types.Params)
+		expectedErr  error
+	}{
+		{
+			name:         "default params",
+			updateParams: func(params *types.Params) {},
+		},
+		{
+			name:         "hooks disabled",
+			updateParams: func(params *types.Params) { params.HooksEnabled = false },
+			expectedErr:  types.ErrHooksDisabled,
+		},
+		{
+			name: "hooks disabled on the channel",
+			updateParams: func(params *types.Params) {
+				params.DisabledChannels = []string{"channel-0"}
+			},
+			expectedErr: types.ErrHooksDisabled,
+		},
+		{
+			name: "contract not in the allowlist",
+			updateParams: func(params *types.Params) {
+				params.AllowedCodeIds = []uint64{counterCodeID + 1}
+			},
+			expectedErr: types.ErrNotAllowed,
+		},
+		{
+			name: "contract denied",
+			updateParams: func(params *types.Params) {
+				params.DeniedContracts = []string{suite.CounterContractAddr.String()}
+			},
+			expectedErr: types.ErrNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupEnv()
+
+			params := types.DefaultParams()
+			tc.updateParams(&params)
+			suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+
+			wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+			ics4Middleware := ibc_hooks.NewICS4Middleware(&mocks.ICS4WrapperMock{}, wasmHooks)
+
+			data := transfertypes.FungibleTokenPacketData{
+				Denom:    "stake",
+				Amount:   "1",
+				Sender:   suite.TestAddress.GetAddress().String(),
+				Receiver: suite.CounterContractAddr.String(),
+				Memo:     fmt.Sprintf(`{"ibc_callback": "%s"}`, suite.CounterContractAddr),
+			}.GetBytes()
+
+			seq, err := ics4Middleware.SendPacket(suite.Ctx, "transfer", "channel-0", ibcclienttypes.NewHeight(1, 1), 1, data)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", 1))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.CounterContractAddr.String(), suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", seq))
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package tests_unit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+func (suite *HooksTestSuite) TestFailedAckCallbackIsQueued() {
+	suite.SetupEnv()
+
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+
+	// The echo contract does not implement sudo, so the callback will fail
+	suite.App.IBCHooksKeeper.StorePacketCallback(suite.Ctx, "channel-0", 1, suite.EchoContractAddr.String())
+
+	ack := ibcmock.MockAcknowledgement.Acknowledgement()
+	err := wasmHooks.SendAckCallback(suite.Ctx, "channel-0", 1, ack, true)
+	suite.Require().NoError(err, "a failed callback should not fail the acknowledgement")
+
+	// The callback should have been moved to the retry queue
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, "channel-0", 1))
+
+	pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.EchoContractAddr.String(), pending.Contract)
+	suite.Require().Equal(ack, pending.Ack)
+	suite.Require().True(pending.Success)
+	suite.Require().Equal(uint32(1), pending.Attempts)
+	suite.Require().NotEmpty(pending.LastError)
+	suite.Require().Zero(pending.GasLimit)
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerUsesMemoGasLimit() {
+	suite.SetupEnv()
+
+	// The gas limit requested in the memo is kept when the callback is queued
+	wasmHooks := ibc_hooks.NewWasmHooks(&suite.App.IBCHooksKeeper, &suite.App.WasmKeeper, "cosmos")
+	suite.App.IBCHooksKeeper.StorePacketCallback(suite.Ctx, "channel-0", 1, suite.EchoContractAddr.String())
+	suite.App.IBCHooksKeeper.SetPacketCallbackGasLimit(suite.Ctx, "channel-0", 1, tinyGasLimit)
+
+	err := wasmHooks.SendAckCallback(suite.Ctx, "channel-0", 1, ibcmock.MockAcknowledgement.Acknowledgement(), true)
+	suite.Require().NoError(err)
+
+	pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(tinyGasLimit), pending.GasLimit)
+
+	// The retry to the counter contract would succeed with the param, but runs out of the memo's gas limit
+	pending.Contract = suite.CounterContractAddr.String()
+	suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, pending)
+	suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+
+	pending, found = suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(2), pending.Attempts)
+	suite.Require().Contains(pending.LastError, "out of gas")
+	suite.requireNoCounter(suite.CounterContractAddr.String())
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerRetriesAckCallback() {
+	suite.SetupEnv()
+
+	suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, types.PendingAckCallback{
+		Channel:   "channel-0",
+		Sequence:  1,
+		Contract:  suite.CounterContractAddr.String(),
+		Ack:       ibcmock.MockAcknowledgement.Acknowledgement(),
+		Success:   true,
+		Attempts:  1,
+		LastError: "failed",
+	})
+
+	suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+
+	// The retry should have delivered the callback and removed it from the queue
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, suite.CounterContractAddr.String())),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"count":1}`, string(count))
+
+	_, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().False(found)
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerDropsAckCallbackAfterMaxAttempts() {
+	suite.SetupEnv()
+
+	// The echo contract does not implement sudo, so every retry fails
+	suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, types.PendingAckCallback{
+		Channel:   "channel-0",
+		Sequence:  1,
+		Contract:  suite.EchoContractAddr.String(),
+		Ack:       ibcmock.MockAcknowledgement.Acknowledgement(),
+		Success:   true,
+		Attempts:  1,
+		LastError: "failed",
+	})
+
+	maxAttempts := suite.App.IBCHooksKeeper.GetParams(suite.Ctx).MaxAckCallbackAttempts
+	for attempt := uint32(2); attempt < maxAttempts; attempt++ {
+		suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+
+		pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+		suite.Require().True(found, "callback should still be queued after attempt %d", attempt)
+		suite.Require().Equal(attempt, pending.Attempts)
+	}
+
+	// The last attempt should drop the callback
+	suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+	_, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().False(found)
+}
+
+// panickingContractKeeper is a contract keeper whose sudo calls panic with an error other than out of gas
+type panickingContractKeeper struct{}
+
+func (panickingContractKeeper) Sudo(sdk.Context, sdk.AccAddress, []byte) ([]byte, error) {
+	panic("contract panicked")
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerRecoversPanickingAckCallback() {
+	suite.SetupEnv()
+
+	suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, types.PendingAckCallback{
+		Channel:   "channel-0",
+		Sequence:  1,
+		Contract:  suite.CounterContractAddr.String(),
+		Ack:       ibcmock.MockAcknowledgement.Acknowledgement(),
+		Success:   true,
+		Attempts:  1,
+		LastError: "failed",
+	})
+
+	k := suite.App.IBCHooksKeeper
+	k.SetContractKeeper(panickingContractKeeper{})
+	suite.Require().NotPanics(func() { k.BeginBlocker(suite.Ctx) })
+
+	// The panic should have been counted as a failed attempt
+	pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(2), pending.Attempts)
+	suite.Require().Contains(pending.LastError, "ack callback panicked: contract panicked")
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerRetriesDisabled() {
+	suite.SetupEnv()
+
+	params := suite.App.IBCHooksKeeper.GetParams(suite.Ctx)
+	params.AckCallbackRetryGasBudget = 0
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+
+	suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, types.PendingAckCallback{
+		Channel:   "channel-0",
+		Sequence:  1,
+		Contract:  suite.CounterContractAddr.String(),
+		Ack:       ibcmock.MockAcknowledgement.Acknowledgement(),
+		Success:   true,
+		Attempts:  1,
+		LastError: "failed",
+	})
+
+	suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+
+	// The callback should be left in the queue, untouched
+	pending, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), pending.Attempts)
+}
+
+func (suite *HooksTestSuite) TestBeginBlockerStopsAtGasBudget() {
+	suite.SetupEnv()
+
+	// With a budget smaller than a single retry, only the first callback is attempted
+	params := suite.App.IBCHooksKeeper.GetParams(suite.Ctx)
+	params.AckCallbackRetryGasBudget = 1
+	suite.App.IBCHooksKeeper.SetParams(suite.Ctx, params)
+
+	for _, sequence := range []uint64{1, 2} {
+		suite.App.IBCHooksKeeper.SetPendingAckCallback(suite.Ctx, types.PendingAckCallback{
+			Channel:   "channel-0",
+			Sequence:  sequence,
+			Contract:  suite.CounterContractAddr.String(),
+			Ack:       ibcmock.MockAcknowledgement.Acknowledgement(),
+			Success:   true,
+			Attempts:  1,
+			LastError: "failed",
+		})
+	}
+
+	suite.App.IBCHooksKeeper.BeginBlocker(suite.Ctx)
+
+	first, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(2), first.Attempts, "the first callback should have run out of gas")
+
+	second, found := suite.App.IBCHooksKeeper.GetPendingAckCallback(suite.Ctx, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), second.Attempts, "the second callback should not have been attempted")
+}
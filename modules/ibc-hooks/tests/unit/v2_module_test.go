@@ -0,0 +1,181 @@
+package tests_unit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	ibchooksv2 "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	transferv2 "github.com/cosmos/ibc-go/v10/modules/apps/transfer/v2"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+)
+
+const (
+	v2SourceClient      = "07-tendermint-0"
+	v2DestinationClient = "07-tendermint-1"
+)
+
+// newV2Middleware wraps the transfer v2 module with the wasm hooks
+func (suite *HooksTestSuite) newV2Middleware() ibchooksv2.IBCMiddleware {
+	wasmHooks := ibc_hooks.NewWasmHooks(
+		&suite.App.IBCHooksKeeper,
+		&suite.App.WasmKeeper,
+		"cosmos",
+	)
+	transferIBCModule := transferv2.NewIBCModule(suite.App.TransferKeeper)
+	return ibchooksv2.NewIBCMiddleware(transferIBCModule, wasmHooks)
+}
+
+// newV2Payload builds an ICS20 v1 JSON-encoded payload for the transfer port
+func (suite *HooksTestSuite) newV2Payload(data transfertypes.FungibleTokenPacketData) channeltypesv2.Payload {
+	return channeltypesv2.NewPayload(
+		transfertypes.PortID,
+		transfertypes.PortID,
+		transfertypes.V1,
+		transfertypes.EncodingJSON,
+		data.GetBytes(),
+	)
+}
+
+// fundV2Escrow sends funds to the escrow of the destination client to simulate a transfer from the ibc module
+func (suite *HooksTestSuite) fundV2Escrow() {
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, v2DestinationClient)
+	testEscrowAmount := sdk.NewInt64Coin("stake", 2)
+	err := suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAddress.GetAddress(), escrowAddress, sdk.NewCoins(testEscrowAmount))
+	suite.NoError(err)
+	suite.App.TransferKeeper.SetTotalEscrowForDenom(suite.Ctx, testEscrowAmount)
+}
+
+// sendV2CallbackPacket sends a payload with an ibc_callback memo through the middleware
+func (suite *HooksTestSuite) sendV2CallbackPacket(middleware ibchooksv2.IBCMiddleware, sequence uint64) channeltypesv2.Payload {
+	payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+		Denom:    "stake",
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.CounterContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"ibc_callback": "%s"}`, suite.CounterContractAddr),
+	})
+
+	err := middleware.OnSendPacket(suite.Ctx, v2SourceClient, v2DestinationClient, sequence, payload, suite.TestAddress.GetAddress())
+	suite.Require().NoError(err)
+
+	// The callback should be keyed by the source client and sequence
+	contract := suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, v2SourceClient, sequence)
+	suite.Require().Equal(suite.CounterContractAddr.String(), contract)
+
+	return payload
+}
+
+func (suite *HooksTestSuite) TestV2OnRecvPacketCounterContract() {
+	suite.SetupEnv()
+	suite.fundV2Escrow()
+
+	payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+		Denom:    fmt.Sprintf("transfer/%s/stake", v2SourceClient),
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.CounterContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+	})
+
+	middleware := suite.newV2Middleware()
+
+	// call the hook twice
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		res := middleware.OnRecvPacket(suite.Ctx, v2SourceClient, v2DestinationClient, sequence, payload, suite.TestAddress.GetAddress())
+		suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+
+		var ack channeltypes.Acknowledgement
+		err := transfertypes.ModuleCdc.UnmarshalJSON(res.Acknowledgement, &ack)
+		suite.Require().NoError(err)
+		suite.Require().True(ack.Success())
+
+		var contractAck ibc_hooks.ContractAck
+		err = json.Unmarshal(ack.GetResult(), &contractAck)
+		suite.Require().NoError(err)
+	}
+
+	// the intermediate sender is derived from the destination client
+	senderBech32, err := ibchookskeeper.DeriveIntermediateSender(
+		v2DestinationClient,
+		suite.TestAddress.GetAddress().String(),
+		"cosmos",
+	)
+	suite.NoError(err)
+
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": "%s"}}`, senderBech32)),
+	)
+	suite.NoError(err)
+	suite.Equal(`{"count":1}`, string(count))
+}
+
+func (suite *HooksTestSuite) TestV2OnRecvPacketInvalidMemo() {
+	suite.SetupEnv()
+	suite.fundV2Escrow()
+
+	// the contract in the memo does not match the receiver
+	payload := suite.newV2Payload(transfertypes.FungibleTokenPacketData{
+		Denom:    fmt.Sprintf("transfer/%s/stake", v2SourceClient),
+		Amount:   "1",
+		Sender:   suite.TestAddress.GetAddress().String(),
+		Receiver: suite.EchoContractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"contract": "%s", "msg":{"increment":{}}}}`, suite.CounterContractAddr.String()),
+	})
+
+	middleware := suite.newV2Middleware()
+
+	res := middleware.OnRecvPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, payload, suite.TestAddress.GetAddress())
+	suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+}
+
+func (suite *HooksTestSuite) TestV2OnAcknowledgementPacketCounterContract() {
+	suite.SetupEnv()
+
+	middleware := suite.newV2Middleware()
+	payload := suite.sendV2CallbackPacket(middleware, 1)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	err := middleware.OnAcknowledgementPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, ack, payload, suite.TestAddress.GetAddress())
+	suite.Require().NoError(err)
+
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, suite.CounterContractAddr.String())),
+	)
+	suite.NoError(err)
+	suite.Equal(`{"count":1}`, string(count))
+
+	// the callback is removed once processed
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, v2SourceClient, 1))
+}
+
+func (suite *HooksTestSuite) TestV2OnTimeoutPacketCounterContract() {
+	suite.SetupEnv()
+
+	middleware := suite.newV2Middleware()
+	payload := suite.sendV2CallbackPacket(middleware, 1)
+
+	err := middleware.OnTimeoutPacket(suite.Ctx, v2SourceClient, v2DestinationClient, 1, payload, suite.TestAddress.GetAddress())
+	suite.Require().NoError(err)
+
+	count, err := suite.App.WasmKeeper.QuerySmart(
+		suite.Ctx,
+		suite.CounterContractAddr,
+		[]byte(fmt.Sprintf(`{"get_count":{"addr": %q}}`, suite.CounterContractAddr.String())),
+	)
+	suite.NoError(err)
+	suite.Equal(`{"count":10}`, string(count))
+
+	// the callback is removed once processed
+	suite.Require().Empty(suite.App.IBCHooksKeeper.GetPacketCallback(suite.Ctx, v2SourceClient, 1))
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// ContractAckVersion1 is the version of the first ContractAcknowledgement format. Packets request it by setting
+// the ack_version key of their wasm memo to it
+const ContractAckVersion1 = "ibchooks-ack-1"
+
+// ackCdc encodes the ContractAcknowledgement as proto JSON
+var ackCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// IsSupportedAckVersion returns whether the version is a ContractAcknowledgement format known by the hooks. The
+// empty version is the unversioned format of the acks of packets that do not request a version
+func IsSupportedAckVersion(version string) bool {
+	return version == "" || version == ContractAckVersion1
+}
+
+// NewContractAcknowledgement creates a ContractAcknowledgement in the latest format
+func NewContractAcknowledgement(contract string, gasUsed uint64, events sdk.Events, contractResult, ibcAck []byte) ContractAcknowledgement {
+	contractEvents := make([]ContractEvent, 0, len(events))
+	for _, event := range events {
+		attributes := make([]ContractEventAttribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, ContractEventAttribute{Key: attribute.Key, Value: attribute.Value})
+		}
+		contractEvents = append(contractEvents, ContractEvent{Type: event.Type, Attributes: attributes})
+	}
+
+	return ContractAcknowledgement{
+		Version:        ContractAckVersion1,
+		Contract:       contract,
+		GasUsed:        gasUsed,
+		Events:         contractEvents,
+		ContractResult: contractResult,
+		IbcAck:         ibcAck,
+	}
+}
+
+// MarshalContractAcknowledgement encodes the ContractAcknowledgement as the proto JSON sent in the result of the
+// ICS-04 acknowledgement
+func MarshalContractAcknowledgement(ack ContractAcknowledgement) ([]byte, error) {
+	return ackCdc.MarshalJSON(&ack)
+}
+
+// UnmarshalContractAcknowledgement decodes the result of the ICS-04 acknowledgement of a packet whose wasm hook
+// was executed. The unversioned format, with only contract_result and ibc_ack, is decoded with an empty version
+func UnmarshalContractAcknowledgement(bz []byte) (ContractAcknowledgement, error) {
+	var ack ContractAcknowledgement
+	if err := ackCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return ContractAcknowledgement{}, err
+	}
+	if !IsSupportedAckVersion(ack.Version) {
+		return ContractAcknowledgement{}, fmt.Errorf("unsupported contract acknowledgement version %q", ack.Version)
+	}
+	return ack, nil
+}
+
+// DecodeContractAcknowledgement decodes the ICS-04 acknowledgement, as written on chain, of a packet whose wasm
+// hook was executed. Error acknowledgements, written when the hook or the app failed, are returned as an error
+func DecodeContractAcknowledgement(acknowledgement []byte) (ContractAcknowledgement, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return ContractAcknowledgement{}, err
+	}
+	if !ack.Success() {
+		return ContractAcknowledgement{}, fmt.Errorf("error acknowledgement: %s", ack.GetError())
+	}
+	return UnmarshalContractAcknowledgement(ack.GetResult())
+}
+
+// InnerAcknowledgement decodes the acknowledgement of the app the packet was sent to, like ICS-20 transfer
+func (a ContractAcknowledgement) InnerAcknowledgement() (channeltypes.Acknowledgement, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(a.IbcAck, &ack); err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	return ack, nil
+}
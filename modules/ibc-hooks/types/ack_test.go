@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+func TestContractAcknowledgementJSON(t *testing.T) {
+	ibcAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	events := sdk.Events{sdk.NewEvent("wasm", sdk.NewAttribute("action", "echo"))}
+	ack := types.NewContractAcknowledgement("contract", 100, events, []byte("result"), ibcAck)
+
+	bz, err := types.MarshalContractAcknowledgement(ack)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"version": "ibchooks-ack-1",
+		"contract": "contract",
+		"gas_used": "100",
+		"events": [{"type": "wasm", "attributes": [{"key": "action", "value": "echo"}]}],
+		"contract_result": "cmVzdWx0",
+		"ibc_ack": "eyJyZXN1bHQiOiJBUT09In0="
+	}`, string(bz))
+
+	decoded, err := types.DecodeContractAcknowledgement(channeltypes.NewResultAcknowledgement(bz).Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, ack, decoded)
+
+	innerAck, err := decoded.InnerAcknowledgement()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, innerAck.GetResult())
+}
+
+func TestUnmarshalContractAcknowledgement(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bz       string
+		expected types.ContractAcknowledgement
+		errMsg   string
+	}{
+		{
+			"unversioned",
+			`{"contract_result":"cmVzdWx0","ibc_ack":"eyJyZXN1bHQiOiJBUT09In0="}`,
+			types.ContractAcknowledgement{ContractResult: []byte("result"), IbcAck: []byte(`{"result":"AQ=="}`)},
+			"",
+		},
+		{
+			"unversioned without result",
+			`{"contract_result":null,"ibc_ack":"eyJyZXN1bHQiOiJBUT09In0="}`,
+			types.ContractAcknowledgement{IbcAck: []byte(`{"result":"AQ=="}`)},
+			"",
+		},
+		{"unsupported version", `{"version":"ibchooks-ack-99"}`, types.ContractAcknowledgement{}, "unsupported contract acknowledgement version"},
+		{"not json", `result`, types.ContractAcknowledgement{}, "invalid character"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ack, err := types.UnmarshalContractAcknowledgement([]byte(tc.bz))
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ack)
+		})
+	}
+}
+
+func TestDecodeErrorAcknowledgement(t *testing.T) {
+	errAck := channeltypes.NewErrorAcknowledgement(types.ErrWasmError).Acknowledgement()
+	_, err := types.DecodeContractAcknowledgement(errAck)
+	require.ErrorContains(t, err, "error acknowledgement")
+}
@@ -12,4 +12,10 @@ var (
 	ErrBadResponse   = errors.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errors.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errors.Register("wasm-hooks", 7, "bad sender")
+	ErrNoPendingAck  = errors.Register("wasm-hooks", 8, "no pending ack callback")
+	ErrUnauthorized  = errors.Register("wasm-hooks", 9, "unauthorized")
+	ErrHooksDisabled = errors.Register("wasm-hooks", 10, "hooks are disabled")
+	ErrNotAllowed    = errors.Register("wasm-hooks", 11, "contract is not allowed")
+	ErrMsgNotAllowed = errors.Register("wasm-hooks", 12, "message type is not allowed")
+	ErrMsgExecution  = errors.Register("wasm-hooks", 13, "msg execution error")
 )
@@ -0,0 +1,15 @@
+package types
+
+const (
+	EventTypeAckCallbackQueued    = "ibc-ack-callback-queued"
+	EventTypeAckCallbackRetried   = "ibc-ack-callback-retried"
+	EventTypeAckCallbackDropped   = "ibc-ack-callback-dropped"
+	EventTypeAckCallbackPulled    = "ibc-ack-callback-pulled"
+	EventTypeAckCallbackRetryFail = "ibc-ack-callback-retry-error"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+	AttributeKeyAttempts = "attempts"
+	AttributeKeyError    = "error"
+)
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state, with no pending callbacks
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PacketCallbacks:     []PacketCallback{},
+		PendingAckCallbacks: []PendingAckCallback{},
+		Params:              DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon any failure
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.PacketCallbacks))
+	for _, callback := range gs.PacketCallbacks {
+		if err := callback.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s::%d", callback.Channel, callback.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate packet callback for channel %s and sequence %d", callback.Channel, callback.Sequence)
+		}
+		seen[key] = true
+	}
+
+	seenPending := make(map[string]bool, len(gs.PendingAckCallbacks))
+	for _, pending := range gs.PendingAckCallbacks {
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s::%d", pending.Channel, pending.Sequence)
+		if seenPending[key] {
+			return fmt.Errorf("duplicate pending ack callback for channel %s and sequence %d", pending.Channel, pending.Sequence)
+		}
+		seenPending[key] = true
+	}
+	return nil
+}
+
+// Validate checks that the callback references a packet and a valid contract address
+func (c PacketCallback) Validate() error {
+	if c.Channel == "" {
+		return fmt.Errorf("packet callback channel cannot be empty")
+	}
+	if c.Sequence == 0 {
+		return fmt.Errorf("packet callback sequence cannot be zero (channel %s)", c.Channel)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return fmt.Errorf("invalid packet callback contract address (%s) for channel %s and sequence %d: %w",
+			c.Contract, c.Channel, c.Sequence, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	testCases := []struct {
+		name        string
+		genState    *types.GenesisState
+		expectedErr string
+	}{
+		{
+			name:     "default genesis",
+			genState: types.DefaultGenesis(),
+		},
+		{
+			name: "valid callbacks",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PacketCallbacks: []types.PacketCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: contract},
+					{Channel: "channel-0", Sequence: 2, Contract: contract},
+					{Channel: "07-tendermint-0", Sequence: 1, Contract: contract},
+				},
+			},
+		},
+		{
+			name: "empty channel",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PacketCallbacks: []types.PacketCallback{
+					{Channel: "", Sequence: 1, Contract: contract},
+				},
+			},
+			expectedErr: "packet callback channel cannot be empty",
+		},
+		{
+			name: "zero sequence",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PacketCallbacks: []types.PacketCallback{
+					{Channel: "channel-0", Sequence: 0, Contract: contract},
+				},
+			},
+			expectedErr: "packet callback sequence cannot be zero",
+		},
+		{
+			name: "invalid contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PacketCallbacks: []types.PacketCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: "contract"},
+				},
+			},
+			expectedErr: "invalid packet callback contract address",
+		},
+		{
+			name: "duplicate callback",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PacketCallbacks: []types.PacketCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: contract},
+					{Channel: "channel-0", Sequence: 1, Contract: contract},
+				},
+			},
+			expectedErr: "duplicate packet callback for channel channel-0 and sequence 1",
+		},
+		{
+			name: "valid pending ack callback",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingAckCallbacks: []types.PendingAckCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: contract, Attempts: 1},
+				},
+			},
+		},
+		{
+			name: "pending ack callback never attempted",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingAckCallbacks: []types.PendingAckCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: contract},
+				},
+			},
+			expectedErr: "must have been attempted",
+		},
+		{
+			name: "duplicate pending ack callback",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingAckCallbacks: []types.PendingAckCallback{
+					{Channel: "channel-0", Sequence: 1, Contract: contract, Attempts: 1},
+					{Channel: "channel-0", Sequence: 1, Contract: contract, Attempts: 2},
+				},
+			},
+			expectedErr: "duplicate pending ack callback for channel channel-0 and sequence 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+const (
+	// ICS721PortID is the default port the ICS-721 NFT transfer app binds to
+	ICS721PortID = "nft-transfer"
+	// ICS721Version is the app version of ICS-721 NFT transfer channels
+	ICS721Version = "ics721-1"
+)
+
+// NonFungibleTokenPacketData is the packet data of an ICS-721 NFT transfer, as defined in the ICS-721 spec.
+// ibc-go does not implement ICS-721, so it is defined here for the hooks to read the sender, receiver and memo
+type NonFungibleTokenPacketData struct {
+	ClassId   string   `json:"classId"`
+	ClassUri  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIds  []string `json:"tokenIds"`
+	TokenUris []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
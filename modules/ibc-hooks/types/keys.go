@@ -1,8 +1,45 @@
 package types
 
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName     = "ibchooks"
 	StoreKey       = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 	IBCCallbackKey = "ibc_callback"
-	SenderPrefix   = "ibc-wasm-hook-intermediary"
+	MsgRouterKey   = "msg"
+	AckVersionKey  = "ack_version"
+
+	// Memo keys of the ibc-go callbacks middleware (ADR-8)
+	SourceCallbackKey      = "src_callback"
+	DestinationCallbackKey = "dest_callback"
+	CallbackAddressKey     = "address"
+	CallbackGasLimitKey    = "gas_limit"
+	SenderPrefix           = "ibc-wasm-hook-intermediary"
 )
+
+// PacketCallbackKeyPrefix is the prefix under which the callback contract of each sent packet is stored.
+// Prior to consensus version 2, the callbacks were stored at the root of the store
+var PacketCallbackKeyPrefix = []byte{0x01}
+
+// CallbacksByContractKeyPrefix is the prefix of the secondary index of packet callbacks by contract,
+// stored as {prefix}{len(contract)}{contract}{channel}::{sequence}
+var CallbacksByContractKeyPrefix = []byte{0x02}
+
+// GetCallbacksByContractPrefix returns the prefix under which the callbacks of a contract are indexed
+func GetCallbacksByContractPrefix(contract string) []byte {
+	return append(bytes.Clone(CallbacksByContractKeyPrefix), address.MustLengthPrefix([]byte(contract))...)
+}
+
+// AckCallbackRetryKeyPrefix is the prefix of the queue of failed ack callbacks, keyed by {channel}::{sequence}
+var AckCallbackRetryKeyPrefix = []byte{0x03}
+
+// ParamsKey is the key under which the module parameters are stored
+var ParamsKey = []byte{0x04}
+
+// CallbackGasLimitKeyPrefix is the prefix under which the gas limit requested for a packet callback is stored,
+// keyed by {channel}::{sequence}
+var CallbackGasLimitKeyPrefix = []byte{0x05}
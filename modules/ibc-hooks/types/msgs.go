@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgPullAckCallback{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidateBasic performs stateless validation of the message
+func (msg *MsgPullAckCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return fmt.Errorf("invalid contract address (%s): %w", msg.Contract, err)
+	}
+	if msg.Channel == "" {
+		return fmt.Errorf("channel cannot be empty")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the message
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address (%s): %w", msg.Authority, err)
+	}
+	return msg.Params.Validate()
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultRecvGasLimit is the default gas limit for the contract execution of a wasm memo
+	DefaultRecvGasLimit uint64 = 2_000_000
+	// DefaultCallbackGasLimit is the default gas limit for the ack and timeout sudo callbacks
+	DefaultCallbackGasLimit uint64 = 1_000_000
+	// DefaultAckCallbackRetryGasBudget is the default total gas available each block to retry failed ack callbacks
+	DefaultAckCallbackRetryGasBudget uint64 = 1_000_000
+	// DefaultMaxAckCallbackAttempts is the default number of times an ack callback is attempted (including the
+	// initial delivery) before it is dropped from the retry queue
+	DefaultMaxAckCallbackAttempts uint32 = 5
+)
+
+// NewParams creates a new Params instance without any contract or channel restrictions, and with the default
+// ack callback retry settings
+func NewParams(hooksEnabled bool, recvGasLimit, ackCallbackGasLimit, timeoutCallbackGasLimit uint64) Params {
+	return Params{
+		HooksEnabled:              hooksEnabled,
+		RecvGasLimit:              recvGasLimit,
+		AckCallbackGasLimit:       ackCallbackGasLimit,
+		TimeoutCallbackGasLimit:   timeoutCallbackGasLimit,
+		AckCallbackRetryGasBudget: DefaultAckCallbackRetryGasBudget,
+		MaxAckCallbackAttempts:    DefaultMaxAckCallbackAttempts,
+	}
+}
+
+// DefaultParams returns the default set of parameters
+func DefaultParams() Params {
+	return NewParams(true, DefaultRecvGasLimit, DefaultCallbackGasLimit, DefaultCallbackGasLimit)
+}
+
+// Validate validates the set of params. Any gas limit is valid, with 0 disabling the limit
+func (p Params) Validate() error {
+	if p.MaxAckCallbackAttempts == 0 {
+		return fmt.Errorf("max ack callback attempts must be positive")
+	}
+	if err := validateContracts("allowed", p.AllowedContracts); err != nil {
+		return err
+	}
+	if err := validateContracts("denied", p.DeniedContracts); err != nil {
+		return err
+	}
+	if err := validateCodeIDs("allowed", p.AllowedCodeIds); err != nil {
+		return err
+	}
+	if err := validateCodeIDs("denied", p.DeniedCodeIds); err != nil {
+		return err
+	}
+
+	seenTypeURLs := make(map[string]bool)
+	for _, typeURL := range p.AllowedMsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid allowed message type URL (%s): must start with '/'", typeURL)
+		}
+		if seenTypeURLs[typeURL] {
+			return fmt.Errorf("duplicate allowed message type URL %s", typeURL)
+		}
+		seenTypeURLs[typeURL] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, channel := range p.DisabledChannels {
+		if channel == "" {
+			return fmt.Errorf("disabled channel cannot be empty")
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate disabled channel %s", channel)
+		}
+		seen[channel] = true
+	}
+	return nil
+}
+
+// ChannelEnabled returns whether the hooks can be used on the channel (or, for IBC v2 packets, client)
+func (p Params) ChannelEnabled(channelOrClientID string) bool {
+	return p.HooksEnabled && !slices.Contains(p.DisabledChannels, channelOrClientID)
+}
+
+// HasCodeIDRestrictions returns whether the code ID of a contract is needed to check if it is allowed
+func (p Params) HasCodeIDRestrictions() bool {
+	return len(p.AllowedCodeIds) > 0 || len(p.DeniedCodeIds) > 0
+}
+
+// ContractAllowed returns whether the contract, instantiated from the given code ID, can be the target of a hook.
+// Denied contracts and code IDs are never allowed. If there is an allowlist, the contract or its code ID must be in it
+func (p Params) ContractAllowed(contract string, codeID uint64) bool {
+	if slices.Contains(p.DeniedContracts, contract) || slices.Contains(p.DeniedCodeIds, codeID) {
+		return false
+	}
+	if len(p.AllowedContracts) == 0 && len(p.AllowedCodeIds) == 0 {
+		return true
+	}
+	return slices.Contains(p.AllowedContracts, contract) || slices.Contains(p.AllowedCodeIds, codeID)
+}
+
+// MsgAllowed returns whether the message type can be executed by the msg memo key
+func (p Params) MsgAllowed(typeURL string) bool {
+	return slices.Contains(p.AllowedMsgTypeUrls, typeURL)
+}
+
+func validateContracts(listName string, contracts []string) error {
+	seen := make(map[string]bool)
+	for _, contract := range contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid %s contract address (%s): %w", listName, contract, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate %s contract %s", listName, contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateCodeIDs(listName string, codeIDs []uint64) error {
+	seen := make(map[uint64]bool)
+	for _, codeID := range codeIDs {
+		if codeID == 0 {
+			return fmt.Errorf("%s code ID cannot be 0", listName)
+		}
+		if seen[codeID] {
+			return fmt.Errorf("duplicate %s code ID %d", listName, codeID)
+		}
+		seen[codeID] = true
+	}
+	return nil
+}
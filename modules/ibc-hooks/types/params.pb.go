@@ -0,0 +1,999 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibchooks/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the ibc-hooks module's parameters.
+type Params struct {
+	// Gas limit for the contract execution triggered by a wasm memo when a
+	// packet is received. 0 disables the limit
+	RecvGasLimit uint64 `protobuf:"varint,1,opt,name=recv_gas_limit,json=recvGasLimit,proto3" json:"recv_gas_limit,omitempty"`
+	// Gas limit for the ibc_ack sudo callback. 0 disables the limit
+	AckCallbackGasLimit uint64 `protobuf:"varint,2,opt,name=ack_callback_gas_limit,json=ackCallbackGasLimit,proto3" json:"ack_callback_gas_limit,omitempty"`
+	// Gas limit for the ibc_timeout sudo callback. 0 disables the limit
+	TimeoutCallbackGasLimit uint64 `protobuf:"varint,3,opt,name=timeout_callback_gas_limit,json=timeoutCallbackGasLimit,proto3" json:"timeout_callback_gas_limit,omitempty"`
+	// Whether the wasm and ibc_callback hooks are enabled
+	HooksEnabled bool `protobuf:"varint,4,opt,name=hooks_enabled,json=hooksEnabled,proto3" json:"hooks_enabled,omitempty"`
+	// If either allowed_contracts or allowed_code_ids is not empty, only the
+	// contracts listed, or instantiated from one of the code IDs listed, can be
+	// the target of a hook
+	AllowedContracts []string `protobuf:"bytes,5,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	AllowedCodeIds   []uint64 `protobuf:"varint,6,rep,packed,name=allowed_code_ids,json=allowedCodeIds,proto3" json:"allowed_code_ids,omitempty"`
+	// Contracts, and code IDs, that can never be the target of a hook. The
+	// denylist takes priority over the allowlist
+	DeniedContracts []string `protobuf:"bytes,7,rep,name=denied_contracts,json=deniedContracts,proto3" json:"denied_contracts,omitempty"`
+	DeniedCodeIds   []uint64 `protobuf:"varint,8,rep,packed,name=denied_code_ids,json=deniedCodeIds,proto3" json:"denied_code_ids,omitempty"`
+	// Channels (or, for IBC v2 packets, clients) on which the hooks are disabled
+	DisabledChannels []string `protobuf:"bytes,9,rep,name=disabled_channels,json=disabledChannels,proto3" json:"disabled_channels,omitempty"`
+	// Whether the src_callback memo key of the ibc-go callbacks middleware
+	// (ADR-8) is accepted as an alternative to ibc_callback
+	Adr8CallbacksEnabled bool `protobuf:"varint,10,opt,name=adr8_callbacks_enabled,json=adr8CallbacksEnabled,proto3" json:"adr8_callbacks_enabled,omitempty"`
+	// Type URLs of the SDK messages that can be executed by the msg memo key of
+	// the MsgRouterHooks. No message can be executed if empty
+	AllowedMsgTypeUrls []string `protobuf:"bytes,11,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// Total gas available each block to retry the failed ack callbacks in
+	// BeginBlock. 0 disables the retries, leaving the callbacks to be pulled by
+	// their contract
+	AckCallbackRetryGasBudget uint64 `protobuf:"varint,12,opt,name=ack_callback_retry_gas_budget,json=ackCallbackRetryGasBudget,proto3" json:"ack_callback_retry_gas_budget,omitempty"`
+	// Number of times an ack callback is attempted (including the initial
+	// delivery) before it is dropped from the retry queue. Must be positive
+	MaxAckCallbackAttempts uint32 `protobuf:"varint,13,opt,name=max_ack_callback_attempts,json=maxAckCallbackAttempts,proto3" json:"max_ack_callback_attempts,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5ac6f593316c985, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRecvGasLimit() uint64 {
+	if m != nil {
+		return m.RecvGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetAckCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.AckCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetTimeoutCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.TimeoutCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetHooksEnabled() bool {
+	if m != nil {
+		return m.HooksEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedCodeIds() []uint64 {
+	if m != nil {
+		return m.AllowedCodeIds
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedContracts() []string {
+	if m != nil {
+		return m.DeniedContracts
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedCodeIds() []uint64 {
+	if m != nil {
+		return m.DeniedCodeIds
+	}
+	return nil
+}
+
+func (m *Params) GetDisabledChannels() []string {
+	if m != nil {
+		return m.DisabledChannels
+	}
+	return nil
+}
+
+func (m *Params) GetAdr8CallbacksEnabled() bool {
+	if m != nil {
+		return m.Adr8CallbacksEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetAckCallbackRetryGasBudget() uint64 {
+	if m != nil {
+		return m.AckCallbackRetryGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAckCallbackAttempts() uint32 {
+	if m != nil {
+		return m.MaxAckCallbackAttempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibchooks.v1.Params")
+}
+
+func init() { proto.RegisterFile("ibchooks/v1/params.proto", fileDescriptor_e5ac6f593316c985) }
+
+var fileDescriptor_e5ac6f593316c985 = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xda, 0x95, 0xcd, 0x6b, 0xb7, 0xce, 0x40, 0xc9, 0x90, 0x88, 0x22, 0x40, 0x28,
+	0x68, 0xa2, 0xa1, 0x1a, 0x42, 0x20, 0x2e, 0x6c, 0x15, 0x9a, 0x90, 0x40, 0x9a, 0x22, 0xb8, 0x70,
+	0xb1, 0x1c, 0xdb, 0x4a, 0xa3, 0xda, 0x71, 0x14, 0x3b, 0x65, 0xbd, 0xf2, 0x09, 0xf8, 0x58, 0x1c,
+	0x7b, 0xe4, 0x88, 0xda, 0x2f, 0x82, 0xe2, 0xfc, 0xa3, 0x82, 0x5b, 0xf4, 0x3c, 0xbf, 0xf7, 0x89,
+	0xfd, 0xbc, 0x06, 0x76, 0x1c, 0x92, 0xb9, 0x94, 0x0b, 0xe5, 0x2f, 0xa7, 0x7e, 0x8a, 0x33, 0x2c,
+	0xd4, 0x24, 0xcd, 0xa4, 0x96, 0xf0, 0xb0, 0x76, 0x26, 0xcb, 0xe9, 0xa3, 0xef, 0x7b, 0xa0, 0x7f,
+	0x6d, 0x5c, 0xf8, 0x04, 0x1c, 0x65, 0x8c, 0x2c, 0x51, 0x84, 0x15, 0xe2, 0xb1, 0x88, 0xb5, 0x6d,
+	0xb9, 0x96, 0xd7, 0x0b, 0x06, 0x85, 0x7a, 0x85, 0xd5, 0xc7, 0x42, 0x83, 0xe7, 0x60, 0x8c, 0xc9,
+	0x02, 0x11, 0xcc, 0x79, 0x58, 0x7c, 0xb4, 0xf4, 0x2d, 0x43, 0xdf, 0xc1, 0x64, 0x31, 0xab, 0xcc,
+	0x66, 0xe8, 0x2d, 0x78, 0xa0, 0x63, 0xc1, 0x64, 0xae, 0xff, 0x37, 0xd8, 0x35, 0x83, 0xf7, 0x2b,
+	0xe2, 0x9f, 0xe1, 0xc7, 0x60, 0x68, 0x8e, 0x8b, 0x58, 0x82, 0x43, 0xce, 0xa8, 0xdd, 0x73, 0x2d,
+	0x6f, 0x3f, 0x18, 0x18, 0xf1, 0x7d, 0xa9, 0xc1, 0x33, 0x70, 0x82, 0x39, 0x97, 0xdf, 0x18, 0x45,
+	0x44, 0x26, 0x3a, 0xc3, 0x44, 0x2b, 0x7b, 0xcf, 0xed, 0x7a, 0x07, 0xc1, 0xa8, 0x32, 0x66, 0xb5,
+	0x0e, 0x3d, 0x30, 0x6a, 0x61, 0xca, 0x50, 0x4c, 0x95, 0xdd, 0x77, 0xbb, 0x5e, 0x2f, 0x38, 0x6a,
+	0x58, 0xca, 0x3e, 0x50, 0x05, 0x9f, 0x81, 0x11, 0x65, 0x49, 0xbc, 0x93, 0x7a, 0xdb, 0xa4, 0x1e,
+	0x97, 0x7a, 0x1b, 0xfa, 0x14, 0x1c, 0x37, 0x68, 0x95, 0xb9, 0x6f, 0x32, 0x87, 0x35, 0x59, 0x46,
+	0x9e, 0x81, 0x13, 0x1a, 0x2b, 0x73, 0x6a, 0x44, 0xe6, 0x38, 0x49, 0x18, 0x57, 0xf6, 0x41, 0x79,
+	0xd2, 0xda, 0x98, 0x55, 0x3a, 0x7c, 0x09, 0xc6, 0x98, 0x66, 0xaf, 0x9b, 0xd6, 0xda, 0x12, 0x80,
+	0x29, 0xe1, 0x6e, 0xe1, 0xd6, 0x8d, 0x35, 0x65, 0x4c, 0xc1, 0xbd, 0xfa, 0x7e, 0x42, 0x45, 0x48,
+	0xaf, 0x52, 0x86, 0xf2, 0x8c, 0x2b, 0xfb, 0xd0, 0xfc, 0x06, 0x56, 0xe6, 0x27, 0x15, 0x7d, 0x5e,
+	0xa5, 0xec, 0x4b, 0xc6, 0x15, 0x7c, 0x07, 0x1e, 0xee, 0xac, 0x35, 0x63, 0x3a, 0x5b, 0x99, 0x1d,
+	0x85, 0x39, 0x8d, 0x98, 0xb6, 0x07, 0x66, 0x49, 0xa7, 0x7f, 0x6d, 0x37, 0x28, 0x90, 0x2b, 0xac,
+	0x2e, 0x0d, 0x00, 0xdf, 0x80, 0x53, 0x81, 0x6f, 0xd0, 0x4e, 0x0a, 0xd6, 0x9a, 0x89, 0x54, 0x2b,
+	0x7b, 0xe8, 0x5a, 0xde, 0x30, 0x18, 0x0b, 0x7c, 0x73, 0xd1, 0x06, 0x5c, 0x54, 0xee, 0xe5, 0xf5,
+	0xcf, 0x8d, 0x63, 0xad, 0x37, 0x8e, 0xf5, 0x7b, 0xe3, 0x58, 0x3f, 0xb6, 0x4e, 0x67, 0xbd, 0x75,
+	0x3a, 0xbf, 0xb6, 0x4e, 0xe7, 0xeb, 0xab, 0x28, 0xd6, 0xf3, 0x3c, 0x9c, 0x10, 0x29, 0x7c, 0x22,
+	0x95, 0x90, 0xca, 0x8f, 0x43, 0xf2, 0x1c, 0xa7, 0xa9, 0xf2, 0x85, 0xa4, 0x39, 0x67, 0xa5, 0x50,
+	0xbf, 0xf4, 0x17, 0x7e, 0x71, 0x59, 0x15, 0xf6, 0xcd, 0x53, 0x3f, 0xff, 0x33, 0x00, 0x6f, 0x72,
+	0x08, 0xd8, 0x06, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAckCallbackAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAckCallbackAttempts))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AckCallbackRetryGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AckCallbackRetryGasBudget))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Adr8CallbacksEnabled {
+		i--
+		if m.Adr8CallbacksEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DisabledChannels) > 0 {
+		for iNdEx := len(m.DisabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledChannels[iNdEx])
+			copy(dAtA[i:], m.DisabledChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DisabledChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeniedCodeIds) > 0 {
+		dAtA2 := make([]byte, len(m.DeniedCodeIds)*10)
+		var j1 int
+		for _, num := range m.DeniedCodeIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DeniedContracts) > 0 {
+		for iNdEx := len(m.DeniedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedContracts[iNdEx])
+			copy(dAtA[i:], m.DeniedContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowedCodeIds) > 0 {
+		dAtA4 := make([]byte, len(m.AllowedCodeIds)*10)
+		var j3 int
+		for _, num := range m.AllowedCodeIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintParams(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HooksEnabled {
+		i--
+		if m.HooksEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeoutCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeoutCallbackGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AckCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AckCallbackGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecvGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecvGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecvGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.RecvGasLimit))
+	}
+	if m.AckCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.AckCallbackGasLimit))
+	}
+	if m.TimeoutCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.TimeoutCallbackGasLimit))
+	}
+	if m.HooksEnabled {
+		n += 2
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedCodeIds) > 0 {
+		l = 0
+		for _, e := range m.AllowedCodeIds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.DeniedContracts) > 0 {
+		for _, s := range m.DeniedContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedCodeIds) > 0 {
+		l = 0
+		for _, e := range m.DeniedCodeIds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if len(m.DisabledChannels) > 0 {
+		for _, s := range m.DisabledChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Adr8CallbacksEnabled {
+		n += 2
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AckCallbackRetryGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.AckCallbackRetryGasBudget))
+	}
+	if m.MaxAckCallbackAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxAckCallbackAttempts))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvGasLimit", wireType)
+			}
+			m.RecvGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCallbackGasLimit", wireType)
+			}
+			m.AckCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutCallbackGasLimit", wireType)
+			}
+			m.TimeoutCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HooksEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedCodeIds = append(m.AllowedCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedCodeIds) == 0 {
+					m.AllowedCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedCodeIds = append(m.AllowedCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeIds", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedContracts = append(m.DeniedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeniedCodeIds = append(m.DeniedCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeniedCodeIds) == 0 {
+					m.DeniedCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeniedCodeIds = append(m.DeniedCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCodeIds", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledChannels = append(m.DisabledChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adr8CallbacksEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Adr8CallbacksEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCallbackRetryGasBudget", wireType)
+			}
+			m.AckCallbackRetryGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCallbackRetryGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAckCallbackAttempts", wireType)
+			}
+			m.MaxAckCallbackAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAckCallbackAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	testCases := []struct {
+		name         string
+		updateParams func(params *types.Params)
+		expectedErr  string
+	}{
+		{
+			name:         "default params",
+			updateParams: func(params *types.Params) {},
+		},
+		{
+			name: "valid lists",
+			updateParams: func(params *types.Params) {
+				params.AllowedContracts = []string{contract}
+				params.AllowedCodeIds = []uint64{1, 2}
+				params.DeniedCodeIds = []uint64{3}
+				params.DisabledChannels = []string{"channel-0", "07-tendermint-0"}
+			},
+		},
+		{
+			name:         "invalid contract",
+			updateParams: func(params *types.Params) { params.DeniedContracts = []string{"contract"} },
+			expectedErr:  "invalid denied contract address (contract)",
+		},
+		{
+			name:         "duplicate contract",
+			updateParams: func(params *types.Params) { params.AllowedContracts = []string{contract, contract} },
+			expectedErr:  "duplicate allowed contract",
+		},
+		{
+			name:         "zero code ID",
+			updateParams: func(params *types.Params) { params.AllowedCodeIds = []uint64{0} },
+			expectedErr:  "allowed code ID cannot be 0",
+		},
+		{
+			name:         "duplicate code ID",
+			updateParams: func(params *types.Params) { params.DeniedCodeIds = []uint64{1, 1} },
+			expectedErr:  "duplicate denied code ID 1",
+		},
+		{
+			name:         "invalid message type URL",
+			updateParams: func(params *types.Params) { params.AllowedMsgTypeUrls = []string{"cosmos.bank.v1beta1.MsgSend"} },
+			expectedErr:  "invalid allowed message type URL (cosmos.bank.v1beta1.MsgSend)",
+		},
+		{
+			name: "duplicate message type URL",
+			updateParams: func(params *types.Params) {
+				params.AllowedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+			},
+			expectedErr: "duplicate allowed message type URL /cosmos.bank.v1beta1.MsgSend",
+		},
+		{
+			name:         "empty disabled channel",
+			updateParams: func(params *types.Params) { params.DisabledChannels = []string{""} },
+			expectedErr:  "disabled channel cannot be empty",
+		},
+		{
+			name:         "zero max ack callback attempts",
+			updateParams: func(params *types.Params) { params.MaxAckCallbackAttempts = 0 },
+			expectedErr:  "max ack callback attempts must be positive",
+		},
+		{
+			name:         "retries disabled",
+			updateParams: func(params *types.Params) { params.AckCallbackRetryGasBudget = 0 },
+		},
+		{
+			name:         "duplicate disabled channel",
+			updateParams: func(params *types.Params) { params.DisabledChannels = []string{"channel-0", "channel-0"} },
+			expectedErr:  "duplicate disabled channel channel-0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.updateParams(&params)
+
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestContractAllowed(t *testing.T) {
+	contractA := sdk.AccAddress([]byte("contract_a__________")).String()
+	contractB := sdk.AccAddress([]byte("contract_b__________")).String()
+
+	params := types.DefaultParams()
+	require.True(t, params.ContractAllowed(contractA, 1), "everything is allowed without lists")
+
+	params.DeniedContracts = []string{contractB}
+	require.True(t, params.ContractAllowed(contractA, 1))
+	require.False(t, params.ContractAllowed(contractB, 1))
+
+	params.AllowedCodeIds = []uint64{2}
+	require.False(t, params.ContractAllowed(contractA, 1), "not in the allowlist")
+	require.True(t, params.ContractAllowed(contractA, 2), "code ID in the allowlist")
+	require.False(t, params.ContractAllowed(contractB, 2), "the denylist takes priority")
+
+	params.AllowedContracts = []string{contractA}
+	require.True(t, params.ContractAllowed(contractA, 1), "contract in the allowlist")
+}
+
+func TestChannelEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.ChannelEnabled("channel-0"))
+
+	params.DisabledChannels = []string{"channel-0"}
+	require.False(t, params.ChannelEnabled("channel-0"))
+	require.True(t, params.ChannelEnabled("channel-1"))
+
+	params.HooksEnabled = false
+	require.False(t, params.ChannelEnabled("channel-1"))
+}
@@ -0,0 +1,15 @@
+package types
+
+import "fmt"
+
+// Validate checks that the pending callback references a packet and a valid contract address
+func (p PendingAckCallback) Validate() error {
+	callback := PacketCallback{Channel: p.Channel, Sequence: p.Sequence, Contract: p.Contract}
+	if err := callback.Validate(); err != nil {
+		return err
+	}
+	if p.Attempts == 0 {
+		return fmt.Errorf("pending ack callback for channel %s and sequence %d must have been attempted", p.Channel, p.Sequence)
+	}
+	return nil
+}
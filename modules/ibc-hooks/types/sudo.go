@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewIBCAckSudoMsg builds the ibc_lifecycle_complete sudo message sent to a contract when the ack of its packet
+// is received
+func NewIBCAckSudoMsg(channel string, sequence uint64, acknowledgement []byte, success bool) ([]byte, error) {
+	ackAsJson, err := json.Marshal(acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf(
+		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %t}}}`,
+		channel, sequence, ackAsJson, success)), nil
+}
+
+// NewIBCRecvSudoMsg builds the ibc_lifecycle_complete sudo message sent to the ADR-8 destination callback contract
+// of a packet after it has been successfully received
+func NewIBCRecvSudoMsg(channel string, sequence uint64, acknowledgement []byte) ([]byte, error) {
+	ackAsJson, err := json.Marshal(acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf(
+		`{"ibc_lifecycle_complete": {"ibc_recv": {"channel": "%s", "sequence": %d, "ack": %s}}}`,
+		channel, sequence, ackAsJson)), nil
+}
+
+// NewIBCTimeoutSudoMsg builds the ibc_lifecycle_complete sudo message sent to a contract when its packet times out
+func NewIBCTimeoutSudoMsg(channel string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf(
+		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
+		channel, sequence))
+}
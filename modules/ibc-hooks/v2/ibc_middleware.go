@@ -0,0 +1,219 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+
+	ibc_hooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v10"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ api.IBCModule = (*IBCMiddleware)(nil)
+
+// IBCMiddleware runs the wasm hooks for ICS20 payloads sent over IBC v2.
+// IBC v2 packets are identified by client IDs instead of channels, so the intermediate sender is
+// derived from the destination client ID, and ibc_callback contracts are keyed by the source
+// client ID and the packet sequence.
+type IBCMiddleware struct {
+	app   api.IBCModule
+	hooks ibc_hooks.WasmHooks
+}
+
+func NewIBCMiddleware(app api.IBCModule, hooks ibc_hooks.WasmHooks) IBCMiddleware {
+	return IBCMiddleware{
+		app:   app,
+		hooks: hooks,
+	}
+}
+
+// OnSendPacket registers the ibc_callback contract in the memo (if any) once the underlying app has
+// accepted the payload. Unlike classic channels, the payload cannot be modified by the middleware, so
+// the ibc_callback key is sent as part of the memo. The send fails if the module params do not allow the contract
+// to receive the callback.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+
+	if !im.hooks.ProperlyConfigured() {
+		return nil
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// Not an ICS20 payload, nothing to register
+		return nil
+	}
+
+	return im.hooks.StorePacketCallbackFromMemo(ctx, sourceClient, sequence, data.Memo)
+}
+
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if !im.hooks.ProperlyConfigured() {
+		// Not configured
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	result := im.onRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if result.Status != channeltypesv2.PacketStatus_Success {
+		return result
+	}
+
+	if data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding); err == nil {
+		im.hooks.SendDestinationCallback(ctx, destinationClient, sequence, data.Memo, result.Acknowledgement)
+	}
+	return result
+}
+
+// onRecvPacket receives the payload, executing the contract of its wasm memo (if any)
+func (im IBCMiddleware) onRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	// Validate the memo
+	isWasmRouted, contractAddr, msgBytes, err := im.hooks.ValidateAndParseMemo(ctx, destinationClient, data.Memo, data.Receiver)
+	if !isWasmRouted {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMsgValidation, err.Error())
+	}
+	if msgBytes == nil || contractAddr == nil { // This should never happen
+		return newErrorResult(ctx, types.ErrMsgValidation)
+	}
+
+	// Calculate the receiver / contract caller based on the packet's destination client and sender
+	senderBech32, err := im.hooks.DeriveIntermediateSender(destinationClient, data.Sender)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", destinationClient, data.Sender, err.Error()))
+	}
+
+	// Hijack the funds to the intermediate sender by overriding the receiver of the payload,
+	// re-encoding it with the same version and encoding it was sent with
+	packetData := transfertypes.NewFungibleTokenPacketData(data.Token.Denom.Path(), data.Token.Amount, data.Sender, senderBech32, data.Memo)
+	bz, err := transfertypes.MarshalPacketData(packetData, payload.Version, payload.Encoding)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrMarshaling, err.Error())
+	}
+	payload.Value = bz
+
+	// Execute the receive
+	result := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if result.Status != channeltypesv2.PacketStatus_Success {
+		return result
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Token.Amount)
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
+		// but returning here for completeness
+		return newErrorResult(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+
+	// The payload's denom is the denom in the sender chain. This needs to be converted to the local denom.
+	denom := ibc_hooks.LocalDenomOnRecv(data.Token.Denom, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient)
+	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+
+	// Execute the contract, keeping track of the gas it uses and the events it emits for the ack
+	gasBefore := ctx.GasMeter().GasConsumed()
+	eventsBefore := len(ctx.EventManager().Events())
+	response, err := im.hooks.ExecuteHookContract(ctx, senderBech32, contractAddr, msgBytes, funds)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrWasmError, err.Error())
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	events := ctx.EventManager().Events()[eventsBefore:]
+	bz, err = ibc_hooks.NewContractAck(ibc_hooks.AckVersionFromMemo(data.Memo), contractAddr, gasUsed, events, response.Data, result.Acknowledgement)
+	if err != nil {
+		return newErrorResult(ctx, types.ErrBadResponse, err.Error())
+	}
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(bz).Acknowledgement(),
+	}
+}
+
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	if !im.hooks.ProperlyConfigured() {
+		// Not configured. Return from the underlying implementation
+		return nil
+	}
+
+	return im.hooks.SendTimeoutCallback(ctx, sourceClient, sequence)
+}
+
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	if !im.hooks.ProperlyConfigured() {
+		// Not configured. Return from the underlying implementation
+		return nil
+	}
+
+	// IBC v2 replaces the app acknowledgement with the universal error ack when the receive failed
+	success := !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) && !ibc_hooks.IsJsonAckError(acknowledgement)
+	return im.hooks.SendAckCallback(ctx, sourceClient, sequence, acknowledgement, success)
+}
+
+// newErrorResult emits the error and returns a failed receive result with an error acknowledgement
+func newErrorResult(ctx sdk.Context, err error, errorContexts ...string) channeltypesv2.RecvPacketResult {
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Failure,
+		Acknowledgement: ibc_hooks.NewEmitErrorAcknowledgement(ctx, err, errorContexts...).Acknowledgement(),
+	}
+}
@@ -3,24 +3,26 @@ package ibc_hooks
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
-	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/ibc-hooks/v10/types"
 
 	errors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
-	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
+// ContractAck is the unversioned result of the ack of a packet whose hook contract was executed. Packets can
+// request the versioned types.ContractAcknowledgement instead with the ack_version key of their wasm memo
 type ContractAck struct {
 	ContractResult []byte `json:"contract_result"`
 	IbcAck         []byte `json:"ibc_ack"`
@@ -30,6 +32,7 @@ type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
 	ibcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
+	packetDataRegistry  *PacketDataRegistry
 }
 
 func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keeper, bech32PrefixAccAddr string) WasmHooks {
@@ -37,27 +40,54 @@ func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keep
 		ContractKeeper:      contractKeeper,
 		ibcHooksKeeper:      ibcHooksKeeper,
 		bech32PrefixAccAddr: bech32PrefixAccAddr,
+		packetDataRegistry:  DefaultPacketDataRegistry(),
 	}
 }
 
+// PacketDataRegistry returns the registry of the apps the hooks run for. Apps other than ICS-20 transfer,
+// interchain accounts and ICS-721 NFT transfer can be supported by registering their PacketDataUnmarshaler
+func (h WasmHooks) PacketDataRegistry() *PacketDataRegistry {
+	return h.packetDataRegistry
+}
+
 func (h WasmHooks) ProperlyConfigured() bool {
 	return h.ContractKeeper != nil && h.ibcHooksKeeper != nil
 }
 
-func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
 	if !h.ProperlyConfigured() {
 		// Not configured
-		return im.App.OnRecvPacket(ctx, packet, relayer)
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
-	isIcs20, data := isIcs20Packet(packet.GetData())
-	if !isIcs20 {
-		return im.App.OnRecvPacket(ctx, packet, relayer)
+
+	ack := h.onRecvPacket(im, ctx, channelVersion, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if unmarshaler, ok := h.packetDataRegistry.GetUnmarshaler(packet.GetDestPort(), channelVersion); ok {
+		if data, err := unmarshaler.UnmarshalPacketData(packet.GetSourcePort(), packet.GetData()); err == nil {
+			h.SendDestinationCallback(ctx, packet.GetDestChannel(), packet.GetSequence(), data.Memo, ack.Acknowledgement())
+		}
+	}
+	return ack
+}
+
+// onRecvPacket receives the packet, executing the contract of its wasm memo (if any)
+func (h WasmHooks) onRecvPacket(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	unmarshaler, ok := h.packetDataRegistry.GetUnmarshaler(packet.GetDestPort(), channelVersion)
+	if !ok {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	data, err := unmarshaler.UnmarshalPacketData(packet.GetSourcePort(), packet.GetData())
+	if err != nil {
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
 
 	// Validate the memo
-	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
+	isWasmRouted, contractAddr, msgBytes, err := h.ValidateAndParseMemo(ctx, packet.GetDestChannel(), data.Memo, data.Receiver)
 	if !isWasmRouted {
-		return im.App.OnRecvPacket(ctx, packet, relayer)
+		return im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	}
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
@@ -68,56 +98,61 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	channel := packet.GetDestChannel()
-	sender := data.GetSender()
-	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
+	sender := data.Sender
+	senderBech32, err := h.DeriveIntermediateSender(channel, sender)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
 	}
 
 	// The funds sent on this packet need to be transferred to the intermediary account for the sender.
-	// For this, we override the ICS20 packet's Receiver (essentially hijacking the funds to this new address)
-	// and execute the underlying OnRecvPacket() call (which should eventually land on the transfer app's
-	// relay.go and send the sunds to the intermediary account.
+	// For this, we override the packet's Receiver (essentially hijacking the funds to this new address)
+	// and execute the underlying OnRecvPacket() call (which, for ICS20, should eventually land on the
+	// transfer app's relay.go and send the funds to the intermediary account.
 	//
 	// If that succeeds, we make the contract call
-	data.Receiver = senderBech32
-	bz, err := json.Marshal(data)
+	bz, err := unmarshaler.SetReceiver(packet.GetData(), senderBech32)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
 	}
 	packet.Data = bz
 
 	// Execute the receive
-	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	ack := im.App.OnRecvPacket(ctx, channelVersion, packet, relayer)
 	if !ack.Success() {
 		return ack
 	}
 
-	amount, ok := sdkmath.NewIntFromString(data.GetAmount())
-	if !ok {
-		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
-		// but returning here for completeness
-		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
-	}
-
-	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
-	denom := MustExtractDenomFromPacketOnRecv(packet)
-	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	funds := sdk.NewCoins()
+	for _, token := range data.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
+			// but returning here for completeness
+			return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+		}
 
-	// Execute the contract
-	execMsg := wasmtypes.MsgExecuteContract{
-		Sender:   senderBech32,
-		Contract: contractAddr.String(),
-		Msg:      msgBytes,
-		Funds:    funds,
+		// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
+		denom := LocalDenomOnRecv(token.Denom, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel())
+		funds = funds.Add(sdk.NewCoin(denom, amount))
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg)
+
+	// Execute the contract, keeping track of the gas it uses and the events it emits for the ack
+	gasBefore := ctx.GasMeter().GasConsumed()
+	eventsBefore := len(ctx.EventManager().Events())
+	response, err := h.ExecuteHookContract(ctx, senderBech32, contractAddr, msgBytes, funds)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
 	}
 
-	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
-	bz, err = json.Marshal(fullAck)
+	// The acks of apps without a receiver, like the TxMsgData of interchain accounts, are decoded by the controllers
+	// of these apps, so they are not wrapped in a contract ack
+	if data.Receiver == "" {
+		return ack
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	events := ctx.EventManager().Events()[eventsBefore:]
+	bz, err = NewContractAck(AckVersionFromMemo(data.Memo), contractAddr, gasUsed, events, response.Data, ack.Acknowledgement())
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
 	}
@@ -125,20 +160,60 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// AckVersionFromMemo returns the ContractAcknowledgement version requested by the ack_version key of the wasm
+// memo, or an empty version for the unversioned ContractAck. The memo must have been validated by
+// ValidateAndParseMemo
+func AckVersionFromMemo(memo string) string {
+	_, metadata := jsonStringHasKey(memo, "wasm")
+	wasm, _ := metadata["wasm"].(map[string]interface{})
+	version, _ := wasm[types.AckVersionKey].(string)
+	return version
+}
+
+// NewContractAck encodes the result of the ack of a packet whose hook contract was executed, in the ack version
+// requested by the packet
+func NewContractAck(ackVersion string, contractAddr sdk.AccAddress, gasUsed uint64, events sdk.Events, contractResult, ibcAck []byte) ([]byte, error) {
+	switch ackVersion {
+	case "":
+		return json.Marshal(ContractAck{ContractResult: contractResult, IbcAck: ibcAck})
+	case types.ContractAckVersion1:
+		return types.MarshalContractAcknowledgement(types.NewContractAcknowledgement(contractAddr.String(), gasUsed, events, contractResult, ibcAck))
+	default:
+		return nil, fmt.Errorf("unsupported contract acknowledgement version %q", ackVersion)
+	}
+}
+
+// DeriveIntermediateSender returns the address used as the contract caller for packets received on the
+// given channel (or, for IBC v2 packets, client) from the original sender on the counterparty chain
+func (h WasmHooks) DeriveIntermediateSender(channelOrClientID, originalSender string) (string, error) {
+	return keeper.DeriveIntermediateSender(channelOrClientID, originalSender, h.bech32PrefixAccAddr)
+}
+
+// ExecuteHookContract executes the contract specified in a wasm memo on behalf of the intermediate sender,
+// attaching the funds that were received in the packet. The execution is limited to the recv gas limit param
+func (h WasmHooks) ExecuteHookContract(ctx sdk.Context, sender string, contractAddr sdk.AccAddress, msgBytes []byte, funds sdk.Coins) (response *wasmtypes.MsgExecuteContractResponse, err error) {
+	execMsg := wasmtypes.MsgExecuteContract{
+		Sender:   sender,
+		Contract: contractAddr.String(),
+		Msg:      msgBytes,
+		Funds:    funds,
+	}
+
+	gasLimit := h.ibcHooksKeeper.GetParams(ctx).RecvGasLimit
+	err = keeper.RunWithGasLimit(ctx, gasLimit, "ibc hooks contract execution", func(ctx sdk.Context) error {
+		var execErr error
+		response, execErr = h.execWasmMsg(ctx, &execMsg)
+		return execErr
+	})
+	return response, err
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
 	}
 	wasmMsgServer := wasmkeeper.NewMsgServerImpl(h.ContractKeeper)
-	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), execMsg)
-}
-
-func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
-	var packetdata transfertypes.FungibleTokenPacketData
-	if err := json.Unmarshal(data, &packetdata); err != nil {
-		return false, packetdata
-	}
-	return true, packetdata
+	return wasmMsgServer.ExecuteContract(ctx, execMsg)
 }
 
 // jsonStringHasKey parses the memo as a json object and checks if it contains the key.
@@ -163,7 +238,42 @@ func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]inter
 	return ok, jsonObject
 }
 
-func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+// ValidateAndParseMemo parses the wasm key of the memo of a packet received on the given channel (or, for IBC v2
+// packets, client), and checks that the module params allow the contract to be executed by the hook
+func (h WasmHooks) ValidateAndParseMemo(ctx sdk.Context, channelOrClientID, memo, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+	isWasmRouted, contractAddr, msgBytes, err = parseWasmMemo(memo, receiver)
+	if !isWasmRouted || err != nil {
+		return isWasmRouted, contractAddr, msgBytes, err
+	}
+
+	if err := h.checkHookAllowed(ctx, channelOrClientID, contractAddr); err != nil {
+		return isWasmRouted, sdk.AccAddress{}, nil, err
+	}
+	return isWasmRouted, contractAddr, msgBytes, nil
+}
+
+// checkHookAllowed returns an error if the hooks are disabled on the channel (or, for IBC v2 packets, client), or
+// if the contract cannot be the target of a hook
+func (h WasmHooks) checkHookAllowed(ctx sdk.Context, channelOrClientID string, contractAddr sdk.AccAddress) error {
+	params := h.ibcHooksKeeper.GetParams(ctx)
+	if !params.ChannelEnabled(channelOrClientID) {
+		return errors.Wrapf(types.ErrHooksDisabled, "cannot use hooks on %s", channelOrClientID)
+	}
+
+	// The contract info is only loaded when needed, as it is not free
+	var codeID uint64
+	if params.HasCodeIDRestrictions() {
+		if contractInfo := h.ContractKeeper.GetContractInfo(ctx, contractAddr); contractInfo != nil {
+			codeID = contractInfo.CodeID
+		}
+	}
+	if !params.ContractAllowed(contractAddr.String(), codeID) {
+		return errors.Wrapf(types.ErrNotAllowed, "contract %s cannot be the target of a hook", contractAddr)
+	}
+	return nil
+}
+
+func parseWasmMemo(memo string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
 	isWasmRouted, metadata := jsonStringHasKey(memo, "wasm")
 	if !isWasmRouted {
 		return isWasmRouted, sdk.AccAddress{}, nil, nil
@@ -191,7 +301,8 @@ func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, cont
 			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
 	}
 
-	if contract != receiver {
+	// Apps without a receiver, like interchain accounts, can target any contract
+	if receiver != "" && contract != receiver {
 		return isWasmRouted, sdk.AccAddress{}, nil,
 			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
 	}
@@ -207,6 +318,18 @@ func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, cont
 			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a map object`)
 	}
 
+	if ackVersion, ok := wasm[types.AckVersionKey]; ok {
+		if version, isString := ackVersion.(string); !isString || !types.IsSupportedAckVersion(version) {
+			return isWasmRouted, sdk.AccAddress{}, nil,
+				fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["ack_version"] is not a supported ack version`)
+		}
+		// The acks of apps without a receiver are never wrapped in a contract ack
+		if receiver == "" {
+			return isWasmRouted, sdk.AccAddress{}, nil,
+				fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["ack_version"] is not supported for packets without a receiver`)
+		}
+	}
+
 	// Get the message string by serializing the map
 	msgBytes, err = json.Marshal(wasm["msg"])
 	if err != nil {
@@ -218,15 +341,19 @@ func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, cont
 	return isWasmRouted, contractAddr, msgBytes, nil
 }
 
-func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
-	isIcs20, ics20data := isIcs20Packet(data)
-	if !isIcs20 {
-		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
+func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
+	unmarshaler, ok := h.packetDataRegistry.GetUnmarshaler(sourcePort, "")
+	if !ok {
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
+	}
+	packetData, err := unmarshaler.UnmarshalPacketData(sourcePort, data)
+	if err != nil {
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
 
-	isCallbackRouted, metadata := jsonStringHasKey(ics20data.GetMemo(), types.IBCCallbackKey)
+	isCallbackRouted, metadata := jsonStringHasKey(packetData.Memo, types.IBCCallbackKey)
 	if !isCallbackRouted {
-		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
+		return h.sendPacketWithSourceCallback(i, ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, packetData.Memo)
 	}
 
 	// We remove the meta.callback provides instructions for post-send processing. This instruction are saved
@@ -236,6 +363,16 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	// from the data completely so the packet is sent without it.
 	// This way receiver chains that are on old versions of IBC will be able to process the packet
 	callbackRaw := metadata[types.IBCCallbackKey] // This will be used later.
+
+	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore the callback.
+	// Otherwise, the module params must allow it to receive the callback before the packet is sent
+	contract, ok := parseCallbackContract(callbackRaw)
+	if ok {
+		if err := h.checkHookAllowed(ctx, sourceChannel, sdk.MustAccAddressFromBech32(contract)); err != nil {
+			return 0, err
+		}
+	}
+
 	delete(metadata, types.IBCCallbackKey)
 	bzMetadata, err := json.Marshal(metadata)
 	if err != nil {
@@ -243,36 +380,186 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	}
 	stringMetadata := string(bzMetadata)
 	if stringMetadata == "{}" {
-		ics20data.Memo = ""
-	} else {
-		ics20data.Memo = stringMetadata
+		stringMetadata = ""
 	}
-	dataBytes, err := json.Marshal(ics20data)
+	dataBytes, err := unmarshaler.SetMemo(data, stringMetadata)
 	if err != nil {
-		return 0, errors.Wrap(err, "ics20data marshall error")
+		return 0, errors.Wrap(err, "packet data marshall error")
 	}
 
-	seq, err := i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, dataBytes)
+	seq, err := i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, dataBytes)
 	if err != nil {
 		return 0, err
 	}
 
-	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore this packet
-	contract, ok := callbackRaw.(string)
 	if !ok {
 		return 0, nil
 	}
-	_, err = sdk.AccAddressFromBech32(contract)
+
+	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
+	return seq, nil
+}
+
+// sendPacketWithSourceCallback sends the packet, registering the contract in the ADR-8 src_callback key of the
+// memo (if any, and if enabled by the params). Unlike ibc_callback, the src_callback key is left in the memo,
+// as the callbacks middleware does
+func (h WasmHooks) sendPacketWithSourceCallback(i ICS4Middleware, ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte, memo string) (uint64, error) {
+	contract, gasLimit, ok := h.sourceCallbackFromMemo(ctx, memo)
+	if !ok {
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
+	}
+	if err := h.checkHookAllowed(ctx, sourceChannel, sdk.MustAccAddressFromBech32(contract)); err != nil {
+		return 0, err
+	}
+
+	seq, err := i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	if err != nil {
-		return 0, nil
+		return 0, err
 	}
 
 	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
+	h.ibcHooksKeeper.SetPacketCallbackGasLimit(ctx, sourceChannel, seq, gasLimit)
 	return seq, nil
 }
 
-func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
-	err := im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+// sourceCallbackFromMemo returns the contract, and the gas limit, of the ADR-8 src_callback key of the memo.
+// The callback is ignored if the ADR-8 callbacks are not enabled or if it is not properly formatted
+func (h WasmHooks) sourceCallbackFromMemo(ctx sdk.Context, memo string) (contract string, gasLimit uint64, ok bool) {
+	isCallbackRouted, metadata := jsonStringHasKey(memo, types.SourceCallbackKey)
+	if !isCallbackRouted || !h.ibcHooksKeeper.GetParams(ctx).Adr8CallbacksEnabled {
+		return "", 0, false
+	}
+	return parseADR8Callback(metadata[types.SourceCallbackKey])
+}
+
+// parseADR8Callback returns the address and gas limit of an ADR-8 callback: {"address": "...", "gas_limit": "..."}.
+// The gas limit is optional and, as in the callbacks middleware, encoded as a string
+func parseADR8Callback(callbackRaw interface{}) (contract string, gasLimit uint64, ok bool) {
+	callback, ok := callbackRaw.(map[string]interface{})
+	if !ok {
+		return "", 0, false
+	}
+
+	contract, ok = parseCallbackContract(callback[types.CallbackAddressKey])
+	if !ok {
+		return "", 0, false
+	}
+
+	gasLimitRaw, found := callback[types.CallbackGasLimitKey]
+	if !found {
+		return contract, 0, true
+	}
+	gasLimitStr, ok := gasLimitRaw.(string)
+	if !ok {
+		return "", 0, false
+	}
+	gasLimit, err := strconv.ParseUint(gasLimitStr, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return contract, gasLimit, true
+}
+
+// StorePacketCallbackFromMemo registers the contract in the memo's ibc_callback key (or, if enabled, the ADR-8
+// src_callback key) to receive the ack or timeout of the packet sent on the given channel (or, for IBC v2 packets,
+// client) and sequence. An error is returned if the module params do not allow the contract to receive the callback
+func (h WasmHooks) StorePacketCallbackFromMemo(ctx sdk.Context, channelOrClientID string, sequence uint64, memo string) error {
+	var (
+		contract string
+		gasLimit uint64
+		ok       bool
+	)
+	if isCallbackRouted, metadata := jsonStringHasKey(memo, types.IBCCallbackKey); isCallbackRouted {
+		contract, ok = parseCallbackContract(metadata[types.IBCCallbackKey])
+	} else {
+		contract, gasLimit, ok = h.sourceCallbackFromMemo(ctx, memo)
+	}
+	if !ok {
+		return nil
+	}
+
+	if err := h.checkHookAllowed(ctx, channelOrClientID, sdk.MustAccAddressFromBech32(contract)); err != nil {
+		return err
+	}
+
+	h.ibcHooksKeeper.StorePacketCallback(ctx, channelOrClientID, sequence, contract)
+	h.ibcHooksKeeper.SetPacketCallbackGasLimit(ctx, channelOrClientID, sequence, gasLimit)
+	return nil
+}
+
+// parseCallbackContract returns the callback contract if it is a string holding a valid bech32 address
+func parseCallbackContract(callbackRaw interface{}) (string, bool) {
+	contract, ok := callbackRaw.(string)
+	if !ok {
+		return "", false
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return "", false
+	}
+	return contract, true
+}
+
+// SendDestinationCallback notifies the contract of the ADR-8 dest_callback key of the memo (if any, and if enabled by
+// the params) that the packet received on the given channel (or, for IBC v2 packets, client) and sequence has been
+// successfully received. As in the callbacks middleware, a failing callback does not fail the packet: the contract's
+// state changes are reverted and an ibc-dest-callback-error event is emitted
+func (h WasmHooks) SendDestinationCallback(ctx sdk.Context, channelOrClientID string, sequence uint64, memo string, acknowledgement []byte) {
+	params := h.ibcHooksKeeper.GetParams(ctx)
+	if !params.Adr8CallbacksEnabled {
+		return
+	}
+
+	isCallbackRouted, metadata := jsonStringHasKey(memo, types.DestinationCallbackKey)
+	if !isCallbackRouted {
+		return
+	}
+	contract, memoGasLimit, ok := parseADR8Callback(metadata[types.DestinationCallbackKey])
+	if !ok {
+		return
+	}
+
+	// The callback is limited to the recv gas limit param (and the gas limit requested in the memo, if lower)
+	gasLimit := keeper.MinGasLimit(params.RecvGasLimit, memoGasLimit)
+	if err := h.sendDestinationCallback(ctx, channelOrClientID, sequence, contract, gasLimit, acknowledgement); err != nil {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"ibc-dest-callback-error",
+				sdk.NewAttribute("contract", contract),
+				sdk.NewAttribute("channel", channelOrClientID),
+				sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+				sdk.NewAttribute("error", err.Error()),
+			),
+		})
+	}
+}
+
+// sendDestinationCallback sends the recv sudo message to the destination callback contract, if the module params
+// allow it. The contract's state changes are only committed if the callback succeeds
+func (h WasmHooks) sendDestinationCallback(ctx sdk.Context, channel string, sequence uint64, contract string, gasLimit uint64, acknowledgement []byte) error {
+	contractAddr := sdk.MustAccAddressFromBech32(contract)
+	if err := h.checkHookAllowed(ctx, channel, contractAddr); err != nil {
+		return err
+	}
+
+	sudoMsg, err := types.NewIBCRecvSudoMsg(channel, sequence, acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	err = keeper.RunWithGasLimit(cacheCtx, gasLimit, "ibc destination callback", func(ctx sdk.Context) error {
+		_, sudoErr := h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+		return sudoErr
+	})
+	if err != nil {
+		return err
+	}
+	writeFn()
+	return nil
+}
+
+func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	err := im.App.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
 	if err != nil {
 		return err
 	}
@@ -282,7 +569,13 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	return h.SendAckCallback(ctx, packet.GetSourceChannel(), packet.GetSequence(), acknowledgement, !IsJsonAckError(acknowledgement))
+}
+
+// SendAckCallback notifies the contract registered for the packet sent on the given channel (or, for IBC v2
+// packets, client) and sequence that the ack has been received. Nothing is done if no callback is registered
+func (h WasmHooks) SendAckCallback(ctx sdk.Context, channelOrClientID string, sequence uint64, acknowledgement []byte, success bool) error {
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, channelOrClientID, sequence)
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -293,33 +586,39 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return errors.Wrap(err, "Ack callback error") // The callback configured is not a bech32. Error out
 	}
 
-	success := "false"
-	if !IsJsonAckError(acknowledgement) {
-		success = "true"
-	}
-
 	// Notify the sender that the ack has been received
-	ackAsJson, err := json.Marshal(acknowledgement)
+	sudoMsg, err := types.NewIBCAckSudoMsg(channelOrClientID, sequence, acknowledgement, success)
 	if err != nil {
 		// If the ack is not a json object, error
 		return err
 	}
 
-	sudoMsg := []byte(fmt.Sprintf(
-		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %s}}}`,
-		packet.SourceChannel, packet.Sequence, ackAsJson, success))
-	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+	// The contract's state changes are only committed if the callback succeeds. The callback is limited to the
+	// ack callback gas limit param (and the gas limit requested in the memo, if lower), so running out of gas is
+	// handled like any other callback error
+	cacheCtx, writeFn := ctx.CacheContext()
+	gasLimit := keeper.MinGasLimit(
+		h.ibcHooksKeeper.GetParams(ctx).AckCallbackGasLimit,
+		h.ibcHooksKeeper.GetPacketCallbackGasLimit(ctx, channelOrClientID, sequence),
+	)
+	err = keeper.RunWithGasLimit(cacheCtx, gasLimit, "ibc ack callback", func(ctx sdk.Context) error {
+		_, sudoErr := h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+		return sudoErr
+	})
 	if err != nil {
-		// error processing the callback
-		// ToDo: Open Question: Should we also delete the callback here?
-		return errors.Wrap(err, "Ack callback error")
+		// error processing the callback. Failing here would also fail the acknowledgement of the packet, so the
+		// callback is queued instead. It is retried in BeginBlock, and the contract can pull it with MsgPullAckCallback
+		h.ibcHooksKeeper.QueueFailedAckCallback(ctx, channelOrClientID, sequence, contract, acknowledgement, success, err)
+		return nil
 	}
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	writeFn()
+
+	h.ibcHooksKeeper.DeletePacketCallback(ctx, channelOrClientID, sequence)
 	return nil
 }
 
-func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	err := im.App.OnTimeoutPacket(ctx, packet, relayer)
+func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	err := im.App.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	if err != nil {
 		return err
 	}
@@ -329,7 +628,13 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return nil
 	}
 
-	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	return h.SendTimeoutCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// SendTimeoutCallback notifies the contract registered for the packet sent on the given channel (or, for IBC v2
+// packets, client) and sequence that the packet has timed out. Nothing is done if no callback is registered
+func (h WasmHooks) SendTimeoutCallback(ctx sdk.Context, channelOrClientID string, sequence uint64) error {
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, channelOrClientID, sequence)
 	if contract == "" {
 		// No callback configured
 		return nil
@@ -340,10 +645,19 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return errors.Wrap(err, "Timeout callback error") // The callback configured is not a bech32. Error out
 	}
 
-	sudoMsg := []byte(fmt.Sprintf(
-		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
-		packet.SourceChannel, packet.Sequence))
-	_, err = h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+	// The contract's state changes are only committed if the callback succeeds. The callback is limited to the
+	// timeout callback gas limit param (and the gas limit requested in the memo, if lower), so running out of gas
+	// is handled like any other callback error
+	sudoMsg := types.NewIBCTimeoutSudoMsg(channelOrClientID, sequence)
+	cacheCtx, writeFn := ctx.CacheContext()
+	gasLimit := keeper.MinGasLimit(
+		h.ibcHooksKeeper.GetParams(ctx).TimeoutCallbackGasLimit,
+		h.ibcHooksKeeper.GetPacketCallbackGasLimit(ctx, channelOrClientID, sequence),
+	)
+	err = keeper.RunWithGasLimit(cacheCtx, gasLimit, "ibc timeout callback", func(ctx sdk.Context) error {
+		_, sudoErr := h.ContractKeeper.Sudo(ctx, contractAddr, sudoMsg)
+		return sudoErr
+	})
 	if err != nil {
 		// error processing the callback. This could be because the contract doesn't implement the message type to
 		// process the callback. Retrying this will not help, so we can delete the callback from storage.
@@ -356,8 +670,10 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 				sdk.NewAttribute("error", err.Error()),
 			),
 		})
+	} else {
+		writeFn()
 	}
-	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	h.ibcHooksKeeper.DeletePacketCallback(ctx, channelOrClientID, sequence)
 	return nil
 }
 
@@ -403,25 +719,24 @@ func MustExtractDenomFromPacketOnRecv(packet ibcexported.PacketI) string {
 		panic("unable to unmarshal ICS20 packet data")
 	}
 
-	var denom string
-	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	return LocalDenomOnRecv(denom, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel())
+}
+
+// LocalDenomOnRecv converts the denom of a received ICS20 token, as represented on the sender chain, to the
+// denom as represented in the local chain. For IBC v2 packets, the source and destination IDs are client IDs
+func LocalDenomOnRecv(denom transfertypes.Denom, sourcePort, sourceID, destPort, destID string) string {
+	if denom.HasPrefix(sourcePort, sourceID) {
 		// if we receive back a token, that was originally sent from "this" chain, then we need to remove
 		// prefix added by the sender chain: port/channel/base_denom -> base_denom.
-		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
-
-		// coin denomination used in sending from the escrow address
-		denom = unprefixedDenom
-
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
-		if !denomTrace.IsNativeDenom() {
-			denom = denomTrace.IBCDenom()
-		}
+		denom.Trace = denom.Trace[1:]
 	} else {
-		prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
-		denom = transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+		// otherwise, the token is a voucher on this chain, so we add the destination hop to the trace
+		trace := []transfertypes.Hop{transfertypes.NewHop(destPort, destID)}
+		denom.Trace = append(trace, denom.Trace...)
 	}
-	return denom
+
+	// The denomination used to send the coins is either the native denom or the hash of the path
+	// if the denomination is not native.
+	return denom.IBCDenom()
 }
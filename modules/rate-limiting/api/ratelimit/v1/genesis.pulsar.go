@@ -207,6 +207,159 @@ func (x *_GenesisState_5_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*DenomBlacklistPattern
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomBlacklistPattern)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomBlacklistPattern)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(DenomBlacklistPattern)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(DenomBlacklistPattern)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*Path
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Path)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Path)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(Path)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(Path)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*PendingWhitelistedTransfer
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingWhitelistedTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingWhitelistedTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(PendingWhitelistedTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(PendingWhitelistedTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                                      protoreflect.MessageDescriptor
 	fd_GenesisState_params                               protoreflect.FieldDescriptor
@@ -215,6 +368,10 @@ var (
 	fd_GenesisState_blacklisted_denoms                   protoreflect.FieldDescriptor
 	fd_GenesisState_pending_send_packet_sequence_numbers protoreflect.FieldDescriptor
 	fd_GenesisState_hour_epoch                           protoreflect.FieldDescriptor
+	fd_GenesisState_blacklisted_denom_patterns           protoreflect.FieldDescriptor
+	fd_GenesisState_new_denom_policy                     protoreflect.FieldDescriptor
+	fd_GenesisState_auto_created_rate_limits             protoreflect.FieldDescriptor
+	fd_GenesisState_pending_whitelisted_transfers        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -226,6 +383,10 @@ func init() {
 	fd_GenesisState_blacklisted_denoms = md_GenesisState.Fields().ByName("blacklisted_denoms")
 	fd_GenesisState_pending_send_packet_sequence_numbers = md_GenesisState.Fields().ByName("pending_send_packet_sequence_numbers")
 	fd_GenesisState_hour_epoch = md_GenesisState.Fields().ByName("hour_epoch")
+	fd_GenesisState_blacklisted_denom_patterns = md_GenesisState.Fields().ByName("blacklisted_denom_patterns")
+	fd_GenesisState_new_denom_policy = md_GenesisState.Fields().ByName("new_denom_policy")
+	fd_GenesisState_auto_created_rate_limits = md_GenesisState.Fields().ByName("auto_created_rate_limits")
+	fd_GenesisState_pending_whitelisted_transfers = md_GenesisState.Fields().ByName("pending_whitelisted_transfers")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -329,6 +490,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.BlacklistedDenomPatterns) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.BlacklistedDenomPatterns})
+		if !f(fd_GenesisState_blacklisted_denom_patterns, value) {
+			return
+		}
+	}
+	if x.NewDenomPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.NewDenomPolicy.ProtoReflect())
+		if !f(fd_GenesisState_new_denom_policy, value) {
+			return
+		}
+	}
+	if len(x.AutoCreatedRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.AutoCreatedRateLimits})
+		if !f(fd_GenesisState_auto_created_rate_limits, value) {
+			return
+		}
+	}
+	if len(x.PendingWhitelistedTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.PendingWhitelistedTransfers})
+		if !f(fd_GenesisState_pending_whitelisted_transfers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -356,6 +541,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.PendingSendPacketSequenceNumbers) != 0
 	case "ratelimit.v1.GenesisState.hour_epoch":
 		return x.HourEpoch != nil
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		return len(x.BlacklistedDenomPatterns) != 0
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		return x.NewDenomPolicy != nil
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		return len(x.AutoCreatedRateLimits) != 0
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		return len(x.PendingWhitelistedTransfers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -384,6 +577,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PendingSendPacketSequenceNumbers = nil
 	case "ratelimit.v1.GenesisState.hour_epoch":
 		x.HourEpoch = nil
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		x.BlacklistedDenomPatterns = nil
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		x.NewDenomPolicy = nil
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		x.AutoCreatedRateLimits = nil
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		x.PendingWhitelistedTransfers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -430,6 +631,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "ratelimit.v1.GenesisState.hour_epoch":
 		value := x.HourEpoch
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		if len(x.BlacklistedDenomPatterns) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.BlacklistedDenomPatterns}
+		return protoreflect.ValueOfList(listValue)
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		value := x.NewDenomPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		if len(x.AutoCreatedRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.AutoCreatedRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		if len(x.PendingWhitelistedTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.PendingWhitelistedTransfers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -470,6 +692,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.PendingSendPacketSequenceNumbers = *clv.list
 	case "ratelimit.v1.GenesisState.hour_epoch":
 		x.HourEpoch = value.Message().Interface().(*HourEpoch)
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.BlacklistedDenomPatterns = *clv.list
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		x.NewDenomPolicy = value.Message().Interface().(*NewDenomPolicy)
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.AutoCreatedRateLimits = *clv.list
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.PendingWhitelistedTransfers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -524,6 +760,29 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.HourEpoch = new(HourEpoch)
 		}
 		return protoreflect.ValueOfMessage(x.HourEpoch.ProtoReflect())
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		if x.BlacklistedDenomPatterns == nil {
+			x.BlacklistedDenomPatterns = []*DenomBlacklistPattern{}
+		}
+		value := &_GenesisState_7_list{list: &x.BlacklistedDenomPatterns}
+		return protoreflect.ValueOfList(value)
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		if x.NewDenomPolicy == nil {
+			x.NewDenomPolicy = new(NewDenomPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.NewDenomPolicy.ProtoReflect())
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		if x.AutoCreatedRateLimits == nil {
+			x.AutoCreatedRateLimits = []*Path{}
+		}
+		value := &_GenesisState_9_list{list: &x.AutoCreatedRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		if x.PendingWhitelistedTransfers == nil {
+			x.PendingWhitelistedTransfers = []*PendingWhitelistedTransfer{}
+		}
+		value := &_GenesisState_10_list{list: &x.PendingWhitelistedTransfers}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -555,6 +814,18 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "ratelimit.v1.GenesisState.hour_epoch":
 		m := new(HourEpoch)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ratelimit.v1.GenesisState.blacklisted_denom_patterns":
+		list := []*DenomBlacklistPattern{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "ratelimit.v1.GenesisState.new_denom_policy":
+		m := new(NewDenomPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ratelimit.v1.GenesisState.auto_created_rate_limits":
+		list := []*Path{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "ratelimit.v1.GenesisState.pending_whitelisted_transfers":
+		list := []*PendingWhitelistedTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ratelimit.v1.GenesisState"))
@@ -656,6 +927,28 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.HourEpoch)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.BlacklistedDenomPatterns) > 0 {
+			for _, e := range x.BlacklistedDenomPatterns {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NewDenomPolicy != nil {
+			l = options.Size(x.NewDenomPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AutoCreatedRateLimits) > 0 {
+			for _, e := range x.AutoCreatedRateLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingWhitelistedTransfers) > 0 {
+			for _, e := range x.PendingWhitelistedTransfers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -685,6 +978,68 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingWhitelistedTransfers) > 0 {
+			for iNdEx := len(x.PendingWhitelistedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingWhitelistedTransfers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AutoCreatedRateLimits) > 0 {
+			for iNdEx := len(x.AutoCreatedRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoCreatedRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.NewDenomPolicy != nil {
+			encoded, err := options.Marshal(x.NewDenomPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BlacklistedDenomPatterns) > 0 {
+			for iNdEx := len(x.BlacklistedDenomPatterns) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlacklistedDenomPatterns[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if x.HourEpoch != nil {
 			encoded, err := options.Marshal(x.HourEpoch)
 			if err != nil {
@@ -1016,6 +1371,144 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedDenomPatterns", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlacklistedDenomPatterns = append(x.BlacklistedDenomPatterns, &DenomBlacklistPattern{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedDenomPatterns[len(x.BlacklistedDenomPatterns)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewDenomPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NewDenomPolicy == nil {
+					x.NewDenomPolicy = &NewDenomPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewDenomPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCreatedRateLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AutoCreatedRateLimits = append(x.AutoCreatedRateLimits, &Path{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoCreatedRateLimits[len(x.AutoCreatedRateLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingWhitelistedTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingWhitelistedTransfers = append(x.PendingWhitelistedTransfers, &PendingWhitelistedTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingWhitelistedTransfers[len(x.PendingWhitelistedTransfers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1070,12 +1563,16 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params                           *Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	RateLimits                       []*RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	WhitelistedAddressPairs          []*WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs,omitempty"`
-	BlacklistedDenoms                []string                  `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
-	PendingSendPacketSequenceNumbers []string                  `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
-	HourEpoch                        *HourEpoch                `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch,omitempty"`
+	Params                           *Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	RateLimits                       []*RateLimit                  `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	WhitelistedAddressPairs          []*WhitelistedAddressPair     `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs,omitempty"`
+	BlacklistedDenoms                []string                      `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
+	PendingSendPacketSequenceNumbers []string                      `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
+	HourEpoch                        *HourEpoch                    `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch,omitempty"`
+	BlacklistedDenomPatterns         []*DenomBlacklistPattern      `protobuf:"bytes,7,rep,name=blacklisted_denom_patterns,json=blacklistedDenomPatterns,proto3" json:"blacklisted_denom_patterns,omitempty"`
+	NewDenomPolicy                   *NewDenomPolicy               `protobuf:"bytes,8,opt,name=new_denom_policy,json=newDenomPolicy,proto3" json:"new_denom_policy,omitempty"`
+	AutoCreatedRateLimits            []*Path                       `protobuf:"bytes,9,rep,name=auto_created_rate_limits,json=autoCreatedRateLimits,proto3" json:"auto_created_rate_limits,omitempty"`
+	PendingWhitelistedTransfers      []*PendingWhitelistedTransfer `protobuf:"bytes,10,rep,name=pending_whitelisted_transfers,json=pendingWhitelistedTransfers,proto3" json:"pending_whitelisted_transfers,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1140,6 +1637,34 @@ func (x *GenesisState) GetHourEpoch() *HourEpoch {
 	return nil
 }
 
+func (x *GenesisState) GetBlacklistedDenomPatterns() []*DenomBlacklistPattern {
+	if x != nil {
+		return x.BlacklistedDenomPatterns
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNewDenomPolicy() *NewDenomPolicy {
+	if x != nil {
+		return x.NewDenomPolicy
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoCreatedRateLimits() []*Path {
+	if x != nil {
+		return x.AutoCreatedRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingWhitelistedTransfers() []*PendingWhitelistedTransfer {
+	if x != nil {
+		return x.PendingWhitelistedTransfers
+	}
+	return nil
+}
+
 var File_ratelimit_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_ratelimit_v1_genesis_proto_rawDesc = []byte{
@@ -1150,7 +1675,7 @@ var file_ratelimit_v1_genesis_proto_rawDesc = []byte{
 	0x1a, 0x19, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x72, 0x61, 0x74,
 	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x04, 0x0a, 0x0c, 0x47, 0x65,
+	0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x08, 0x0a, 0x0c, 0x47, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x61, 0x74,
 	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
@@ -1183,20 +1708,52 @@ var file_ratelimit_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x75, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x19,
 	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f,
 	0x75, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x52, 0x09, 0x68, 0x6f, 0x75, 0x72, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x42, 0xc4, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x61, 0x74,
-	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x62, 0x63,
-	0x2d, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x61,
-	0x74, 0x65, 0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x30, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x76, 0x31,
-	0x3b, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52,
-	0x58, 0x58, 0xaa, 0x02, 0x0c, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x52, 0x61,
-	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x8c, 0x01, 0x0a, 0x1a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x61, 0x74, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x29,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0x52, 0x18, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x1f, 0xc8, 0xde, 0x1f,
+	0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x52, 0x0e, 0x6e, 0x65,
+	0x77, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x74, 0x0a, 0x18,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x42, 0x27, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x52, 0x15, 0x61, 0x75, 0x74,
+	0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x1d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x61, 0x74,
+	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x42, 0x2c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x22, 0x52, 0x1b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42,
+	0xc4, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x69, 0x62, 0x63, 0x2d, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x2d, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x61, 0x74, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x58, 0x58, 0xaa, 0x02, 0x0c,
+	0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x52,
+	0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x52, 0x61,
+	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1213,22 +1770,30 @@ func file_ratelimit_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_ratelimit_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_ratelimit_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),           // 0: ratelimit.v1.GenesisState
-	(*Params)(nil),                 // 1: ratelimit.v1.Params
-	(*RateLimit)(nil),              // 2: ratelimit.v1.RateLimit
-	(*WhitelistedAddressPair)(nil), // 3: ratelimit.v1.WhitelistedAddressPair
-	(*HourEpoch)(nil),              // 4: ratelimit.v1.HourEpoch
+	(*GenesisState)(nil),               // 0: ratelimit.v1.GenesisState
+	(*Params)(nil),                     // 1: ratelimit.v1.Params
+	(*RateLimit)(nil),                  // 2: ratelimit.v1.RateLimit
+	(*WhitelistedAddressPair)(nil),     // 3: ratelimit.v1.WhitelistedAddressPair
+	(*HourEpoch)(nil),                  // 4: ratelimit.v1.HourEpoch
+	(*DenomBlacklistPattern)(nil),      // 5: ratelimit.v1.DenomBlacklistPattern
+	(*NewDenomPolicy)(nil),             // 6: ratelimit.v1.NewDenomPolicy
+	(*Path)(nil),                       // 7: ratelimit.v1.Path
+	(*PendingWhitelistedTransfer)(nil), // 8: ratelimit.v1.PendingWhitelistedTransfer
 }
 var file_ratelimit_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: ratelimit.v1.GenesisState.params:type_name -> ratelimit.v1.Params
 	2, // 1: ratelimit.v1.GenesisState.rate_limits:type_name -> ratelimit.v1.RateLimit
 	3, // 2: ratelimit.v1.GenesisState.whitelisted_address_pairs:type_name -> ratelimit.v1.WhitelistedAddressPair
 	4, // 3: ratelimit.v1.GenesisState.hour_epoch:type_name -> ratelimit.v1.HourEpoch
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: ratelimit.v1.GenesisState.blacklisted_denom_patterns:type_name -> ratelimit.v1.DenomBlacklistPattern
+	6, // 5: ratelimit.v1.GenesisState.new_denom_policy:type_name -> ratelimit.v1.NewDenomPolicy
+	7, // 6: ratelimit.v1.GenesisState.auto_created_rate_limits:type_name -> ratelimit.v1.Path
+	8, // 7: ratelimit.v1.GenesisState.pending_whitelisted_transfers:type_name -> ratelimit.v1.PendingWhitelistedTransfer
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_ratelimit_v1_genesis_proto_init() }
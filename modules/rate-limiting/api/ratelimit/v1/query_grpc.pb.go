@@ -24,6 +24,9 @@ const (
 	Query_RateLimitsByChainId_FullMethodName           = "/ratelimit.v1.Query/RateLimitsByChainId"
 	Query_RateLimitsByChannelOrClientId_FullMethodName = "/ratelimit.v1.Query/RateLimitsByChannelOrClientId"
 	Query_AllBlacklistedDenoms_FullMethodName          = "/ratelimit.v1.Query/AllBlacklistedDenoms"
+	Query_AllBlacklistedDenomPatterns_FullMethodName   = "/ratelimit.v1.Query/AllBlacklistedDenomPatterns"
+	Query_NewDenomPolicy_FullMethodName                = "/ratelimit.v1.Query/NewDenomPolicy"
+	Query_AllAutoCreatedRateLimits_FullMethodName      = "/ratelimit.v1.Query/AllAutoCreatedRateLimits"
 	Query_AllWhitelistedAddresses_FullMethodName       = "/ratelimit.v1.Query/AllWhitelistedAddresses"
 )
 
@@ -35,7 +38,7 @@ type QueryClient interface {
 	AllRateLimits(ctx context.Context, in *QueryAllRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllRateLimitsResponse, error)
 	// Queries a specific rate limit by channel ID and denom
 	// Ex:
-	//   - /ratelimit/{channel_or_client_id}/by_denom?denom={denom}
+	//  - /ratelimit/{channel_or_client_id}/by_denom?denom={denom}
 	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
 	// Queries all the rate limits for a given chain
 	RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error)
@@ -43,6 +46,12 @@ type QueryClient interface {
 	RateLimitsByChannelOrClientId(ctx context.Context, in *QueryRateLimitsByChannelOrClientIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelOrClientIdResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all blacklisted denom patterns
+	AllBlacklistedDenomPatterns(ctx context.Context, in *QueryAllBlacklistedDenomPatternsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomPatternsResponse, error)
+	// Queries the policy applied to IBC denoms received without a rate limit
+	NewDenomPolicy(ctx context.Context, in *QueryNewDenomPolicyRequest, opts ...grpc.CallOption) (*QueryNewDenomPolicyResponse, error)
+	// Queries all rate limits that were automatically created from the new denom policy
+	AllAutoCreatedRateLimits(ctx context.Context, in *QueryAllAutoCreatedRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllAutoCreatedRateLimitsResponse, error)
 	// Queries all whitelisted address pairs
 	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
 }
@@ -100,6 +109,33 @@ func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlac
 	return out, nil
 }
 
+func (c *queryClient) AllBlacklistedDenomPatterns(ctx context.Context, in *QueryAllBlacklistedDenomPatternsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomPatternsResponse, error) {
+	out := new(QueryAllBlacklistedDenomPatternsResponse)
+	err := c.cc.Invoke(ctx, Query_AllBlacklistedDenomPatterns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NewDenomPolicy(ctx context.Context, in *QueryNewDenomPolicyRequest, opts ...grpc.CallOption) (*QueryNewDenomPolicyResponse, error) {
+	out := new(QueryNewDenomPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_NewDenomPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllAutoCreatedRateLimits(ctx context.Context, in *QueryAllAutoCreatedRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllAutoCreatedRateLimitsResponse, error) {
+	out := new(QueryAllAutoCreatedRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_AllAutoCreatedRateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error) {
 	out := new(QueryAllWhitelistedAddressesResponse)
 	err := c.cc.Invoke(ctx, Query_AllWhitelistedAddresses_FullMethodName, in, out, opts...)
@@ -117,7 +153,7 @@ type QueryServer interface {
 	AllRateLimits(context.Context, *QueryAllRateLimitsRequest) (*QueryAllRateLimitsResponse, error)
 	// Queries a specific rate limit by channel ID and denom
 	// Ex:
-	//   - /ratelimit/{channel_or_client_id}/by_denom?denom={denom}
+	//  - /ratelimit/{channel_or_client_id}/by_denom?denom={denom}
 	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
 	// Queries all the rate limits for a given chain
 	RateLimitsByChainId(context.Context, *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error)
@@ -125,6 +161,12 @@ type QueryServer interface {
 	RateLimitsByChannelOrClientId(context.Context, *QueryRateLimitsByChannelOrClientIdRequest) (*QueryRateLimitsByChannelOrClientIdResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all blacklisted denom patterns
+	AllBlacklistedDenomPatterns(context.Context, *QueryAllBlacklistedDenomPatternsRequest) (*QueryAllBlacklistedDenomPatternsResponse, error)
+	// Queries the policy applied to IBC denoms received without a rate limit
+	NewDenomPolicy(context.Context, *QueryNewDenomPolicyRequest) (*QueryNewDenomPolicyResponse, error)
+	// Queries all rate limits that were automatically created from the new denom policy
+	AllAutoCreatedRateLimits(context.Context, *QueryAllAutoCreatedRateLimitsRequest) (*QueryAllAutoCreatedRateLimitsResponse, error)
 	// Queries all whitelisted address pairs
 	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -149,6 +191,15 @@ func (UnimplementedQueryServer) RateLimitsByChannelOrClientId(context.Context, *
 func (UnimplementedQueryServer) AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
 }
+func (UnimplementedQueryServer) AllBlacklistedDenomPatterns(context.Context, *QueryAllBlacklistedDenomPatternsRequest) (*QueryAllBlacklistedDenomPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenomPatterns not implemented")
+}
+func (UnimplementedQueryServer) NewDenomPolicy(context.Context, *QueryNewDenomPolicyRequest) (*QueryNewDenomPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewDenomPolicy not implemented")
+}
+func (UnimplementedQueryServer) AllAutoCreatedRateLimits(context.Context, *QueryAllAutoCreatedRateLimitsRequest) (*QueryAllAutoCreatedRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllAutoCreatedRateLimits not implemented")
+}
 func (UnimplementedQueryServer) AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllWhitelistedAddresses not implemented")
 }
@@ -255,6 +306,60 @@ func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllBlacklistedDenomPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedDenomPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBlacklistedDenomPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllBlacklistedDenomPatterns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBlacklistedDenomPatterns(ctx, req.(*QueryAllBlacklistedDenomPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NewDenomPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNewDenomPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NewDenomPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NewDenomPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NewDenomPolicy(ctx, req.(*QueryNewDenomPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllAutoCreatedRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAutoCreatedRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllAutoCreatedRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllAutoCreatedRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllAutoCreatedRateLimits(ctx, req.(*QueryAllAutoCreatedRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllWhitelistedAddressesRequest)
 	if err := dec(in); err != nil {
@@ -300,6 +405,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllBlacklistedDenoms",
 			Handler:    _Query_AllBlacklistedDenoms_Handler,
 		},
+		{
+			MethodName: "AllBlacklistedDenomPatterns",
+			Handler:    _Query_AllBlacklistedDenomPatterns_Handler,
+		},
+		{
+			MethodName: "NewDenomPolicy",
+			Handler:    _Query_NewDenomPolicy_Handler,
+		},
+		{
+			MethodName: "AllAutoCreatedRateLimits",
+			Handler:    _Query_AllAutoCreatedRateLimits_Handler,
+		},
 		{
 			MethodName: "AllWhitelistedAddresses",
 			Handler:    _Query_AllWhitelistedAddresses_Handler,
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AddRateLimit_FullMethodName    = "/ratelimit.v1.Msg/AddRateLimit"
-	Msg_UpdateRateLimit_FullMethodName = "/ratelimit.v1.Msg/UpdateRateLimit"
-	Msg_RemoveRateLimit_FullMethodName = "/ratelimit.v1.Msg/RemoveRateLimit"
-	Msg_ResetRateLimit_FullMethodName  = "/ratelimit.v1.Msg/ResetRateLimit"
+	Msg_AddRateLimit_FullMethodName      = "/ratelimit.v1.Msg/AddRateLimit"
+	Msg_UpdateRateLimit_FullMethodName   = "/ratelimit.v1.Msg/UpdateRateLimit"
+	Msg_RemoveRateLimit_FullMethodName   = "/ratelimit.v1.Msg/RemoveRateLimit"
+	Msg_ResetRateLimit_FullMethodName    = "/ratelimit.v1.Msg/ResetRateLimit"
+	Msg_SetNewDenomPolicy_FullMethodName = "/ratelimit.v1.Msg/SetNewDenomPolicy"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +38,8 @@ type MsgClient interface {
 	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
 	// Gov tx to reset the flow on a rate limit
 	ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+	// Gov tx to set the policy for IBC denoms received without a rate limit
+	SetNewDenomPolicy(ctx context.Context, in *MsgSetNewDenomPolicy, opts ...grpc.CallOption) (*MsgSetNewDenomPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +86,15 @@ func (c *msgClient) ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, o
 	return out, nil
 }
 
+func (c *msgClient) SetNewDenomPolicy(ctx context.Context, in *MsgSetNewDenomPolicy, opts ...grpc.CallOption) (*MsgSetNewDenomPolicyResponse, error) {
+	out := new(MsgSetNewDenomPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetNewDenomPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +107,8 @@ type MsgServer interface {
 	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
 	// Gov tx to reset the flow on a rate limit
 	ResetRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+	// Gov tx to set the policy for IBC denoms received without a rate limit
+	SetNewDenomPolicy(context.Context, *MsgSetNewDenomPolicy) (*MsgSetNewDenomPolicyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -114,6 +128,9 @@ func (UnimplementedMsgServer) RemoveRateLimit(context.Context, *MsgRemoveRateLim
 func (UnimplementedMsgServer) ResetRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
 }
+func (UnimplementedMsgServer) SetNewDenomPolicy(context.Context, *MsgSetNewDenomPolicy) (*MsgSetNewDenomPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNewDenomPolicy not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +216,24 @@ func _Msg_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetNewDenomPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNewDenomPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNewDenomPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetNewDenomPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNewDenomPolicy(ctx, req.(*MsgSetNewDenomPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +257,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetRateLimit",
 			Handler:    _Msg_ResetRateLimit_Handler,
 		},
+		{
+			MethodName: "SetNewDenomPolicy",
+			Handler:    _Msg_SetNewDenomPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ratelimit/v1/tx.proto",
@@ -8,7 +8,10 @@ import (
 
 // Before each hour epoch, check if any of the rate limits have expired,
 // and reset them if they have
+// Additionally, remove any whitelisted address pairs that have expired
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	k.PruneExpiredWhitelistedAddressPairs(ctx)
+
 	if epochStarting, epochNumber := k.CheckHourEpochStarting(ctx); epochStarting {
 		for _, rateLimit := range k.GetAllRateLimits(ctx) {
 			if rateLimit.Quota.DurationHours != 0 && epochNumber%rateLimit.Quota.DurationHours == 0 {
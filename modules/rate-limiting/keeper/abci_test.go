@@ -76,3 +76,20 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestBeginBlocker_PruneExpiredWhitelist() {
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-1", Receiver: "receiver-1", ExpirationHeight: 100,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-2", Receiver: "receiver-2",
+	})
+
+	s.App.RatelimitKeeper.BeginBlocker(s.Ctx)
+
+	expectedWhitelist := []types.WhitelistedAddressPair{{Sender: "sender-2", Receiver: "receiver-2"}}
+	actualWhitelist := s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx)
+	s.Require().Equal(expectedWhitelist, actualWhitelist, "only the unexpired pair should remain")
+}
@@ -52,3 +52,50 @@ func (k Keeper) GetAllBlacklistedDenoms(ctx sdk.Context) []string {
 
 	return allBlacklistedDenoms
 }
+
+// Adds a denom pattern to the blacklist to prevent all IBC transfers whose denom
+// trace matches the pattern (e.g. every voucher of a given base denom)
+func (k Keeper) AddDenomPatternToBlacklist(ctx sdk.Context, pattern types.DenomBlacklistPattern) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.BlacklistPatternKeyPrefix)
+	key := types.GetDenomBlacklistPatternKey(pattern.MatchType, pattern.Pattern)
+	value := k.cdc.MustMarshal(&pattern)
+	store.Set(key, value)
+}
+
+// Removes a denom pattern from the blacklist
+func (k Keeper) RemoveDenomPatternFromBlacklist(ctx sdk.Context, pattern types.DenomBlacklistPattern) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.BlacklistPatternKeyPrefix)
+	key := types.GetDenomBlacklistPatternKey(pattern.MatchType, pattern.Pattern)
+	store.Delete(key)
+}
+
+// Get all the blacklisted denom patterns
+func (k Keeper) GetAllBlacklistedDenomPatterns(ctx sdk.Context) []types.DenomBlacklistPattern {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.BlacklistPatternKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allPatterns := []types.DenomBlacklistPattern{}
+	for ; iterator.Valid(); iterator.Next() {
+		pattern := types.DenomBlacklistPattern{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pattern)
+		allPatterns = append(allPatterns, pattern)
+	}
+
+	return allPatterns
+}
+
+// Check if the full denom trace (e.g. transfer/channel-5/uatom) matches any of the
+// blacklisted denom patterns
+func (k Keeper) IsDenomTraceBlacklisted(ctx sdk.Context, denomTrace string) bool {
+	for _, pattern := range k.GetAllBlacklistedDenomPatterns(ctx) {
+		if pattern.Matches(denomTrace) {
+			return true
+		}
+	}
+	return false
+}
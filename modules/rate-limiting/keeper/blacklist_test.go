@@ -1,5 +1,7 @@
 package keeper_test
 
+import "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
 // Helper function to check if an element is in an array
 func isInArray(element string, arr []string) bool {
 	for _, e := range arr {
@@ -53,3 +55,51 @@ func (s *KeeperTestSuite) TestDenomBlacklist() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestDenomBlacklistPatterns() {
+	baseDenomPattern := types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"}
+	tracePrefixPattern := types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"}
+	globPattern := types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/*/ujuno"}
+
+	// No traces should be blacklisted before the patterns are added
+	s.Require().False(s.App.RatelimitKeeper.IsDenomTraceBlacklisted(s.Ctx, "transfer/channel-0/uatom"))
+
+	for _, pattern := range []types.DenomBlacklistPattern{baseDenomPattern, tracePrefixPattern, globPattern} {
+		s.App.RatelimitKeeper.AddDenomPatternToBlacklist(s.Ctx, pattern)
+	}
+	s.Require().ElementsMatch(
+		[]types.DenomBlacklistPattern{baseDenomPattern, tracePrefixPattern, globPattern},
+		s.App.RatelimitKeeper.GetAllBlacklistedDenomPatterns(s.Ctx),
+		"list of blacklisted patterns",
+	)
+
+	// The exact denom blacklist should not pick up any of the patterns
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllBlacklistedDenoms(s.Ctx), "exact denom blacklist")
+
+	blacklistedTraces := []string{
+		"uatom",
+		"transfer/channel-0/uatom",
+		"transfer/channel-0/transfer/channel-1/uatom",
+		"transfer/channel-5/uosmo",
+		"transfer/channel-5/transfer/channel-9/ustrd",
+		"transfer/channel-3/transfer/channel-9/ujuno",
+	}
+	allowedTraces := []string{
+		"ustrd",
+		"transfer/channel-0/uosmo",
+		"transfer/channel-50/uosmo",
+		"transfer/channel-0/ujunox",
+	}
+
+	for _, denomTrace := range blacklistedTraces {
+		s.Require().True(s.App.RatelimitKeeper.IsDenomTraceBlacklisted(s.Ctx, denomTrace), "%s should be blacklisted", denomTrace)
+	}
+	for _, denomTrace := range allowedTraces {
+		s.Require().False(s.App.RatelimitKeeper.IsDenomTraceBlacklisted(s.Ctx, denomTrace), "%s should not be blacklisted", denomTrace)
+	}
+
+	// Remove the base denom pattern and confirm uatom is allowed again
+	s.App.RatelimitKeeper.RemoveDenomPatternFromBlacklist(s.Ctx, baseDenomPattern)
+	s.Require().False(s.App.RatelimitKeeper.IsDenomTraceBlacklisted(s.Ctx, "transfer/channel-0/uatom"), "uatom after removal")
+	s.Require().Len(s.App.RatelimitKeeper.GetAllBlacklistedDenomPatterns(s.Ctx), 2, "number of patterns after removal")
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
@@ -25,3 +26,58 @@ func EmitTransferDeniedEvent(ctx sdk.Context, reason, denom, channelOrClientId s
 		),
 	)
 }
+
+// If a rate limit was created from the new denom policy, we emit an event
+func EmitRateLimitAutoCreatedEvent(ctx sdk.Context, denom, channelOrClientId string, quota types.Quota) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRateLimitAutoCreated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyChannelOrClient, channelOrClientId),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, quota.MaxPercentSend.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, quota.MaxPercentRecv.String()),
+			sdk.NewAttribute(types.AttributeKeyDurationHours, fmt.Sprintf("%d", quota.DurationHours)),
+		),
+	)
+}
+
+// If a whitelisted transfer was deducted from the pair's amount cap, we emit an event
+func EmitWhitelistAmountCapDecrementedEvent(ctx sdk.Context, whitelist types.WhitelistedAddressPair, amount sdk.Coin, remainingCap sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventWhitelistAmountCapDecremented,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, whitelist.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, whitelist.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingAmountCap, remainingCap.String()),
+		),
+	)
+}
+
+// If a failed whitelisted transfer was restored to the pair's amount cap, we emit an event
+func EmitWhitelistAmountCapRestoredEvent(ctx sdk.Context, whitelist types.WhitelistedAddressPair, amount sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventWhitelistAmountCapRestored,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, whitelist.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, whitelist.Receiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingAmountCap, whitelist.RemainingAmountCap.String()),
+		),
+	)
+}
+
+// If a whitelisted pair was removed after expiring, we emit an event
+func EmitWhitelistExpiredEvent(ctx sdk.Context, whitelist types.WhitelistedAddressPair) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventWhitelistExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, whitelist.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, whitelist.Receiver),
+		),
+	)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -8,6 +10,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 )
 
 // The total value on a given path (aka, the denominator in the percentage calculation)
@@ -16,6 +20,23 @@ func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) sdkmath.Int {
 	return k.bankKeeper.GetSupply(ctx, denom).Amount
 }
 
+// Returns the channel value to use when a rate limit's flow is (re)initialized
+// While an IBC denom has no supply yet (e.g. before its first voucher is minted), the
+// total supply would make the quota unenforceable, so the initial channel value of the
+// new denom policy is used instead, unless new denoms are allowed through
+func (k Keeper) GetRateLimitChannelValue(ctx sdk.Context, denom string) sdkmath.Int {
+	channelValue := k.GetChannelValue(ctx, denom)
+	if !channelValue.IsZero() || !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return channelValue
+	}
+
+	policy := k.GetNewDenomPolicy(ctx)
+	if policy.Action == types.NEW_DENOM_ALLOW || policy.InitialChannelValue.IsNil() {
+		return channelValue
+	}
+	return policy.InitialChannelValue
+}
+
 // Adds an amount to the flow in either the SEND or RECV direction
 func (k Keeper) UpdateFlow(rateLimit types.RateLimit, direction types.PacketDirection, amount sdkmath.Int) error {
 	switch direction {
@@ -46,6 +67,13 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 		return false, err
 	}
 
+	// Then check if the full denom trace matches any of the blacklisted patterns
+	if k.IsDenomTraceBlacklisted(ctx, packetInfo.DenomTrace) {
+		err := errorsmod.Wrapf(types.ErrDenomIsBlacklisted, "denom %s (%s) matches a blacklisted pattern", denom, packetInfo.DenomTrace)
+		EmitTransferDeniedEvent(ctx, types.EventBlacklistedDenom, denom, channelOrClientId, direction, amount, err)
+		return false, err
+	}
+
 	// If there's no rate limit yet for this denom, no action is necessary
 	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientId)
 	if !found {
@@ -53,8 +81,9 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 	}
 
 	// Check if the sender/receiver pair is whitelisted
-	// If so, return a success without modifying the quota
-	if k.IsAddressPairWhitelisted(ctx, packetInfo.Sender, packetInfo.Receiver) {
+	// If so, deduct the transfer from the pair's cap and return a success without modifying the quota
+	if k.IsAddressPairWhitelisted(ctx, packetInfo.Sender, packetInfo.Receiver, sdk.Coin{Denom: denom, Amount: amount}) {
+		k.DecrementWhitelistAmountCap(ctx, direction, packetInfo)
 		return false, nil
 	}
 
@@ -20,6 +20,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, denom := range genState.BlacklistedDenoms {
 		k.AddDenomToBlacklist(ctx, denom)
 	}
+	for _, pattern := range genState.BlacklistedDenomPatterns {
+		k.AddDenomPatternToBlacklist(ctx, pattern)
+	}
+
+	// Set the new denom policy and flag any rate limits that were created from it
+	k.SetNewDenomPolicy(ctx, genState.NewDenomPolicy)
+	for _, path := range genState.AutoCreatedRateLimits {
+		k.SetAutoCreatedRateLimit(ctx, path.Denom, path.ChannelOrClientId)
+	}
 	for _, addressPair := range genState.WhitelistedAddressPairs {
 		k.SetWhitelistedAddressPair(ctx, addressPair)
 	}
@@ -32,6 +41,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetPendingSendPacket(ctx, channelOrClientId, sequence)
 	}
+	for _, pendingTransfer := range genState.PendingWhitelistedTransfers {
+		k.SetPendingWhitelistedTransfer(ctx, pendingTransfer)
+	}
 
 	// If the hour epoch has been initialized already (epoch number != 0), validate and then use it
 	if genState.HourEpoch.EpochNumber > 0 {
@@ -53,8 +65,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.RateLimits = k.GetAllRateLimits(ctx)
 	genesis.BlacklistedDenoms = k.GetAllBlacklistedDenoms(ctx)
+	genesis.BlacklistedDenomPatterns = k.GetAllBlacklistedDenomPatterns(ctx)
+	genesis.NewDenomPolicy = k.GetNewDenomPolicy(ctx)
+	genesis.AutoCreatedRateLimits = k.GetAllAutoCreatedRateLimitPaths(ctx)
 	genesis.WhitelistedAddressPairs = k.GetAllWhitelistedAddressPairs(ctx)
 	genesis.PendingSendPacketSequenceNumbers = k.GetAllPendingSendPackets(ctx)
+	genesis.PendingWhitelistedTransfers = k.GetAllPendingWhitelistedTransfers(ctx)
 	genesis.HourEpoch = k.GetHourEpoch(ctx)
 
 	return genesis
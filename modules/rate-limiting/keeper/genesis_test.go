@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 
 	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func createRateLimits() []types.RateLimit {
@@ -29,6 +31,7 @@ func (s *KeeperTestSuite) TestGenesis() {
 	blockTime := time.Date(2024, 1, 1, currentHour, 55, 8, 0, time.UTC)            // 13:55:08
 	defaultEpochStartTime := time.Date(2024, 1, 1, currentHour, 0, 0, 0, time.UTC) // 13:00:00 (truncated to hour)
 	blockHeight := int64(10)
+	whitelistCap := sdk.NewCoins(sdk.NewInt64Coin("denom-1", 1000), sdk.NewInt64Coin("denom-2", 500))
 
 	testCases := []struct {
 		name          string
@@ -47,7 +50,7 @@ func (s *KeeperTestSuite) TestGenesis() {
 				RateLimits: createRateLimits(),
 				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
 					{Sender: "senderA", Receiver: "receiverA"},
-					{Sender: "senderB", Receiver: "receiverB"},
+					{Sender: "senderB", Receiver: "receiverB", ExpirationHeight: 100, RemainingAmountCap: whitelistCap},
 				},
 				BlacklistedDenoms:                []string{"denomA", "denomB"},
 				PendingSendPacketSequenceNumbers: []string{"channel-0/1", "channel-2/3"},
@@ -57,6 +60,32 @@ func (s *KeeperTestSuite) TestGenesis() {
 					Duration:         time.Minute,
 					EpochStartHeight: 1,
 				},
+				BlacklistedDenomPatterns: []types.DenomBlacklistPattern{
+					{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+					{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+				},
+				NewDenomPolicy: types.NewDenomPolicy{
+					Action: types.NEW_DENOM_AUTO_CREATE,
+					Quota: types.Quota{
+						MaxPercentSend: sdkmath.NewInt(10),
+						MaxPercentRecv: sdkmath.NewInt(10),
+						DurationHours:  24,
+					},
+					InitialChannelValue: sdkmath.NewInt(1_000_000),
+				},
+				AutoCreatedRateLimits: []types.Path{
+					{Denom: "denom-1", ChannelOrClientId: "channel-1"},
+				},
+				PendingWhitelistedTransfers: []types.PendingWhitelistedTransfer{
+					{
+						ChannelOrClientId: "channel-0",
+						Sequence:          1,
+						Sender:            "senderB",
+						Receiver:          "receiverB",
+						Amount:            sdk.NewInt64Coin("denom-1", 100),
+						ExpirationHeight:  100,
+					},
+				},
 			},
 			firstEpoch: false,
 		},
@@ -85,6 +85,27 @@ func (k Keeper) AllBlacklistedDenoms(c context.Context, req *types.QueryAllBlack
 	return &types.QueryAllBlacklistedDenomsResponse{Denoms: blacklistedDenoms}, nil
 }
 
+// Query all blacklisted denom patterns
+func (k Keeper) AllBlacklistedDenomPatterns(c context.Context, req *types.QueryAllBlacklistedDenomPatternsRequest) (*types.QueryAllBlacklistedDenomPatternsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	blacklistedPatterns := k.GetAllBlacklistedDenomPatterns(ctx)
+	return &types.QueryAllBlacklistedDenomPatternsResponse{Patterns: blacklistedPatterns}, nil
+}
+
+// Query the policy applied to IBC denoms received without a rate limit
+func (k Keeper) NewDenomPolicy(c context.Context, req *types.QueryNewDenomPolicyRequest) (*types.QueryNewDenomPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	policy := k.GetNewDenomPolicy(ctx)
+	return &types.QueryNewDenomPolicyResponse{Policy: policy}, nil
+}
+
+// Query all rate limits that were created from the new denom policy
+func (k Keeper) AllAutoCreatedRateLimits(c context.Context, req *types.QueryAllAutoCreatedRateLimitsRequest) (*types.QueryAllAutoCreatedRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimits := k.GetAllAutoCreatedRateLimits(ctx)
+	return &types.QueryAllAutoCreatedRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
 // Query all whitelisted addresses
 func (k Keeper) AllWhitelistedAddresses(c context.Context, req *types.QueryAllWhitelistedAddressesRequest) (*types.QueryAllWhitelistedAddressesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
@@ -100,6 +102,50 @@ func (s *KeeperTestSuite) TestQueryAllBlacklistedDenoms() {
 	s.Require().Equal([]string{"denom-A", "denom-B"}, queryResponse.Denoms)
 }
 
+func (s *KeeperTestSuite) TestQueryAllBlacklistedDenomPatterns() {
+	patterns := []types.DenomBlacklistPattern{
+		{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+		{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+	}
+	for _, pattern := range patterns {
+		s.App.RatelimitKeeper.AddDenomPatternToBlacklist(s.Ctx, pattern)
+	}
+
+	queryResponse, err := s.QueryClient.AllBlacklistedDenomPatterns(context.Background(), &types.QueryAllBlacklistedDenomPatternsRequest{})
+	s.Require().NoError(err, "no error expected when querying blacklisted denom patterns")
+	s.Require().Equal(patterns, queryResponse.Patterns)
+}
+
+func (s *KeeperTestSuite) TestQueryNewDenomPolicy() {
+	policy := types.NewDenomPolicy{
+		Action: types.NEW_DENOM_BLOCK,
+		Quota: types.Quota{
+			MaxPercentSend: sdkmath.ZeroInt(),
+			MaxPercentRecv: sdkmath.ZeroInt(),
+		},
+		InitialChannelValue: sdkmath.NewInt(1000),
+	}
+	s.App.RatelimitKeeper.SetNewDenomPolicy(s.Ctx, policy)
+
+	queryResponse, err := s.QueryClient.NewDenomPolicy(context.Background(), &types.QueryNewDenomPolicyRequest{})
+	s.Require().NoError(err, "no error expected when querying new denom policy")
+	s.Require().Equal(policy, queryResponse.Policy)
+}
+
+func (s *KeeperTestSuite) TestQueryAllAutoCreatedRateLimits() {
+	rateLimits := s.setupQueryRateLimitTests()
+
+	// Flag the first rate limit as auto-created, and add a flag for a rate limit that no
+	// longer exists, which should be excluded from the response
+	autoCreatedRateLimit := rateLimits[0]
+	s.App.RatelimitKeeper.SetAutoCreatedRateLimit(s.Ctx, autoCreatedRateLimit.Path.Denom, autoCreatedRateLimit.Path.ChannelOrClientId)
+	s.App.RatelimitKeeper.SetAutoCreatedRateLimit(s.Ctx, "removed-denom", "channel-100")
+
+	queryResponse, err := s.QueryClient.AllAutoCreatedRateLimits(context.Background(), &types.QueryAllAutoCreatedRateLimitsRequest{})
+	s.Require().NoError(err, "no error expected when querying auto-created rate limits")
+	s.Require().Equal([]types.RateLimit{autoCreatedRateLimit}, queryResponse.RateLimits)
+}
+
 func (s *KeeperTestSuite) TestQueryAllWhitelistedAddresses() {
 	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
 		Sender:   "address-A",
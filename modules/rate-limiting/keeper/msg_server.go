@@ -79,3 +79,15 @@ func (k msgServer) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRate
 
 	return &types.MsgResetRateLimitResponse{}, nil
 }
+
+// Sets the policy for IBC denoms that are received without a rate limit
+func (k msgServer) SetNewDenomPolicy(goCtx context.Context, msg *types.MsgSetNewDenomPolicy) (*types.MsgSetNewDenomPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.Keeper.SetNewDenomPolicy(ctx, msg.Policy())
+
+	return &types.MsgSetNewDenomPolicyResponse{}, nil
+}
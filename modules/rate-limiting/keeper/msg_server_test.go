@@ -201,3 +201,39 @@ func (s *KeeperTestSuite) TestMsgServer_ResetRateLimit() {
 		ChannelValue: channelValue,
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServer_SetNewDenomPolicy() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	// The policy should default to allowing new denoms
+	s.Require().Equal(types.DefaultNewDenomPolicy(), s.App.RatelimitKeeper.GetNewDenomPolicy(s.Ctx), "default policy")
+
+	msg := types.MsgSetNewDenomPolicy{
+		Authority:           authority,
+		Action:              types.NEW_DENOM_AUTO_CREATE,
+		MaxPercentSend:      sdkmath.NewInt(10),
+		MaxPercentRecv:      sdkmath.NewInt(20),
+		DurationHours:       24,
+		InitialChannelValue: sdkmath.NewInt(1_000_000),
+	}
+
+	// Attempt to set the policy from a non-authority address
+	invalidMsg := msg
+	invalidMsg.Authority = "invalid"
+	_, err := msgServer.SetNewDenomPolicy(s.Ctx, &invalidMsg)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// Set the policy successfully
+	_, err = msgServer.SetNewDenomPolicy(s.Ctx, &msg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(types.NewDenomPolicy{
+		Action: types.NEW_DENOM_AUTO_CREATE,
+		Quota: types.Quota{
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(20),
+			DurationHours:  24,
+		},
+		InitialChannelValue: sdkmath.NewInt(1_000_000),
+	}, s.App.RatelimitKeeper.GetNewDenomPolicy(s.Ctx), "updated policy")
+}
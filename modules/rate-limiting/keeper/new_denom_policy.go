@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// Stores the policy that's applied to IBC denoms received without a rate limit
+func (k Keeper) SetNewDenomPolicy(ctx sdk.Context, policy types.NewDenomPolicy) {
+	store := k.storeService.OpenKVStore(ctx)
+	value := k.cdc.MustMarshal(&policy)
+	if err := store.Set(types.NewDenomPolicyKey, value); err != nil {
+		panic(err)
+	}
+}
+
+// Reads the new denom policy from the store
+// If the policy has not been set, new denoms are allowed through
+func (k Keeper) GetNewDenomPolicy(ctx sdk.Context) types.NewDenomPolicy {
+	store := k.storeService.OpenKVStore(ctx)
+
+	policyBz, err := store.Get(types.NewDenomPolicyKey)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(policyBz) == 0 {
+		return types.DefaultNewDenomPolicy()
+	}
+
+	policy := types.NewDenomPolicy{}
+	k.cdc.MustUnmarshal(policyBz, &policy)
+	return policy
+}
+
+// Flags a rate limit as having been created from the new denom policy
+func (k Keeper) SetAutoCreatedRateLimit(ctx sdk.Context, denom, channelOrClientId string) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.AutoCreatedRateLimitKeyPrefix)
+
+	path := types.Path{Denom: denom, ChannelOrClientId: channelOrClientId}
+	key := types.GetRateLimitItemKey(denom, channelOrClientId)
+	store.Set(key, k.cdc.MustMarshal(&path))
+}
+
+// Removes the auto-created flag from a rate limit (e.g. after it's updated or removed by governance)
+func (k Keeper) RemoveAutoCreatedRateLimit(ctx sdk.Context, denom, channelOrClientId string) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.AutoCreatedRateLimitKeyPrefix)
+	key := types.GetRateLimitItemKey(denom, channelOrClientId)
+	store.Delete(key)
+}
+
+// Checks whether a rate limit was created from the new denom policy
+func (k Keeper) IsRateLimitAutoCreated(ctx sdk.Context, denom, channelOrClientId string) bool {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.AutoCreatedRateLimitKeyPrefix)
+	key := types.GetRateLimitItemKey(denom, channelOrClientId)
+	return len(store.Get(key)) != 0
+}
+
+// Returns the paths of all rate limits that were created from the new denom policy
+func (k Keeper) GetAllAutoCreatedRateLimitPaths(ctx sdk.Context) []types.Path {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.AutoCreatedRateLimitKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allPaths := []types.Path{}
+	for ; iterator.Valid(); iterator.Next() {
+		path := types.Path{}
+		k.cdc.MustUnmarshal(iterator.Value(), &path)
+		allPaths = append(allPaths, path)
+	}
+
+	return allPaths
+}
+
+// Returns all rate limits that were created from the new denom policy
+func (k Keeper) GetAllAutoCreatedRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	for _, path := range k.GetAllAutoCreatedRateLimitPaths(ctx) {
+		rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId)
+		if found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	return rateLimits
+}
+
+// Applies the new denom policy to an incoming packet, the first time an IBC denom is
+// received on a channel or client that does not have a rate limit
+// Depending on the policy, a rate limit is either created from the quota template,
+// or the packet is rejected until governance adds a rate limit
+//
+// Native tokens returning to this chain are not subject to the policy
+func (k Keeper) ApplyNewDenomPolicy(ctx sdk.Context, packetInfo RateLimitedPacketInfo) error {
+	policy := k.GetNewDenomPolicy(ctx)
+	if policy.Action == types.NEW_DENOM_ALLOW {
+		return nil
+	}
+
+	if !strings.HasPrefix(packetInfo.Denom, transfertypes.DenomPrefix+"/") {
+		return nil
+	}
+
+	if _, found := k.GetRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID); found {
+		return nil
+	}
+
+	switch policy.Action {
+	case types.NEW_DENOM_BLOCK:
+		err := errorsmod.Wrapf(types.ErrNewDenomBlocked, "denom %s (%s) has no rate limit on %s",
+			packetInfo.Denom, packetInfo.DenomTrace, packetInfo.ChannelID)
+		EmitTransferDeniedEvent(ctx, types.EventNewDenomBlocked, packetInfo.Denom, packetInfo.ChannelID,
+			types.PACKET_RECV, packetInfo.Amount, err)
+		return err
+
+	case types.NEW_DENOM_AUTO_CREATE:
+		// The voucher has likely not been minted yet, in which case the policy's initial channel
+		// value is used so that the quota is enforced from this first packet
+		quota := policy.Quota
+		flow := types.NewFlow(k.GetRateLimitChannelValue(ctx, packetInfo.Denom))
+		path := types.Path{
+			Denom:             packetInfo.Denom,
+			ChannelOrClientId: packetInfo.ChannelID,
+		}
+
+		k.SetRateLimit(ctx, types.RateLimit{
+			Path:  &path,
+			Quota: &quota,
+			Flow:  &flow,
+		})
+		k.SetAutoCreatedRateLimit(ctx, packetInfo.Denom, packetInfo.ChannelID)
+
+		EmitRateLimitAutoCreatedEvent(ctx, packetInfo.Denom, packetInfo.ChannelID, quota)
+		k.Logger(ctx).Info(fmt.Sprintf("Auto-created rate limit for Denom: %s, ChannelOrClientId: %s", packetInfo.Denom, packetInfo.ChannelID))
+		return nil
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// Helper function to build a packet that transfers uosmo from osmosis to stride
+func (s *KeeperTestSuite) createNewDenomRecvPacket(packetDenom string) channeltypes.Packet {
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: packetDenom, Amount: "5"})
+	s.Require().NoError(err)
+
+	return channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      channelOnHost,
+		DestinationPort:    transferPort,
+		DestinationChannel: channelOnStride,
+		Data:               packetData,
+	}
+}
+
+// Helper function to confirm an event of the given type was emitted
+func (s *KeeperTestSuite) checkEventTypeEmitted(eventType string) {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	s.Fail(fmt.Sprintf("%s event not emitted", eventType))
+}
+
+func (s *KeeperTestSuite) TestApplyNewDenomPolicy_Allow() {
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, channelOnStride, uosmo))
+
+	// With the default policy, the packet should be accepted without a rate limit being created
+	err := s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().NoError(err, "no error expected when receiving packet")
+
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, rateLimitDenom, channelOnStride)
+	s.Require().False(found, "rate limit should not have been created")
+}
+
+func (s *KeeperTestSuite) TestApplyNewDenomPolicy_AutoCreate() {
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, channelOnStride, uosmo))
+	quota := types.Quota{
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(20),
+		DurationHours:  24,
+	}
+	s.App.RatelimitKeeper.SetNewDenomPolicy(s.Ctx, types.NewDenomPolicy{
+		Action:              types.NEW_DENOM_AUTO_CREATE,
+		Quota:               quota,
+		InitialChannelValue: sdkmath.NewInt(100),
+	})
+
+	// Receive the packet, the rate limit should be created and the inflow should be tracked
+	err := s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().NoError(err, "no error expected when receiving packet")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, rateLimitDenom, channelOnStride)
+	s.Require().True(found, "rate limit should have been created")
+	s.Require().Equal(quota, *rateLimit.Quota, "rate limit quota")
+	s.Require().Equal(int64(5), rateLimit.Flow.Inflow.Int64(), "rate limit inflow")
+
+	// Since the voucher has no supply yet, the initial channel value should be used,
+	// so that the quota is enforced before the first reset
+	s.Require().Equal(int64(100), rateLimit.Flow.ChannelValue.Int64(), "rate limit channel value")
+
+	// Confirm it's flagged as auto-created and the event was emitted
+	s.Require().True(s.App.RatelimitKeeper.IsRateLimitAutoCreated(s.Ctx, rateLimitDenom, channelOnStride), "auto-created flag")
+	s.Require().Equal([]types.RateLimit{rateLimit}, s.App.RatelimitKeeper.GetAllAutoCreatedRateLimits(s.Ctx), "auto-created rate limits")
+	s.checkEventTypeEmitted(types.EventRateLimitAutoCreated)
+
+	for i := 0; i < 3; i++ {
+		err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+		s.Require().NoError(err, "no error expected when receiving packet %d within quota", i)
+	}
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "inflow above 20%% of the initial channel value")
+
+	// Once governance updates the rate limit, it should no longer be flagged
+	s.App.RatelimitKeeper.SetNewDenomPolicy(s.Ctx, types.DefaultNewDenomPolicy())
+	s.createChannel(channelOnStride)
+	err = s.App.RatelimitKeeper.UpdateRateLimit(s.Ctx, &types.MsgUpdateRateLimit{
+		Denom:             rateLimitDenom,
+		ChannelOrClientId: channelOnStride,
+		MaxPercentSend:    sdkmath.NewInt(50),
+		MaxPercentRecv:    sdkmath.NewInt(50),
+		DurationHours:     1,
+	})
+	s.Require().NoError(err, "no error expected when updating rate limit")
+	s.Require().False(s.App.RatelimitKeeper.IsRateLimitAutoCreated(s.Ctx, rateLimitDenom, channelOnStride), "auto-created flag after update")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllAutoCreatedRateLimits(s.Ctx), "auto-created rate limits after update")
+}
+
+func (s *KeeperTestSuite) TestApplyNewDenomPolicy_Block() {
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, channelOnStride, uosmo))
+	s.App.RatelimitKeeper.SetNewDenomPolicy(s.Ctx, types.NewDenomPolicy{
+		Action: types.NEW_DENOM_BLOCK,
+		Quota: types.Quota{
+			MaxPercentSend: sdkmath.ZeroInt(),
+			MaxPercentRecv: sdkmath.ZeroInt(),
+		},
+		InitialChannelValue: sdkmath.NewInt(100),
+	})
+
+	// The packet should be rejected since there's no rate limit
+	err := s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().ErrorIs(err, types.ErrNewDenomBlocked, "error type")
+	s.checkEventTypeEmitted(types.EventTransferDenied)
+
+	// Native tokens returning home are not subject to the policy
+	nativeDenom := fmt.Sprintf("%s/%s/%s", transferPort, channelOnHost, ustrd)
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(nativeDenom))
+	s.Require().NoError(err, "no error expected when receiving native denom")
+
+	// Governance should be able to add a rate limit even though the blocked voucher was never minted
+	s.createChannel(channelOnStride)
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+	_, err = msgServer.AddRateLimit(s.Ctx, &types.MsgAddRateLimit{
+		Authority:         authority,
+		Denom:             rateLimitDenom,
+		ChannelOrClientId: channelOnStride,
+		MaxPercentSend:    sdkmath.NewInt(10),
+		MaxPercentRecv:    sdkmath.NewInt(10),
+		DurationHours:     1,
+	})
+	s.Require().NoError(err, "no error expected when adding rate limit for a blocked denom")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, rateLimitDenom, channelOnStride)
+	s.Require().True(found, "rate limit should have been added")
+	s.Require().Equal(int64(100), rateLimit.Flow.ChannelValue.Int64(), "rate limit channel value")
+
+	// Once the rate limit is added, the packet should be accepted, and the quota enforced
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().NoError(err, "no error expected after rate limit is added")
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().NoError(err, "no error expected within quota")
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, s.createNewDenomRecvPacket(uosmo))
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "inflow above 10%% of the initial channel value")
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit_ZeroSupplyWithoutPolicy() {
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, channelOnStride, uosmo))
+	s.createChannel(channelOnStride)
+
+	// Without a new denom policy, rate limits still can't be added for denoms without supply
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+	_, err := msgServer.AddRateLimit(s.Ctx, &types.MsgAddRateLimit{
+		Authority:         authority,
+		Denom:             rateLimitDenom,
+		ChannelOrClientId: channelOnStride,
+		MaxPercentSend:    sdkmath.NewInt(10),
+		MaxPercentRecv:    sdkmath.NewInt(10),
+		DurationHours:     1,
+	})
+	s.Require().ErrorIs(err, types.ErrZeroChannelValue)
+}
@@ -21,11 +21,13 @@ import (
 )
 
 type RateLimitedPacketInfo struct {
-	ChannelID string
-	Denom     string
-	Amount    sdkmath.Int
-	Sender    string
-	Receiver  string
+	ChannelID  string
+	Denom      string
+	DenomTrace string
+	Amount     sdkmath.Int
+	Sender     string
+	Receiver   string
+	Sequence   uint64
 }
 
 // CheckAcknowledementSucceeded unmarshals IBC Acknowledgements, and determines
@@ -118,31 +120,36 @@ func ParseDenomFromSendPacket(packet transfertypes.FungibleTokenPacketData) (den
 //	        -> Remove Prefix: transfer/channel-Z/ujuno
 //	        -> Hash:          ibc/...
 func ParseDenomFromRecvPacket(packet channeltypes.Packet, packetData transfertypes.FungibleTokenPacketData) (denom string) {
+	fullDenomPath := ParseDenomTraceFromRecvPacket(packet, packetData)
+
+	// Native assets will have an empty trace path and can be returned as is
+	denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
+	if denomTrace.Path() == "" {
+		denom = fullDenomPath
+	} else {
+		// Non-native assets should be hashed
+		denom = denomTrace.IBCDenom()
+	}
+
+	return denom
+}
+
+// Parse the full denom trace (before hashing) from the Recv Packet, as it will appear on this chain
+// See ParseDenomFromRecvPacket for a description of the source and sink cases
+//
+//	Sink:   uosmo sent from Osmosis to Stride -> transfer/channel-X/uosmo
+//	Source: ustrd sent back to Stride from Osmosis -> ustrd
+func ParseDenomTraceFromRecvPacket(packet channeltypes.Packet, packetData transfertypes.FungibleTokenPacketData) (denomTrace string) {
 	// To determine the denom, first check whether Stride is acting as source
 	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetData.Denom) {
 		// Remove the source prefix (e.g. transfer/channel-X/transfer/channel-Z/ujuno -> transfer/channel-Z/ujuno)
 		sourcePrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := packetData.Denom[len(sourcePrefix):]
-
-		// Native assets will have an empty trace path and can be returned as is
-		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
-		if denomTrace.Path() == "" {
-			denom = unprefixedDenom
-		} else {
-			// Non-native assets should be hashed
-			denom = denomTrace.IBCDenom()
-		}
-	} else {
-		// Prefix the destination channel - this will contain the trailing slash (e.g. transfer/channel-X/)
-		destinationPrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-		prefixedDenom := destinationPrefix + packetData.Denom
-
-		// Hash the denom trace
-		denomTrace := transfertypes.ParseDenomTrace(prefixedDenom)
-		denom = denomTrace.IBCDenom()
+		return packetData.Denom[len(sourcePrefix):]
 	}
 
-	return denom
+	// Prefix the destination channel - this will contain the trailing slash (e.g. transfer/channel-X/)
+	destinationPrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return destinationPrefix + packetData.Denom
 }
 
 // Parses the sender and channelId and denom for the corresponding RateLimit object, and
@@ -163,13 +170,15 @@ func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection
 		return RateLimitedPacketInfo{}, err
 	}
 
-	var channelID, denom string
+	var channelID, denom, denomTrace string
 	if direction == types.PACKET_SEND {
 		channelID = packet.GetSourceChannel()
 		denom = ParseDenomFromSendPacket(packetData)
+		denomTrace = packetData.Denom
 	} else {
 		channelID = packet.GetDestChannel()
 		denom = ParseDenomFromRecvPacket(packet, packetData)
+		denomTrace = ParseDenomTraceFromRecvPacket(packet, packetData)
 	}
 
 	amount, ok := sdkmath.NewIntFromString(packetData.Amount)
@@ -179,11 +188,13 @@ func ParsePacketInfo(packet channeltypes.Packet, direction types.PacketDirection
 	}
 
 	packetInfo := RateLimitedPacketInfo{
-		ChannelID: channelID,
-		Denom:     denom,
-		Amount:    amount,
-		Sender:    packetData.Sender,
-		Receiver:  packetData.Receiver,
+		ChannelID:  channelID,
+		Denom:      denom,
+		DenomTrace: denomTrace,
+		Amount:     amount,
+		Sender:     packetData.Sender,
+		Receiver:   packetData.Receiver,
+		Sequence:   packet.Sequence,
 	}
 
 	return packetInfo, nil
@@ -220,6 +231,12 @@ func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 		return err
 	}
 
+	// If this is the first time the denom is seen on this channel, either create
+	// a rate limit from the governance template or reject the packet
+	if err := k.ApplyNewDenomPolicy(ctx, packetInfo); err != nil {
+		return err
+	}
+
 	_, err = k.CheckRateLimitAndUpdateFlow(ctx, types.PACKET_RECV, packetInfo)
 	return err
 }
@@ -242,21 +259,24 @@ func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltype
 	// If the ack was successful, remove the pending packet
 	if ackSuccess {
 		k.RemovePendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		k.RemovePendingWhitelistedTransfer(ctx, packetInfo.ChannelID, packet.Sequence)
 		return nil
 	}
 
-	// If the ack failed, undo the change to the rate limit Outflow
+	// If the ack failed, undo the change to the rate limit Outflow or the whitelist cap
+	k.RestoreWhitelistAmountCap(ctx, packetInfo.ChannelID, packet.Sequence)
 	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
 }
 
 // Middleware implementation for OnAckPacket with rate limiting
-// The Outflow should be decremented from the failed packet
+// The Outflow or whitelist cap should be restored from the failed packet
 func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	packetInfo, err := ParsePacketInfo(packet, types.PACKET_SEND)
 	if err != nil {
 		return err
 	}
 
+	k.RestoreWhitelistAmountCap(ctx, packetInfo.ChannelID, packet.Sequence)
 	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
 }
 
@@ -12,6 +12,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -178,11 +180,12 @@ func (s *KeeperTestSuite) TestParsePacketInfo() {
 	// Send 'denom' from channel-100 (stride) -> channel-200
 	// Since the 'denom' is native, it's kept as is for the rate limit object
 	expectedSendPacketInfo := keeper.RateLimitedPacketInfo{
-		ChannelID: sourceChannel,
-		Denom:     denom,
-		Amount:    amountInt,
-		Sender:    sender,
-		Receiver:  receiver,
+		ChannelID:  sourceChannel,
+		Denom:      denom,
+		DenomTrace: denom,
+		Amount:     amountInt,
+		Sender:     sender,
+		Receiver:   receiver,
 	}
 	actualSendPacketInfo, err := keeper.ParsePacketInfo(packet, types.PACKET_SEND)
 	s.Require().NoError(err, "no error expected when parsing send packet")
@@ -191,11 +194,12 @@ func (s *KeeperTestSuite) TestParsePacketInfo() {
 	// Receive 'denom' from channel-100 -> channel-200 (stride)
 	// The stride channel (channel-200) should be tacked onto the end and the denom should be hashed
 	expectedRecvPacketInfo := keeper.RateLimitedPacketInfo{
-		ChannelID: destinationChannel,
-		Denom:     hashDenomTrace(fmt.Sprintf("transfer/%s/%s", destinationChannel, denom)),
-		Amount:    amountInt,
-		Sender:    sender,
-		Receiver:  receiver,
+		ChannelID:  destinationChannel,
+		Denom:      hashDenomTrace(fmt.Sprintf("transfer/%s/%s", destinationChannel, denom)),
+		DenomTrace: fmt.Sprintf("transfer/%s/%s", destinationChannel, denom),
+		Amount:     amountInt,
+		Sender:     sender,
+		Receiver:   receiver,
 	}
 	actualRecvPacketInfo, err := keeper.ParsePacketInfo(packet, types.PACKET_RECV)
 	s.Require().NoError(err, "no error expected when parsing recv packet")
@@ -306,6 +310,31 @@ func (s *KeeperTestSuite) TestReceiveRateLimitedPacket() {
 	s.Require().ErrorContains(err, "Inflow exceeds quota", "error text")
 }
 
+func (s *KeeperTestSuite) TestReceiveRateLimitedPacket_BlacklistedPattern() {
+	// Blacklist every uosmo voucher, regardless of the path it took
+	s.App.RatelimitKeeper.AddDenomPatternToBlacklist(s.Ctx, types.DenomBlacklistPattern{
+		MatchType: types.BLACKLIST_BASE_DENOM,
+		Pattern:   uosmo,
+	})
+
+	// uosmo that has hopped through juno first should still be rejected, even though
+	// there's no rate limit and the hashed denom was never blacklisted
+	packetDenom := fmt.Sprintf("%s/%s/%s", transferPort, "channel-200", uosmo)
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: packetDenom, Amount: "5"})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      channelOnHost,
+		DestinationPort:    transferPort,
+		DestinationChannel: channelOnStride,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrDenomIsBlacklisted, "error type")
+	s.Require().ErrorContains(err, fmt.Sprintf("%s/%s/%s", transferPort, channelOnStride, packetDenom), "error text")
+}
+
 func (s *KeeperTestSuite) TestAcknowledgeRateLimitedPacket_AckSuccess() {
 	// For ack packets, the source will be stride and the destination will be the host
 	denom := ustrd
@@ -491,3 +520,47 @@ func (s *KeeperTestSuite) TestTimeoutRateLimitedPacket() {
 	s.Require().True(found)
 	s.Require().Equal(expectedOutflow.Int64(), rateLimit.Flow.Outflow.Int64(), "outflow should not have changed")
 }
+
+func (s *KeeperTestSuite) TestTimeoutRateLimitedPacket_RestoresWhitelistAmountCap() {
+	denom := ustrd
+	sourceChannel := channelOnStride
+	destinationChannel := channelOnHost
+	sequence := uint64(10)
+	sender := "sender"
+	receiver := "receiver"
+
+	// Create a rate limit that would be exceeded by the transfer, and whitelist the pair with a cap
+	s.createRateLimitCloseToQuota(denom, sourceChannel, types.PACKET_SEND)
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: sender, Receiver: receiver, RemainingAmountCap: sdk.NewCoins(sdk.NewInt64Coin(denom, 5)),
+	})
+
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "5", Sender: sender, Receiver: receiver})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+		Sequence:           sequence,
+	}
+
+	// The send should bypass the quota and use up the cap
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when sending whitelisted packet")
+
+	_, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, sender, receiver)
+	s.Require().False(found, "pair should have been removed once the cap was used up")
+
+	// After the timeout, the cap should be restored so the transfer can be retried
+	err = s.App.RatelimitKeeper.TimeoutRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "no error expected when calling timeout packet")
+
+	whitelist, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, sender, receiver)
+	s.Require().True(found, "pair should have been restored")
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 5)), whitelist.RemainingAmountCap, "restored cap")
+
+	_, found = s.App.RatelimitKeeper.GetPendingWhitelistedTransfer(s.Ctx, sourceChannel, sequence)
+	s.Require().False(found, "pending whitelisted transfer should have been removed")
+}
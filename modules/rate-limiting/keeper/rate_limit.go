@@ -30,6 +30,8 @@ func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom string, channelId string)
 	store := prefix.NewStore(adapter, types.RateLimitKeyPrefix)
 	rateLimitKey := types.GetRateLimitItemKey(denom, channelId)
 	store.Delete(rateLimitKey)
+
+	k.RemoveAutoCreatedRateLimit(ctx, denom, channelId)
 }
 
 // Grabs and returns a rate limit object from the store using denom and channel-id
@@ -68,9 +70,11 @@ func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
 }
 
 // Adds a new rate limit. Fails if the rate limit already exists or the channel value is 0
+// IBC denoms without supply, such as those blocked by the new denom policy, use the
+// policy's initial channel value
 func (k Keeper) AddRateLimit(ctx sdk.Context, msg *types.MsgAddRateLimit) error {
 	// Confirm the channel value is not zero
-	channelValue := k.GetChannelValue(ctx, msg.Denom)
+	channelValue := k.GetRateLimitChannelValue(ctx, msg.Denom)
 	if channelValue.IsZero() {
 		return types.ErrZeroChannelValue
 	}
@@ -138,7 +142,7 @@ func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
 		Outflow:      sdkmath.ZeroInt(),
-		ChannelValue: k.GetChannelValue(ctx, msg.Denom),
+		ChannelValue: k.GetRateLimitChannelValue(ctx, msg.Denom),
 	}
 
 	k.SetRateLimit(ctx, types.RateLimit{
@@ -147,6 +151,9 @@ func (k Keeper) UpdateRateLimit(ctx sdk.Context, msg *types.MsgUpdateRateLimit)
 		Flow:  &flow,
 	})
 
+	// Once governance has updated the rate limit, it's no longer considered auto-created
+	k.RemoveAutoCreatedRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+
 	return nil
 }
 
@@ -162,7 +169,7 @@ func (k Keeper) ResetRateLimit(ctx sdk.Context, denom string, channelId string)
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
 		Outflow:      sdkmath.ZeroInt(),
-		ChannelValue: k.GetChannelValue(ctx, denom),
+		ChannelValue: k.GetRateLimitChannelValue(ctx, denom),
 	}
 	rateLimit.Flow = &flow
 
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,31 +13,179 @@ import (
 // Adds an pair of sender and receiver addresses to the whitelist to allow all
 // IBC transfers between those addresses to skip all flow calculations
 func (k Keeper) SetWhitelistedAddressPair(ctx sdk.Context, whitelist types.WhitelistedAddressPair) {
+	// If the pair is being updated, its previous expiration must be removed from the indexes
+	if existingWhitelist, found := k.GetWhitelistedAddressPair(ctx, whitelist.Sender, whitelist.Receiver); found {
+		k.removeWhitelistExpirationIndexes(ctx, existingWhitelist)
+	}
+
 	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(adapter, types.AddressWhitelistKeyPrefix)
 	key := types.GetAddressWhitelistKey(whitelist.Sender, whitelist.Receiver)
 	value := k.cdc.MustMarshal(&whitelist)
 	store.Set(key, value)
+
+	k.setWhitelistExpirationIndexes(ctx, whitelist)
 }
 
 // Removes a whitelisted address pair so that it's transfers are counted in the quota
+// Any amounts pending to be restored to the pair's cap from in-flight transfers are
+// discarded so that the pair is not added back when those transfers fail
 func (k Keeper) RemoveWhitelistedAddressPair(ctx sdk.Context, sender, receiver string) {
+	if whitelist, found := k.GetWhitelistedAddressPair(ctx, sender, receiver); found {
+		k.removeWhitelistedAddressPair(ctx, whitelist)
+	}
+
+	for _, pendingTransfer := range k.GetAllPendingWhitelistedTransfers(ctx) {
+		if pendingTransfer.Sender == sender && pendingTransfer.Receiver == receiver {
+			k.RemovePendingWhitelistedTransfer(ctx, pendingTransfer.ChannelOrClientId, pendingTransfer.Sequence)
+		}
+	}
+}
+
+// Removes a whitelisted address pair and its expiration indexes from the store
+func (k Keeper) removeWhitelistedAddressPair(ctx sdk.Context, whitelist types.WhitelistedAddressPair) {
 	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(adapter, types.AddressWhitelistKeyPrefix)
-	key := types.GetAddressWhitelistKey(sender, receiver)
+	key := types.GetAddressWhitelistKey(whitelist.Sender, whitelist.Receiver)
 	store.Delete(key)
+
+	k.removeWhitelistExpirationIndexes(ctx, whitelist)
 }
 
-// Check if a sender/receiver address pair is currently whitelisted
-func (k Keeper) IsAddressPairWhitelisted(ctx sdk.Context, sender, receiver string) bool {
+// Reads a whitelisted address pair from the store
+func (k Keeper) GetWhitelistedAddressPair(ctx sdk.Context, sender, receiver string) (whitelist types.WhitelistedAddressPair, found bool) {
+	return k.getWhitelistedAddressPair(ctx, types.GetAddressWhitelistKey(sender, receiver))
+}
+
+// Reads a whitelisted address pair from the store by its key
+func (k Keeper) getWhitelistedAddressPair(ctx sdk.Context, key []byte) (whitelist types.WhitelistedAddressPair, found bool) {
 	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(adapter, types.AddressWhitelistKeyPrefix)
 
-	key := types.GetAddressWhitelistKey(sender, receiver)
 	value := store.Get(key)
-	found := len(value) != 0
+	if len(value) == 0 {
+		return whitelist, false
+	}
+
+	k.cdc.MustUnmarshal(value, &whitelist)
+	return whitelist, true
+}
+
+// Adds the pair to the expiration height and time indexes, if it expires
+func (k Keeper) setWhitelistExpirationIndexes(ctx sdk.Context, whitelist types.WhitelistedAddressPair) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if whitelist.ExpirationHeight != 0 {
+		store := prefix.NewStore(adapter, types.WhitelistExpirationHeightKeyPrefix)
+		store.Set(types.GetWhitelistExpirationHeightKey(whitelist.ExpirationHeight, whitelist.Sender, whitelist.Receiver), []byte{1})
+	}
+	if whitelist.ExpirationTime != nil {
+		store := prefix.NewStore(adapter, types.WhitelistExpirationTimeKeyPrefix)
+		store.Set(types.GetWhitelistExpirationTimeKey(*whitelist.ExpirationTime, whitelist.Sender, whitelist.Receiver), []byte{1})
+	}
+}
+
+// Removes the pair from the expiration height and time indexes
+func (k Keeper) removeWhitelistExpirationIndexes(ctx sdk.Context, whitelist types.WhitelistedAddressPair) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if whitelist.ExpirationHeight != 0 {
+		store := prefix.NewStore(adapter, types.WhitelistExpirationHeightKeyPrefix)
+		store.Delete(types.GetWhitelistExpirationHeightKey(whitelist.ExpirationHeight, whitelist.Sender, whitelist.Receiver))
+	}
+	if whitelist.ExpirationTime != nil {
+		store := prefix.NewStore(adapter, types.WhitelistExpirationTimeKeyPrefix)
+		store.Delete(types.GetWhitelistExpirationTimeKey(*whitelist.ExpirationTime, whitelist.Sender, whitelist.Receiver))
+	}
+}
+
+// Check if a sender/receiver address pair is currently whitelisted for a transfer of the given amount
+// A pair is not considered whitelisted once it's expired, or if it has a cap and the amount
+// exceeds the remaining cap of the transfer's denom
+func (k Keeper) IsAddressPairWhitelisted(ctx sdk.Context, sender, receiver string, amount sdk.Coin) bool {
+	whitelist, found := k.GetWhitelistedAddressPair(ctx, sender, receiver)
+	if !found {
+		return false
+	}
+
+	// Expired pairs are pruned in the BeginBlocker, but they may still be in the store
+	// if they expired by time during this block
+	if whitelist.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return false
+	}
+
+	// If there's no cap, the transfer can bypass the quota regardless of the amount
+	if len(whitelist.RemainingAmountCap) == 0 {
+		return true
+	}
+
+	capFound, remainingCap := whitelist.RemainingAmountCap.Find(amount.Denom)
+	return capFound && amount.Amount.LTE(remainingCap.Amount)
+}
+
+// Deducts a whitelisted transfer from the remaining cap of its denom, if the pair has a cap
+// Once every denom's cap is used up, the pair is removed
+// For outbound transfers, the deduction is recorded against the pending packet so that it
+// can be restored if the packet fails or times out
+func (k Keeper) DecrementWhitelistAmountCap(ctx sdk.Context, direction types.PacketDirection, packetInfo RateLimitedPacketInfo) {
+	whitelist, found := k.GetWhitelistedAddressPair(ctx, packetInfo.Sender, packetInfo.Receiver)
+	if !found || len(whitelist.RemainingAmountCap) == 0 {
+		return
+	}
+
+	amount := sdk.Coin{Denom: packetInfo.Denom, Amount: packetInfo.Amount}
+	remainingCap := whitelist.RemainingAmountCap.Sub(amount)
+	EmitWhitelistAmountCapDecrementedEvent(ctx, whitelist, amount, remainingCap)
+
+	if remainingCap.Empty() {
+		k.removeWhitelistedAddressPair(ctx, whitelist)
+	} else {
+		whitelist.RemainingAmountCap = remainingCap
+		k.SetWhitelistedAddressPair(ctx, whitelist)
+	}
+
+	if direction == types.PACKET_SEND {
+		k.SetPendingWhitelistedTransfer(ctx, types.PendingWhitelistedTransfer{
+			ChannelOrClientId: packetInfo.ChannelID,
+			Sequence:          packetInfo.Sequence,
+			Sender:            whitelist.Sender,
+			Receiver:          whitelist.Receiver,
+			Amount:            amount,
+			ExpirationHeight:  whitelist.ExpirationHeight,
+			ExpirationTime:    whitelist.ExpirationTime,
+		})
+	}
+}
+
+// If a whitelisted SendPacket fails or times out, restore the amount it deducted from the pair's cap
+// If the transfer used up the cap, the pair is added back with just the restored amount,
+// unless it has expired in the meantime
+func (k Keeper) RestoreWhitelistAmountCap(ctx sdk.Context, channelOrClientId string, sequence uint64) {
+	pendingTransfer, found := k.GetPendingWhitelistedTransfer(ctx, channelOrClientId, sequence)
+	if !found {
+		return
+	}
+	k.RemovePendingWhitelistedTransfer(ctx, channelOrClientId, sequence)
+
+	whitelist, found := k.GetWhitelistedAddressPair(ctx, pendingTransfer.Sender, pendingTransfer.Receiver)
+	if !found {
+		whitelist = types.WhitelistedAddressPair{
+			Sender:           pendingTransfer.Sender,
+			Receiver:         pendingTransfer.Receiver,
+			ExpirationHeight: pendingTransfer.ExpirationHeight,
+			ExpirationTime:   pendingTransfer.ExpirationTime,
+		}
+		if whitelist.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+			return
+		}
+	} else if len(whitelist.RemainingAmountCap) == 0 {
+		// The pair is no longer capped, so there is nothing to restore
+		return
+	}
 
-	return found
+	whitelist.RemainingAmountCap = whitelist.RemainingAmountCap.Add(pendingTransfer.Amount)
+	k.SetWhitelistedAddressPair(ctx, whitelist)
+	EmitWhitelistAmountCapRestoredEvent(ctx, whitelist, pendingTransfer.Amount)
 }
 
 // Get all the whitelisted addresses
@@ -56,3 +205,94 @@ func (k Keeper) GetAllWhitelistedAddressPairs(ctx sdk.Context) []types.Whitelist
 
 	return allWhitelistedAddresses
 }
+
+// Removes all whitelisted address pairs that have passed their expiration height or time
+// Only the pairs that are due are visited, by iterating the expiration indexes up to
+// the current block height and time
+func (k Keeper) PruneExpiredWhitelistedAddressPairs(ctx sdk.Context) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	heightStore := prefix.NewStore(adapter, types.WhitelistExpirationHeightKeyPrefix)
+	timeStore := prefix.NewStore(adapter, types.WhitelistExpirationTimeKeyPrefix)
+
+	// Collect the keys first since the indexes are modified when the pairs are removed
+	expiredKeys := [][]byte{}
+
+	heightIterator := heightStore.Iterator(nil, types.GetWhitelistExpirationHeightPrefix(ctx.BlockHeight()+1))
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		whitelistKey := heightIterator.Key()[types.WhitelistExpirationHeightLength:]
+		expiredKeys = append(expiredKeys, append([]byte{}, whitelistKey...))
+	}
+	heightIterator.Close()
+
+	timeIterator := timeStore.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		whitelistKey := timeIterator.Key()[types.WhitelistExpirationTimeLength:]
+		expiredKeys = append(expiredKeys, append([]byte{}, whitelistKey...))
+	}
+	timeIterator.Close()
+
+	for _, key := range expiredKeys {
+		// A pair that expired by both height and time will have already been removed
+		whitelist, found := k.getWhitelistedAddressPair(ctx, key)
+		if !found {
+			continue
+		}
+		k.removeWhitelistedAddressPair(ctx, whitelist)
+		EmitWhitelistExpiredEvent(ctx, whitelist)
+	}
+}
+
+// Stores the amount an outbound whitelisted transfer deducted from its pair's cap
+func (k Keeper) SetPendingWhitelistedTransfer(ctx sdk.Context, pendingTransfer types.PendingWhitelistedTransfer) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingWhitelistedTransferKeyPrefix)
+	key := types.GetPendingSendPacketKey(pendingTransfer.ChannelOrClientId, pendingTransfer.Sequence)
+	value := k.cdc.MustMarshal(&pendingTransfer)
+	store.Set(key, value)
+}
+
+// Removes a pending whitelisted transfer
+// Used after the ack or timeout for the packet has been received
+func (k Keeper) RemovePendingWhitelistedTransfer(ctx sdk.Context, channelOrClientId string, sequence uint64) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingWhitelistedTransferKeyPrefix)
+	key := types.GetPendingSendPacketKey(channelOrClientId, sequence)
+	store.Delete(key)
+}
+
+// Reads a pending whitelisted transfer from the store
+func (k Keeper) GetPendingWhitelistedTransfer(
+	ctx sdk.Context,
+	channelOrClientId string,
+	sequence uint64,
+) (pendingTransfer types.PendingWhitelistedTransfer, found bool) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingWhitelistedTransferKeyPrefix)
+
+	key := types.GetPendingSendPacketKey(channelOrClientId, sequence)
+	value := store.Get(key)
+	if len(value) == 0 {
+		return pendingTransfer, false
+	}
+
+	k.cdc.MustUnmarshal(value, &pendingTransfer)
+	return pendingTransfer, true
+}
+
+// Get all the pending whitelisted transfers
+func (k Keeper) GetAllPendingWhitelistedTransfers(ctx sdk.Context) []types.PendingWhitelistedTransfer {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.PendingWhitelistedTransferKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allPendingTransfers := []types.PendingWhitelistedTransfer{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingTransfer := types.PendingWhitelistedTransfer{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingTransfer)
+		allPendingTransfers = append(allPendingTransfers, pendingTransfer)
+	}
+
+	return allPendingTransfers
+}
@@ -1,6 +1,15 @@
 package keeper_test
 
-import "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+import (
+	"time"
+
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func (s *KeeperTestSuite) TestAddressWhitelist() {
 	// Store addresses in whitelist
@@ -15,18 +24,18 @@ func (s *KeeperTestSuite) TestAddressWhitelist() {
 
 	// Confirm that each was found
 	for _, addressPair := range expectedWhitelist {
-		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, addressPair.Receiver)
+		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, addressPair.Receiver, sdk.NewInt64Coin("denom", 1))
 		s.Require().True(found, "address pair should have been whitelisted (%s/%s)",
 			addressPair.Sender, addressPair.Receiver)
 	}
 
 	// Confirm that looking both the sender and receiver must match for the pair to be whitelisted
 	for _, addressPair := range expectedWhitelist {
-		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, "fake-receiver")
+		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, "fake-receiver", sdk.NewInt64Coin("denom", 1))
 		s.Require().False(found, "address pair should not have been whitelisted (%s/%s)",
 			addressPair.Sender, "fake-receiver")
 
-		found = s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "fake-sender", addressPair.Receiver)
+		found = s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "fake-sender", addressPair.Receiver, sdk.NewInt64Coin("denom", 1))
 		s.Require().False(found, "address pair should not have been whitelisted (%s/%s)",
 			"fake-sender", addressPair.Receiver)
 	}
@@ -45,8 +54,191 @@ func (s *KeeperTestSuite) TestAddressWhitelist() {
 	s.Require().Empty(actualWhitelist, "whitelist should have been cleared")
 
 	for _, addressPair := range expectedWhitelist {
-		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, addressPair.Receiver)
+		found := s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, addressPair.Sender, addressPair.Receiver, sdk.NewInt64Coin("denom", 1))
 		s.Require().False(found, "address pair should no longer be whitelisted (%s/%s)",
 			addressPair.Sender, addressPair.Receiver)
 	}
 }
+
+func (s *KeeperTestSuite) TestAddressWhitelist_Expiration() {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+
+	pastTime := blockTime.Add(-time.Minute)
+	futureTime := blockTime.Add(time.Minute)
+
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-1", Receiver: "receiver-1", ExpirationHeight: 10,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-2", Receiver: "receiver-2", ExpirationHeight: 11,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-3", Receiver: "receiver-3", ExpirationTime: &pastTime,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-4", Receiver: "receiver-4", ExpirationTime: &futureTime,
+	})
+
+	// Only the pairs that have not yet expired should be whitelisted
+	amount := sdk.NewInt64Coin("denom", 1)
+	s.Require().False(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "sender-1", "receiver-1", amount), "pair 1")
+	s.Require().True(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "sender-2", "receiver-2", amount), "pair 2")
+	s.Require().False(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "sender-3", "receiver-3", amount), "pair 3")
+	s.Require().True(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "sender-4", "receiver-4", amount), "pair 4")
+
+	// Prune the expired pairs and confirm only the active pairs remain
+	s.App.RatelimitKeeper.PruneExpiredWhitelistedAddressPairs(s.Ctx)
+	s.checkEventTypeEmitted(types.EventWhitelistExpired)
+
+	remaining := s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx)
+	s.Require().Len(remaining, 2, "number of remaining pairs")
+	s.Require().Equal("sender-2", remaining[0].Sender, "first remaining pair")
+	s.Require().Equal("sender-4", remaining[1].Sender, "second remaining pair")
+}
+
+func (s *KeeperTestSuite) TestAddressWhitelist_ExpirationIndexes() {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expirationTime := blockTime.Add(time.Hour)
+
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-1", Receiver: "receiver-1", ExpirationHeight: 10,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-2", Receiver: "receiver-2", ExpirationTime: &expirationTime,
+	})
+
+	// Extend the first pair, which should remove it from the index at its old expiration height
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender-1", Receiver: "receiver-1", ExpirationHeight: 20,
+	})
+
+	// At the old expiration height, neither pair should be pruned
+	s.Ctx = s.Ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+	s.App.RatelimitKeeper.PruneExpiredWhitelistedAddressPairs(s.Ctx)
+	s.Require().Len(s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx), 2, "no pairs should be pruned")
+
+	// Once the expiration time is reached, only the second pair should be pruned
+	s.Ctx = s.Ctx.WithBlockTime(expirationTime).WithBlockHeight(11)
+	s.App.RatelimitKeeper.PruneExpiredWhitelistedAddressPairs(s.Ctx)
+	remaining := s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx)
+	s.Require().Len(remaining, 1, "second pair should be pruned by time")
+	s.Require().Equal("sender-1", remaining[0].Sender, "remaining pair")
+
+	// Once the new expiration height is reached, the first pair should be pruned
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.App.RatelimitKeeper.PruneExpiredWhitelistedAddressPairs(s.Ctx)
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx), "first pair should be pruned by height")
+}
+
+func (s *KeeperTestSuite) TestAddressWhitelist_AmountCap() {
+	amountCap := sdk.NewCoins(sdk.NewInt64Coin("denomA", 100), sdk.NewInt64Coin("denomB", 50))
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender", Receiver: "receiver", RemainingAmountCap: amountCap,
+	})
+
+	transfer := func(amount sdk.Coin) bool {
+		if !s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, "sender", "receiver", amount) {
+			return false
+		}
+		s.App.RatelimitKeeper.DecrementWhitelistAmountCap(s.Ctx, types.PACKET_RECV, keeper.RateLimitedPacketInfo{
+			Denom: amount.Denom, Amount: amount.Amount, Sender: "sender", Receiver: "receiver",
+		})
+		return true
+	}
+	remainingCap := func() sdk.Coins {
+		whitelist, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+		s.Require().True(found, "pair should still be whitelisted")
+		return whitelist.RemainingAmountCap
+	}
+
+	// A denom without a cap should not be whitelisted
+	s.Require().False(transfer(sdk.NewInt64Coin("denomC", 1)), "transfer of uncapped denom should not be whitelisted")
+
+	// Transfer 60 denomA - should be whitelisted and decrement only the denomA cap to 40
+	s.Require().True(transfer(sdk.NewInt64Coin("denomA", 60)), "first transfer should be whitelisted")
+	s.checkEventTypeEmitted(types.EventWhitelistAmountCapDecremented)
+	s.Require().Equal("40denomA,50denomB", remainingCap().String(), "remaining cap after first transfer")
+
+	// Transfer 50 denomA - exceeds the remaining cap, so it should not be whitelisted and the cap is unchanged
+	s.Require().False(transfer(sdk.NewInt64Coin("denomA", 50)), "transfer exceeding cap should not be whitelisted")
+	s.Require().Equal("40denomA,50denomB", remainingCap().String(), "remaining cap after rejected transfer")
+
+	// Transfer the remaining 40 denomA - the denomA cap is used up, but the pair remains for denomB
+	s.Require().True(transfer(sdk.NewInt64Coin("denomA", 40)), "second transfer should be whitelisted")
+	s.Require().Equal("50denomB", remainingCap().String(), "remaining cap after denomA is used up")
+	s.Require().False(transfer(sdk.NewInt64Coin("denomA", 1)), "denomA should no longer be whitelisted")
+
+	// Transfer the remaining 50 denomB - should use up the cap, removing the pair
+	s.Require().True(transfer(sdk.NewInt64Coin("denomB", 50)), "final transfer should be whitelisted")
+
+	_, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().False(found, "pair should have been removed once the cap was used up")
+}
+
+func (s *KeeperTestSuite) TestAddressWhitelist_RestoreAmountCap() {
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	channelId := "channel-0"
+
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender", Receiver: "receiver", ExpirationHeight: 20,
+		RemainingAmountCap: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+	})
+
+	send := func(sequence uint64, amount int64) {
+		s.App.RatelimitKeeper.DecrementWhitelistAmountCap(s.Ctx, types.PACKET_SEND, keeper.RateLimitedPacketInfo{
+			ChannelID: channelId,
+			Denom:     "denom",
+			Amount:    sdkmath.NewInt(amount),
+			Sender:    "sender",
+			Receiver:  "receiver",
+			Sequence:  sequence,
+		})
+	}
+
+	// Send 60 and then 40, using up the cap and removing the pair
+	send(1, 60)
+	send(2, 40)
+	_, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().False(found, "pair should have been removed once the cap was used up")
+	s.Require().Len(s.App.RatelimitKeeper.GetAllPendingWhitelistedTransfers(s.Ctx), 2, "pending transfers")
+
+	// Fail the second transfer - the pair should be added back with just the restored amount
+	s.App.RatelimitKeeper.RestoreWhitelistAmountCap(s.Ctx, channelId, 2)
+	s.checkEventTypeEmitted(types.EventWhitelistAmountCapRestored)
+
+	whitelist, found := s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().True(found, "pair should have been restored")
+	s.Require().Equal("40denom", whitelist.RemainingAmountCap.String(), "remaining cap after first restore")
+	s.Require().Equal(int64(20), whitelist.ExpirationHeight, "expiration height should be preserved")
+
+	// Fail the first transfer - the amount should be added back to the cap
+	s.App.RatelimitKeeper.RestoreWhitelistAmountCap(s.Ctx, channelId, 1)
+	whitelist, found = s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().True(found, "pair should still be whitelisted")
+	s.Require().Equal("100denom", whitelist.RemainingAmountCap.String(), "remaining cap after second restore")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllPendingWhitelistedTransfers(s.Ctx), "pending transfers")
+
+	// Restoring the same transfer again should have no effect
+	s.App.RatelimitKeeper.RestoreWhitelistAmountCap(s.Ctx, channelId, 1)
+	whitelist, _ = s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().Equal("100denom", whitelist.RemainingAmountCap.String(), "remaining cap after duplicate restore")
+
+	// If the pair is removed while a transfer is in flight, the failed transfer should not add it back
+	send(3, 100)
+	s.App.RatelimitKeeper.RemoveWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.App.RatelimitKeeper.RestoreWhitelistAmountCap(s.Ctx, channelId, 3)
+	_, found = s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().False(found, "removed pair should not be restored")
+
+	// If the pair expires while a transfer is in flight, the failed transfer should not add it back
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender: "sender", Receiver: "receiver", ExpirationHeight: 20,
+		RemainingAmountCap: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+	})
+	send(4, 100)
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.App.RatelimitKeeper.RestoreWhitelistAmountCap(s.Ctx, channelId, 4)
+	_, found = s.App.RatelimitKeeper.GetWhitelistedAddressPair(s.Ctx, "sender", "receiver")
+	s.Require().False(found, "expired pair should not be restored")
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// Confirms the pattern is non-empty and has the expected form for its match type
+func (p DenomBlacklistPattern) Validate() error {
+	if strings.TrimSpace(p.Pattern) == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "blacklist pattern cannot be empty")
+	}
+
+	switch p.MatchType {
+	case BLACKLIST_BASE_DENOM:
+		// Base denoms can contain a '/' (e.g. factory/{address}/{subdenom} or gamm/pool/1),
+		// but they cannot start with port/channel hops, since those are never part of the base
+		if len(transfertypes.ExtractDenomFromPath(p.Pattern).Trace) > 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"base denom pattern (%s) cannot contain a trace path", p.Pattern)
+		}
+	case BLACKLIST_TRACE_PREFIX:
+		if strings.HasPrefix(p.Pattern, "/") || strings.HasSuffix(p.Pattern, "/") {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"trace prefix pattern (%s) cannot start or end with a '/'", p.Pattern)
+		}
+	case BLACKLIST_GLOB:
+		// Any non-empty glob is valid since every character other than '*' and '?' is a literal
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid blacklist pattern type (%d)", p.MatchType)
+	}
+
+	return nil
+}
+
+// Checks whether the full denom trace (e.g. transfer/channel-5/uatom) matches the pattern
+// For native tokens, the denom trace is just the base denom (e.g. ustrd)
+func (p DenomBlacklistPattern) Matches(denomTrace string) bool {
+	denom := transfertypes.ExtractDenomFromPath(denomTrace)
+
+	switch p.MatchType {
+	case BLACKLIST_BASE_DENOM:
+		return denom.Base == p.Pattern
+	case BLACKLIST_TRACE_PREFIX:
+		hops := make([]string, len(denom.Trace))
+		for i, hop := range denom.Trace {
+			hops[i] = hop.String()
+		}
+		tracePath := strings.Join(hops, "/")
+		return tracePath == p.Pattern || strings.HasPrefix(tracePath, p.Pattern+"/")
+	case BLACKLIST_GLOB:
+		return matchGlob(p.Pattern, denomTrace)
+	default:
+		return false
+	}
+}
+
+// Matches a string against a glob pattern where '*' matches any sequence of characters
+// (including '/') and '?' matches any single character
+// This is checked on every transfer, so rather than compiling a regex, the pattern is
+// matched directly by backtracking to the most recent '*' on a mismatch
+func matchGlob(glob, str string) bool {
+	pattern, text := []rune(glob), []rune(str)
+
+	patternIndex, textIndex := 0, 0
+	starIndex, starTextIndex := -1, 0
+	for textIndex < len(text) {
+		switch {
+		case patternIndex < len(pattern) && (pattern[patternIndex] == '?' || pattern[patternIndex] == text[textIndex]):
+			patternIndex++
+			textIndex++
+		case patternIndex < len(pattern) && pattern[patternIndex] == '*':
+			starIndex, starTextIndex = patternIndex, textIndex
+			patternIndex++
+		case starIndex != -1:
+			// Let the last '*' absorb one more character and retry from there
+			starTextIndex++
+			patternIndex, textIndex = starIndex+1, starTextIndex
+		default:
+			return false
+		}
+	}
+
+	// Any remaining pattern characters must all be '*'
+	for patternIndex < len(pattern) && pattern[patternIndex] == '*' {
+		patternIndex++
+	}
+	return patternIndex == len(pattern)
+}
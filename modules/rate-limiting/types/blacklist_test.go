@@ -0,0 +1,180 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomBlacklistPatternValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		pattern       types.DenomBlacklistPattern
+		expectedError string
+	}{
+		{
+			name:    "valid base denom",
+			pattern: types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+		},
+		{
+			name:    "valid base denom with slashes",
+			pattern: types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "factory/osmo1abc/utoken"},
+		},
+		{
+			name:    "valid base denom with pool path",
+			pattern: types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "gamm/pool/1"},
+		},
+		{
+			name:    "valid trace prefix",
+			pattern: types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+		},
+		{
+			name:    "valid glob",
+			pattern: types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/*/u?tom"},
+		},
+		{
+			name:          "empty pattern",
+			pattern:       types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: " "},
+			expectedError: "blacklist pattern cannot be empty",
+		},
+		{
+			name:          "base denom with trace",
+			pattern:       types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "transfer/channel-0/uatom"},
+			expectedError: "cannot contain a trace path",
+		},
+		{
+			name:          "trace prefix with trailing slash",
+			pattern:       types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5/"},
+			expectedError: "cannot start or end with a '/'",
+		},
+		{
+			name:          "invalid type",
+			pattern:       types.DenomBlacklistPattern{MatchType: 10, Pattern: "uatom"},
+			expectedError: "invalid blacklist pattern type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pattern.Validate()
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDenomBlacklistPatternMatches(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pattern    types.DenomBlacklistPattern
+		denomTrace string
+		matches    bool
+	}{
+		{
+			name:       "base denom - native",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+			denomTrace: "uatom",
+			matches:    true,
+		},
+		{
+			name:       "base denom - multi hop",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+			denomTrace: "transfer/channel-0/transfer/channel-1/uatom",
+			matches:    true,
+		},
+		{
+			name:       "base denom - with slashes",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "factory/osmo1abc/utoken"},
+			denomTrace: "transfer/channel-0/factory/osmo1abc/utoken",
+			matches:    true,
+		},
+		{
+			name:       "base denom - different denom",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+			denomTrace: "transfer/channel-0/stuatom",
+			matches:    false,
+		},
+		{
+			name:       "trace prefix - single hop",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+			denomTrace: "transfer/channel-5/uosmo",
+			matches:    true,
+		},
+		{
+			name:       "trace prefix - multi hop",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+			denomTrace: "transfer/channel-5/transfer/channel-1/uosmo",
+			matches:    true,
+		},
+		{
+			name:       "trace prefix - partial channel id",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+			denomTrace: "transfer/channel-55/uosmo",
+			matches:    false,
+		},
+		{
+			name:       "trace prefix - later hop",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+			denomTrace: "transfer/channel-1/transfer/channel-5/uosmo",
+			matches:    false,
+		},
+		{
+			name:       "trace prefix - native",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: "transfer/channel-5"},
+			denomTrace: "uosmo",
+			matches:    false,
+		},
+		{
+			name:       "glob - any path",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "*/uatom"},
+			denomTrace: "transfer/channel-0/transfer/channel-1/uatom",
+			matches:    true,
+		},
+		{
+			name:       "glob - single character",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/channel-?/uatom"},
+			denomTrace: "transfer/channel-7/uatom",
+			matches:    true,
+		},
+		{
+			name:       "glob - no match",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/channel-?/uatom"},
+			denomTrace: "transfer/channel-17/uatom",
+			matches:    false,
+		},
+		{
+			name:       "glob - star matches empty sequence",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/*channel-0/uatom*"},
+			denomTrace: "transfer/channel-0/uatom",
+			matches:    true,
+		},
+		{
+			name:       "glob - backtracks over multiple stars",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "*/channel-1/*/uatom"},
+			denomTrace: "transfer/channel-1/transfer/channel-1/transfer/channel-2/uatom",
+			matches:    true,
+		},
+		{
+			name:       "glob - trailing characters must match",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "*/uatom"},
+			denomTrace: "transfer/channel-0/uatomx",
+			matches:    false,
+		},
+		{
+			name:       "glob - regex characters are literal",
+			pattern:    types.DenomBlacklistPattern{MatchType: types.BLACKLIST_GLOB, Pattern: "factory.*"},
+			denomTrace: "factoryX/abc",
+			matches:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, tc.pattern.Matches(tc.denomTrace))
+		})
+	}
+}
@@ -21,4 +21,7 @@ var (
 	ErrDenomIsBlacklisted = errorsmod.Register(ModuleName, 7,
 		"denom is blacklisted",
 	)
+	ErrNewDenomBlocked = errorsmod.Register(ModuleName, 8,
+		"denom does not have a rate limit",
+	)
 )
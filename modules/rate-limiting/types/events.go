@@ -5,12 +5,25 @@ var (
 
 	EventRateLimitExceeded = "rate_limit_exceeded"
 	EventBlacklistedDenom  = "blacklisted_denom"
+	EventNewDenomBlocked   = "new_denom_blocked"
 
-	AttributeKeyReason          = "reason"
-	AttributeKeyModule          = "module"
-	AttributeKeyAction          = "action"
-	AttributeKeyDenom           = "denom"
-	AttributeKeyChannelOrClient = "channel_or_client"
-	AttributeKeyAmount          = "amount"
-	AttributeKeyError           = "error"
+	EventRateLimitAutoCreated = "rate_limit_auto_created"
+
+	EventWhitelistAmountCapDecremented = "whitelist_amount_cap_decremented"
+	EventWhitelistAmountCapRestored    = "whitelist_amount_cap_restored"
+	EventWhitelistExpired              = "whitelist_expired"
+
+	AttributeKeyReason             = "reason"
+	AttributeKeyModule             = "module"
+	AttributeKeyAction             = "action"
+	AttributeKeyDenom              = "denom"
+	AttributeKeyChannelOrClient    = "channel_or_client"
+	AttributeKeyAmount             = "amount"
+	AttributeKeyError              = "error"
+	AttributeKeyMaxPercentSend     = "max_percent_send"
+	AttributeKeyMaxPercentRecv     = "max_percent_recv"
+	AttributeKeyDurationHours      = "duration_hours"
+	AttributeKeySender             = "sender"
+	AttributeKeyReceiver           = "receiver"
+	AttributeKeyRemainingAmountCap = "remaining_amount_cap"
 )
@@ -40,6 +40,10 @@ func DefaultGenesis() *GenesisState {
 			EpochNumber: 0,
 			Duration:    time.Hour,
 		},
+		BlacklistedDenomPatterns:    []DenomBlacklistPattern{},
+		NewDenomPolicy:              DefaultNewDenomPolicy(),
+		AutoCreatedRateLimits:       []Path{},
+		PendingWhitelistedTransfers: []PendingWhitelistedTransfer{},
 	}
 }
 
@@ -58,6 +62,55 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate the whitelisted address pairs
+	for _, whitelist := range gs.WhitelistedAddressPairs {
+		if err := whitelist.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the amounts pending to be restored to the whitelisted address pairs
+	for _, pendingTransfer := range gs.PendingWhitelistedTransfers {
+		if err := pendingTransfer.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the blacklisted denom patterns
+	for _, pattern := range gs.BlacklistedDenomPatterns {
+		if err := pattern.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the new denom policy
+	if err := gs.NewDenomPolicy.Validate(); err != nil {
+		return err
+	}
+
+	// Validate that each auto-created rate limit flag refers to a single rate limit
+	rateLimitKeys := map[string]bool{}
+	for _, rateLimit := range gs.RateLimits {
+		if rateLimit.Path != nil {
+			rateLimitKeys[string(GetRateLimitItemKey(rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId))] = true
+		}
+	}
+	autoCreatedKeys := map[string]bool{}
+	for _, path := range gs.AutoCreatedRateLimits {
+		if path.Denom == "" || path.ChannelOrClientId == "" {
+			return fmt.Errorf("auto-created rate limit must specify a denom and channel or client id (%s/%s)",
+				path.Denom, path.ChannelOrClientId)
+		}
+		key := string(GetRateLimitItemKey(path.Denom, path.ChannelOrClientId))
+		if autoCreatedKeys[key] {
+			return fmt.Errorf("duplicate auto-created rate limit (%s/%s)", path.Denom, path.ChannelOrClientId)
+		}
+		if !rateLimitKeys[key] {
+			return fmt.Errorf("auto-created rate limit (%s/%s) does not exist", path.Denom, path.ChannelOrClientId)
+		}
+		autoCreatedKeys[key] = true
+	}
+
 	// Verify the epoch hour duration is specified
 	if gs.HourEpoch.Duration == 0 {
 		return errors.New("hour epoch duration must be specified")
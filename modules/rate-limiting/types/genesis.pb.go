@@ -25,12 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ratelimit module's genesis state.
 type GenesisState struct {
-	Params                           Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	RateLimits                       []RateLimit              `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
-	WhitelistedAddressPairs          []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
-	BlacklistedDenoms                []string                 `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
-	PendingSendPacketSequenceNumbers []string                 `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
-	HourEpoch                        HourEpoch                `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch" yaml:"hour_epoch"`
+	Params                           Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	RateLimits                       []RateLimit                  `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	WhitelistedAddressPairs          []WhitelistedAddressPair     `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
+	BlacklistedDenoms                []string                     `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
+	PendingSendPacketSequenceNumbers []string                     `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
+	HourEpoch                        HourEpoch                    `protobuf:"bytes,6,opt,name=hour_epoch,json=hourEpoch,proto3" json:"hour_epoch" yaml:"hour_epoch"`
+	BlacklistedDenomPatterns         []DenomBlacklistPattern      `protobuf:"bytes,7,rep,name=blacklisted_denom_patterns,json=blacklistedDenomPatterns,proto3" json:"blacklisted_denom_patterns" yaml:"blacklisted_denom_patterns"`
+	NewDenomPolicy                   NewDenomPolicy               `protobuf:"bytes,8,opt,name=new_denom_policy,json=newDenomPolicy,proto3" json:"new_denom_policy" yaml:"new_denom_policy"`
+	AutoCreatedRateLimits            []Path                       `protobuf:"bytes,9,rep,name=auto_created_rate_limits,json=autoCreatedRateLimits,proto3" json:"auto_created_rate_limits" yaml:"auto_created_rate_limits"`
+	PendingWhitelistedTransfers      []PendingWhitelistedTransfer `protobuf:"bytes,10,rep,name=pending_whitelisted_transfers,json=pendingWhitelistedTransfers,proto3" json:"pending_whitelisted_transfers" yaml:"pending_whitelisted_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +112,34 @@ func (m *GenesisState) GetHourEpoch() HourEpoch {
 	return HourEpoch{}
 }
 
+func (m *GenesisState) GetBlacklistedDenomPatterns() []DenomBlacklistPattern {
+	if m != nil {
+		return m.BlacklistedDenomPatterns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNewDenomPolicy() NewDenomPolicy {
+	if m != nil {
+		return m.NewDenomPolicy
+	}
+	return NewDenomPolicy{}
+}
+
+func (m *GenesisState) GetAutoCreatedRateLimits() []Path {
+	if m != nil {
+		return m.AutoCreatedRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWhitelistedTransfers() []PendingWhitelistedTransfer {
+	if m != nil {
+		return m.PendingWhitelistedTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ratelimit.v1.GenesisState")
 }
@@ -115,35 +147,46 @@ func init() {
 func init() { proto.RegisterFile("ratelimit/v1/genesis.proto", fileDescriptor_fbb08d6119688a03) }
 
 var fileDescriptor_fbb08d6119688a03 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6e, 0xd3, 0x40,
-	0x1c, 0xc6, 0x63, 0x52, 0x22, 0xf5, 0x52, 0x86, 0x5a, 0x45, 0x75, 0x22, 0xe4, 0x5a, 0x56, 0x87,
-	0x2c, 0xb1, 0x49, 0xd9, 0xba, 0x11, 0x40, 0x30, 0xa0, 0x2a, 0x38, 0x95, 0x90, 0x58, 0xac, 0xb3,
-	0xfd, 0x97, 0x7d, 0x6a, 0xec, 0x3b, 0xee, 0x7f, 0x4e, 0xd5, 0x37, 0x40, 0x4c, 0x3c, 0x56, 0xc7,
-	0x8e, 0x4c, 0x15, 0x4a, 0xde, 0x80, 0x27, 0x40, 0xbe, 0x33, 0x4d, 0x82, 0xe8, 0x66, 0xeb, 0xfb,
-	0xfd, 0xbe, 0x4f, 0x67, 0x1f, 0x19, 0x4a, 0xaa, 0x60, 0xc1, 0x4a, 0xa6, 0xc2, 0xe5, 0x24, 0xcc,
-	0xa1, 0x02, 0x64, 0x18, 0x08, 0xc9, 0x15, 0xb7, 0x0f, 0x1e, 0xb2, 0x60, 0x39, 0x19, 0x1e, 0xe5,
-	0x3c, 0xe7, 0x3a, 0x08, 0x9b, 0x27, 0xc3, 0x0c, 0x07, 0x3b, 0xbe, 0xa0, 0x92, 0x96, 0xad, 0x3e,
-	0x7c, 0xb1, 0x13, 0x6d, 0xba, 0x74, 0xea, 0x7f, 0xdb, 0x23, 0x07, 0xef, 0xcd, 0xdc, 0x5c, 0x51,
-	0x05, 0xf6, 0x1b, 0xd2, 0x33, 0xba, 0x63, 0x79, 0xd6, 0xa8, 0x7f, 0x76, 0x14, 0x6c, 0xcf, 0x07,
-	0x33, 0x9d, 0x4d, 0x9f, 0xdf, 0xde, 0x9f, 0x74, 0x7e, 0xdf, 0x9f, 0x3c, 0xbb, 0xa1, 0xe5, 0xe2,
-	0xdc, 0x37, 0x86, 0x1f, 0xb5, 0xaa, 0x7d, 0x49, 0xfa, 0x8d, 0x15, 0x6b, 0x0d, 0x9d, 0x27, 0x5e,
-	0x77, 0xd4, 0x3f, 0x3b, 0xde, 0x6d, 0x8a, 0xa8, 0x82, 0x8f, 0xcd, 0xcb, 0x74, 0xd8, 0x96, 0xd9,
-	0xa6, 0x6c, 0xcb, 0xf4, 0x23, 0x22, 0xff, 0x62, 0x68, 0x7f, 0xb7, 0xc8, 0xe0, 0xba, 0x60, 0x4d,
-	0x07, 0x2a, 0xc8, 0x62, 0x9a, 0x65, 0x12, 0x10, 0x63, 0x41, 0x99, 0x44, 0xa7, 0xab, 0x47, 0x4e,
-	0x77, 0x47, 0x3e, 0x6f, 0xf0, 0xd7, 0x86, 0x9e, 0x51, 0x26, 0xa7, 0xa3, 0x76, 0xd1, 0x33, 0x8b,
-	0x8f, 0x96, 0xfa, 0xd1, 0xf1, 0xf5, 0x7f, 0x1b, 0xd0, 0x1e, 0x13, 0x3b, 0x59, 0xd0, 0xf4, 0xaa,
-	0xd5, 0x32, 0xa8, 0x78, 0x89, 0xce, 0x9e, 0xd7, 0x1d, 0xed, 0x47, 0x87, 0x5b, 0xc9, 0x5b, 0x1d,
-	0xd8, 0x17, 0xe4, 0x54, 0x40, 0x95, 0xb1, 0x2a, 0x8f, 0x11, 0xaa, 0x2c, 0x16, 0x34, 0xbd, 0x02,
-	0x15, 0x23, 0x7c, 0xad, 0xa1, 0x4a, 0x21, 0xae, 0xea, 0x32, 0x01, 0x89, 0xce, 0x53, 0x5d, 0xe0,
-	0xb5, 0xec, 0x1c, 0xaa, 0x6c, 0xa6, 0xc9, 0x79, 0x0b, 0x5e, 0x18, 0xce, 0xfe, 0x44, 0x48, 0xc1,
-	0x6b, 0x19, 0x83, 0xe0, 0x69, 0xe1, 0xf4, 0xf4, 0xaf, 0xfa, 0xe7, 0x03, 0x7f, 0xe0, 0xb5, 0x7c,
-	0xd7, 0xc4, 0xd3, 0x41, 0x7b, 0xdc, 0x43, 0x73, 0xdc, 0x8d, 0xe8, 0x47, 0xfb, 0xc5, 0x03, 0x75,
-	0x79, 0xbb, 0x72, 0xad, 0xbb, 0x95, 0x6b, 0xfd, 0x5a, 0xb9, 0xd6, 0x8f, 0xb5, 0xdb, 0xb9, 0x5b,
-	0xbb, 0x9d, 0x9f, 0x6b, 0xb7, 0xf3, 0xe5, 0x3c, 0x67, 0xaa, 0xa8, 0x93, 0x20, 0xe5, 0x65, 0x98,
-	0x72, 0x2c, 0x39, 0x86, 0x2c, 0x49, 0xc7, 0x54, 0x08, 0x0c, 0x4b, 0x9e, 0xd5, 0x0b, 0x40, 0x7d,
-	0xb1, 0xc6, 0x7a, 0x9b, 0x55, 0x79, 0xb8, 0x9c, 0xbc, 0x0c, 0xd5, 0x8d, 0x00, 0x4c, 0x7a, 0xfa,
-	0x9e, 0xbd, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xae, 0x15, 0xb1, 0xe2, 0x02, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
+	0x1c, 0xc6, 0x9b, 0x77, 0x2f, 0x63, 0xf3, 0x06, 0x62, 0xd6, 0xa6, 0x65, 0x61, 0xa4, 0x21, 0x4c,
+	0xa2, 0x48, 0xac, 0x61, 0xe3, 0xb6, 0x1b, 0x19, 0x08, 0x0e, 0x68, 0x2a, 0xd9, 0x24, 0x24, 0x2e,
+	0x91, 0x9b, 0x98, 0x24, 0x5a, 0x63, 0x07, 0xdb, 0x59, 0xd5, 0xaf, 0x80, 0x38, 0x20, 0x71, 0xe3,
+	0x13, 0xed, 0xb8, 0x23, 0xa7, 0x09, 0xb5, 0xdf, 0x80, 0x4f, 0x80, 0x62, 0xbb, 0x34, 0xe9, 0x28,
+	0xb7, 0x56, 0xcf, 0xf3, 0x7b, 0x1e, 0xff, 0xad, 0x7f, 0x0c, 0x2c, 0x86, 0x04, 0x1e, 0x64, 0x79,
+	0x26, 0xbc, 0x8b, 0x03, 0x2f, 0xc1, 0x04, 0xf3, 0x8c, 0x77, 0x0b, 0x46, 0x05, 0x85, 0xeb, 0x7f,
+	0xb4, 0xee, 0xc5, 0x81, 0xb5, 0x99, 0xd0, 0x84, 0x4a, 0xc1, 0xab, 0x7e, 0x29, 0x8f, 0xb5, 0xd3,
+	0xe0, 0x0b, 0xc4, 0x50, 0xae, 0x71, 0x6b, 0xb7, 0x21, 0xcd, 0xb2, 0xa4, 0xea, 0x7e, 0x5b, 0x01,
+	0xeb, 0xaf, 0x55, 0xdd, 0xa9, 0x40, 0x02, 0xc3, 0x63, 0xb0, 0xac, 0x70, 0xd3, 0x70, 0x8c, 0xce,
+	0xda, 0xe1, 0x66, 0xb7, 0x5e, 0xdf, 0xed, 0x49, 0xcd, 0xdf, 0xba, 0xbc, 0x6e, 0xb7, 0x7e, 0x5d,
+	0xb7, 0xef, 0x8c, 0x50, 0x3e, 0x38, 0x72, 0x15, 0xe1, 0x06, 0x1a, 0x85, 0x67, 0x60, 0xad, 0xa2,
+	0x42, 0x89, 0x71, 0xf3, 0x3f, 0x67, 0xa9, 0xb3, 0x76, 0xb8, 0xdd, 0x4c, 0x0a, 0x90, 0xc0, 0x6f,
+	0xab, 0x3f, 0xbe, 0xa5, 0xc3, 0xa0, 0x0a, 0xab, 0x91, 0x6e, 0x00, 0xd8, 0xd4, 0xc6, 0xe1, 0x67,
+	0x03, 0xec, 0x0c, 0xd3, 0xac, 0xca, 0xe0, 0x02, 0xc7, 0x21, 0x8a, 0x63, 0x86, 0x39, 0x0f, 0x0b,
+	0x94, 0x31, 0x6e, 0x2e, 0xc9, 0x92, 0xbd, 0x66, 0xc9, 0xfb, 0x99, 0xfd, 0x85, 0x72, 0xf7, 0x50,
+	0xc6, 0xfc, 0x8e, 0x6e, 0x74, 0x54, 0xe3, 0xc2, 0x50, 0x37, 0xd8, 0x1e, 0xfe, 0x35, 0x81, 0xc3,
+	0x7d, 0x00, 0xfb, 0x03, 0x14, 0x9d, 0x6b, 0x2c, 0xc6, 0x84, 0xe6, 0xdc, 0xfc, 0xdf, 0x59, 0xea,
+	0xac, 0x06, 0x1b, 0x35, 0xe5, 0xa5, 0x14, 0xe0, 0x09, 0xd8, 0x2b, 0x30, 0x89, 0x33, 0x92, 0x84,
+	0x1c, 0x93, 0x38, 0x2c, 0x50, 0x74, 0x8e, 0x45, 0xc8, 0xf1, 0xa7, 0x12, 0x93, 0x08, 0x87, 0xa4,
+	0xcc, 0xfb, 0x98, 0x71, 0xf3, 0x96, 0x0c, 0x70, 0xb4, 0xf7, 0x14, 0x93, 0xb8, 0x27, 0x9d, 0xa7,
+	0xda, 0x78, 0xa2, 0x7c, 0xf0, 0x1d, 0x00, 0x29, 0x2d, 0x59, 0x88, 0x0b, 0x1a, 0xa5, 0xe6, 0xb2,
+	0x63, 0xdc, 0xbc, 0xe0, 0x37, 0xb4, 0x64, 0xaf, 0x2a, 0xd9, 0xdf, 0xd1, 0xe3, 0x6e, 0xa8, 0x71,
+	0x67, 0xa0, 0x1b, 0xac, 0xa6, 0x53, 0x17, 0xfc, 0x62, 0x00, 0xeb, 0xc6, 0x48, 0x61, 0x81, 0x84,
+	0xc0, 0x8c, 0x70, 0xf3, 0xb6, 0xbc, 0xdf, 0x47, 0xcd, 0x0e, 0x39, 0x9d, 0x3f, 0x85, 0x7a, 0xca,
+	0xeb, 0x3f, 0xd1, 0x7d, 0x0f, 0x55, 0xdf, 0xe2, 0x50, 0x37, 0x30, 0xe7, 0xaf, 0x4a, 0x67, 0x70,
+	0x98, 0x80, 0x7b, 0x04, 0x0f, 0xa7, 0x00, 0x1d, 0x64, 0xd1, 0xc8, 0x5c, 0x91, 0x73, 0xee, 0x36,
+	0xcf, 0x70, 0x82, 0x87, 0x8a, 0x94, 0x1e, 0xbf, 0xad, 0xcb, 0xb7, 0x55, 0xf9, 0x7c, 0x86, 0x1b,
+	0xdc, 0x25, 0x0d, 0x00, 0x0a, 0x60, 0xa2, 0x52, 0xd0, 0x30, 0x62, 0x18, 0x55, 0x47, 0xac, 0x6f,
+	0xee, 0xaa, 0x1c, 0x1a, 0xce, 0x7f, 0x03, 0x22, 0xf5, 0x1f, 0xeb, 0x9a, 0xb6, 0xaa, 0x59, 0x94,
+	0xe0, 0x06, 0x5b, 0x95, 0x74, 0xac, 0x94, 0x60, 0xb6, 0xcc, 0xdf, 0x0d, 0xf0, 0x60, 0xba, 0x11,
+	0xf5, 0xfd, 0x13, 0x0c, 0x11, 0xfe, 0xb1, 0x5a, 0x05, 0x20, 0xbb, 0x3b, 0x73, 0xdd, 0x0a, 0xa9,
+	0xed, 0xf5, 0x99, 0x06, 0xfc, 0xa7, 0xfa, 0x44, 0x7b, 0xfa, 0x9b, 0xfc, 0x57, 0xb8, 0x1b, 0xdc,
+	0x2f, 0x16, 0x26, 0x71, 0xff, 0xec, 0x72, 0x6c, 0x1b, 0x57, 0x63, 0xdb, 0xf8, 0x39, 0xb6, 0x8d,
+	0xaf, 0x13, 0xbb, 0x75, 0x35, 0xb1, 0x5b, 0x3f, 0x26, 0x76, 0xeb, 0xc3, 0x51, 0x92, 0x89, 0xb4,
+	0xec, 0x77, 0x23, 0x9a, 0x7b, 0x11, 0xe5, 0x39, 0xe5, 0x5e, 0xd6, 0x8f, 0xf6, 0x51, 0x51, 0x70,
+	0x2f, 0xa7, 0x71, 0x39, 0xc0, 0x5c, 0xbe, 0x31, 0xfb, 0xf2, 0xc4, 0x19, 0x49, 0xbc, 0x8b, 0x83,
+	0x67, 0x9e, 0x18, 0x15, 0x98, 0xf7, 0x97, 0xe5, 0x93, 0xf3, 0xfc, 0xf7, 0x00, 0x12, 0x5c, 0xb7,
+	0xb6, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -166,6 +209,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingWhitelistedTransfers) > 0 {
+		for iNdEx := len(m.PendingWhitelistedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWhitelistedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AutoCreatedRateLimits) > 0 {
+		for iNdEx := len(m.AutoCreatedRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCreatedRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.NewDenomPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.BlacklistedDenomPatterns) > 0 {
+		for iNdEx := len(m.BlacklistedDenomPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedDenomPatterns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.HourEpoch.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -280,6 +375,26 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.HourEpoch.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlacklistedDenomPatterns) > 0 {
+		for _, e := range m.BlacklistedDenomPatterns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.NewDenomPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoCreatedRateLimits) > 0 {
+		for _, e := range m.AutoCreatedRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingWhitelistedTransfers) > 0 {
+		for _, e := range m.PendingWhitelistedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -516,6 +631,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedDenomPatterns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedDenomPatterns = append(m.BlacklistedDenomPatterns, DenomBlacklistPattern{})
+			if err := m.BlacklistedDenomPatterns[len(m.BlacklistedDenomPatterns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDenomPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewDenomPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCreatedRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCreatedRateLimits = append(m.AutoCreatedRateLimits, Path{})
+			if err := m.AutoCreatedRateLimits[len(m.AutoCreatedRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWhitelistedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWhitelistedTransfers = append(m.PendingWhitelistedTransfers, PendingWhitelistedTransfer{})
+			if err := m.PendingWhitelistedTransfers[len(m.PendingWhitelistedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
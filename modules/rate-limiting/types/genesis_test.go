@@ -4,6 +4,10 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 	"github.com/stretchr/testify/require"
 )
@@ -11,6 +15,7 @@ import (
 func TestValidateGenesis(t *testing.T) {
 	currentHour := 13
 	blockTime := time.Date(2024, 1, 1, currentHour, 55, 8, 0, time.UTC) // 13:55:08
+	zeroCap := sdk.Coins{{Denom: "uatom", Amount: sdkmath.ZeroInt()}}
 
 	testCases := []struct {
 		name          string
@@ -36,7 +41,90 @@ func TestValidateGenesis(t *testing.T) {
 					Duration:         time.Minute,
 					EpochStartHeight: 1,
 				},
+				BlacklistedDenomPatterns: []types.DenomBlacklistPattern{
+					{MatchType: types.BLACKLIST_BASE_DENOM, Pattern: "uatom"},
+					{MatchType: types.BLACKLIST_GLOB, Pattern: "transfer/*/uosmo"},
+				},
+			},
+		},
+		{
+			name: "invalid new denom policy",
+			genesisState: types.GenesisState{
+				NewDenomPolicy: types.NewDenomPolicy{
+					Action:              types.NEW_DENOM_AUTO_CREATE,
+					InitialChannelValue: sdkmath.NewInt(1000),
+				},
+			},
+			expectedError: "percent must be between 0 and 100",
+		},
+		{
+			name: "invalid new denom policy - no initial channel value",
+			genesisState: types.GenesisState{
+				NewDenomPolicy: types.NewDenomPolicy{
+					Action: types.NEW_DENOM_BLOCK,
+				},
+			},
+			expectedError: "initial channel value must be greater than 0",
+		},
+		{
+			name: "valid auto-created rate limit",
+			genesisState: types.GenesisState{
+				RateLimits:            []types.RateLimit{{Path: &types.Path{Denom: "ibc/denom", ChannelOrClientId: "channel-0"}}},
+				AutoCreatedRateLimits: []types.Path{{Denom: "ibc/denom", ChannelOrClientId: "channel-0"}},
+				HourEpoch:             types.HourEpoch{Duration: time.Minute},
+			},
+		},
+		{
+			name: "invalid auto-created rate limit - empty path",
+			genesisState: types.GenesisState{
+				AutoCreatedRateLimits: []types.Path{{Denom: "ibc/denom"}},
+			},
+			expectedError: "auto-created rate limit must specify a denom and channel or client id",
+		},
+		{
+			name: "invalid auto-created rate limit - duplicate",
+			genesisState: types.GenesisState{
+				RateLimits: []types.RateLimit{{Path: &types.Path{Denom: "ibc/denom", ChannelOrClientId: "channel-0"}}},
+				AutoCreatedRateLimits: []types.Path{
+					{Denom: "ibc/denom", ChannelOrClientId: "channel-0"},
+					{Denom: "ibc/denom", ChannelOrClientId: "channel-0"},
+				},
+			},
+			expectedError: "duplicate auto-created rate limit (ibc/denom/channel-0)",
+		},
+		{
+			name: "invalid auto-created rate limit - no rate limit",
+			genesisState: types.GenesisState{
+				AutoCreatedRateLimits: []types.Path{{Denom: "ibc/denom", ChannelOrClientId: "channel-0"}},
+			},
+			expectedError: "auto-created rate limit (ibc/denom/channel-0) does not exist",
+		},
+		{
+			name: "invalid whitelist amount cap",
+			genesisState: types.GenesisState{
+				WhitelistedAddressPairs: []types.WhitelistedAddressPair{
+					{Sender: "senderA", Receiver: "receiverA", RemainingAmountCap: zeroCap},
+				},
+			},
+			expectedError: "whitelist amount cap (0uatom) must be sorted and strictly positive",
+		},
+		{
+			name: "invalid pending whitelisted transfer amount",
+			genesisState: types.GenesisState{
+				PendingWhitelistedTransfers: []types.PendingWhitelistedTransfer{
+					{ChannelOrClientId: "channel-0", Sequence: 1, Sender: "senderA", Receiver: "receiverA", Amount: sdk.Coin{Denom: "uatom", Amount: sdkmath.ZeroInt()}},
+				},
+			},
+			expectedError: "pending whitelisted transfer amount (0uatom) must be positive",
+		},
+		{
+			name: "invalid blacklist pattern",
+			genesisState: types.GenesisState{
+				BlacklistedDenomPatterns: []types.DenomBlacklistPattern{
+					{MatchType: types.BLACKLIST_TRACE_PREFIX, Pattern: ""},
+				},
 			},
+			expectedError: "blacklist pattern cannot be empty",
 		},
 		{
 			name: "invalid packet sequence - wrong delimiter",
@@ -1,6 +1,11 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	ModuleName = "ratelimit"
@@ -26,8 +31,22 @@ var (
 	DenomBlacklistKeyPrefix   = KeyPrefix("denom-blacklist")
 	AddressWhitelistKeyPrefix = KeyPrefix("address-blacklist")
 	HourEpochKey              = KeyPrefix("hour-epoch")
+	// Note: this must not share a prefix with DenomBlacklistKeyPrefix since
+	// the exact denom blacklist is iterated over its full prefix
+	BlacklistPatternKeyPrefix     = KeyPrefix("blacklist-pattern")
+	NewDenomPolicyKey             = KeyPrefix("new-denom-policy")
+	AutoCreatedRateLimitKeyPrefix = KeyPrefix("auto-created-rate-limit")
+	// Secondary indexes of the whitelisted address pairs by their expiration,
+	// so that only the pairs that are due are visited when pruning
+	WhitelistExpirationHeightKeyPrefix  = KeyPrefix("whitelist-expiration-height")
+	WhitelistExpirationTimeKeyPrefix    = KeyPrefix("whitelist-expiration-time")
+	PendingWhitelistedTransferKeyPrefix = KeyPrefix("pending-whitelisted-transfer")
 
 	PendingSendPacketChannelLength int = 16
+
+	// The whitelist expiration index keys are prefixed by a fixed length height or time
+	WhitelistExpirationHeightLength int = 8
+	WhitelistExpirationTimeLength   int = len(sdk.SortableTimeFormat)
 )
 
 // Get the rate limit byte key built from the denom and channelId
@@ -52,3 +71,27 @@ func GetPendingSendPacketKey(channelId string, sequenceNumber uint64) []byte {
 func GetAddressWhitelistKey(sender, receiver string) []byte {
 	return append(KeyPrefix(sender), KeyPrefix(receiver)...)
 }
+
+// Get the whitelist expiration height index key, ordered by height so that all pairs
+// that expire at or before a given height can be iterated over
+func GetWhitelistExpirationHeightKey(expirationHeight int64, sender, receiver string) []byte {
+	return append(GetWhitelistExpirationHeightPrefix(expirationHeight), GetAddressWhitelistKey(sender, receiver)...)
+}
+
+// Get the prefix of all whitelist expiration height index keys at the given height
+func GetWhitelistExpirationHeightPrefix(expirationHeight int64) []byte {
+	heightBz := make([]byte, WhitelistExpirationHeightLength)
+	binary.BigEndian.PutUint64(heightBz, uint64(expirationHeight)) //nolint:gosec
+	return heightBz
+}
+
+// Get the whitelist expiration time index key, ordered by time so that all pairs
+// that expire at or before a given time can be iterated over
+func GetWhitelistExpirationTimeKey(expirationTime time.Time, sender, receiver string) []byte {
+	return append(sdk.FormatTimeBytes(expirationTime), GetAddressWhitelistKey(sender, receiver)...)
+}
+
+// Get the blacklist pattern key from the match type and pattern
+func GetDenomBlacklistPatternKey(matchType BlacklistPatternType, pattern string) []byte {
+	return append([]byte{byte(matchType)}, KeyPrefix(pattern)...)
+}
@@ -14,10 +14,11 @@ import (
 )
 
 const (
-	TypeMsgAddRateLimit    = "AddRateLimit"
-	TypeMsgUpdateRateLimit = "UpdateRateLimit"
-	TypeMsgRemoveRateLimit = "RemoveRateLimit"
-	TypeMsgResetRateLimit  = "ResetRateLimit"
+	TypeMsgAddRateLimit      = "AddRateLimit"
+	TypeMsgUpdateRateLimit   = "UpdateRateLimit"
+	TypeMsgRemoveRateLimit   = "RemoveRateLimit"
+	TypeMsgResetRateLimit    = "ResetRateLimit"
+	TypeMsgSetNewDenomPolicy = "SetNewDenomPolicy"
 )
 
 var (
@@ -25,12 +26,14 @@ var (
 	_ sdk.Msg = &MsgUpdateRateLimit{}
 	_ sdk.Msg = &MsgRemoveRateLimit{}
 	_ sdk.Msg = &MsgResetRateLimit{}
+	_ sdk.Msg = &MsgSetNewDenomPolicy{}
 
 	// Implement legacy interface for ledger support
 	_ legacytx.LegacyMsg = &MsgAddRateLimit{}
 	_ legacytx.LegacyMsg = &MsgUpdateRateLimit{}
 	_ legacytx.LegacyMsg = &MsgRemoveRateLimit{}
 	_ legacytx.LegacyMsg = &MsgResetRateLimit{}
+	_ legacytx.LegacyMsg = &MsgSetNewDenomPolicy{}
 )
 
 // ----------------------------------------------
@@ -292,3 +295,60 @@ func (msg *MsgResetRateLimit) ValidateBasic() error {
 
 	return nil
 }
+
+// ----------------------------------------------
+//               MsgSetNewDenomPolicy
+// ----------------------------------------------
+
+func NewMsgSetNewDenomPolicy(action NewDenomAction, maxPercentSend sdkmath.Int, maxPercentRecv sdkmath.Int, durationHours uint64, initialChannelValue sdkmath.Int) *MsgSetNewDenomPolicy {
+	return &MsgSetNewDenomPolicy{
+		Action:              action,
+		MaxPercentSend:      maxPercentSend,
+		MaxPercentRecv:      maxPercentRecv,
+		DurationHours:       durationHours,
+		InitialChannelValue: initialChannelValue,
+	}
+}
+
+func (msg MsgSetNewDenomPolicy) Type() string {
+	return TypeMsgSetNewDenomPolicy
+}
+
+func (msg MsgSetNewDenomPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetNewDenomPolicy) GetSigners() []sdk.AccAddress {
+	staker, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{staker}
+}
+
+func (msg *MsgSetNewDenomPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetNewDenomPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return msg.Policy().Validate()
+}
+
+// Returns the policy set by the message
+func (msg *MsgSetNewDenomPolicy) Policy() NewDenomPolicy {
+	return NewDenomPolicy{
+		Action: msg.Action,
+		Quota: Quota{
+			MaxPercentSend: msg.MaxPercentSend,
+			MaxPercentRecv: msg.MaxPercentRecv,
+			DurationHours:  msg.DurationHours,
+		},
+		InitialChannelValue: msg.InitialChannelValue,
+	}
+}
@@ -505,3 +505,141 @@ func TestMsgResetRateLimit(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+//               MsgSetNewDenomPolicy
+// ----------------------------------------------
+
+func TestMsgSetNewDenomPolicy(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name string
+		msg  types.MsgSetNewDenomPolicy
+		err  string
+	}{
+		{
+			name: "successful auto-create policy",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				InitialChannelValue: sdkmath.NewInt(1000),
+				MaxPercentSend:      sdkmath.NewInt(10),
+				MaxPercentRecv:      sdkmath.NewInt(10),
+				DurationHours:       24,
+			},
+		},
+		{
+			name: "successful block policy without quota",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_BLOCK,
+				InitialChannelValue: sdkmath.NewInt(1000),
+			},
+		},
+		{
+			name: "block without initial channel value",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority: validAuthority,
+				Action:    types.NEW_DENOM_BLOCK,
+			},
+			err: "initial channel value must be greater than 0",
+		},
+		{
+			name: "auto-create with negative initial channel value",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				MaxPercentSend:      sdkmath.NewInt(10),
+				MaxPercentRecv:      sdkmath.NewInt(10),
+				DurationHours:       24,
+				InitialChannelValue: sdkmath.NewInt(-1),
+			},
+			err: "initial channel value must be greater than 0",
+		},
+		{
+			name: "successful allow policy without quota",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority: validAuthority,
+				Action:    types.NEW_DENOM_ALLOW,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           "invalid_address",
+				Action:              types.NEW_DENOM_BLOCK,
+				InitialChannelValue: sdkmath.NewInt(1000),
+			},
+			err: "invalid authority",
+		},
+		{
+			name: "invalid action",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority: validAuthority,
+				Action:    types.NewDenomAction(10),
+			},
+			err: "invalid new denom action",
+		},
+		{
+			name: "auto-create without quota",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				InitialChannelValue: sdkmath.NewInt(1000),
+			},
+			err: "percent must be between 0 and 100",
+		},
+		{
+			name: "auto-create with invalid percent",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				InitialChannelValue: sdkmath.NewInt(1000),
+				MaxPercentSend:      sdkmath.NewInt(101),
+				MaxPercentRecv:      sdkmath.NewInt(10),
+				DurationHours:       24,
+			},
+			err: "percent must be between 0 and 100",
+		},
+		{
+			name: "auto-create with both percents zero",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				InitialChannelValue: sdkmath.NewInt(1000),
+				MaxPercentSend:      sdkmath.ZeroInt(),
+				MaxPercentRecv:      sdkmath.ZeroInt(),
+				DurationHours:       24,
+			},
+			err: "either the max send or max receive threshold must be greater than 0",
+		},
+		{
+			name: "auto-create with zero duration",
+			msg: types.MsgSetNewDenomPolicy{
+				Authority:           validAuthority,
+				Action:              types.NEW_DENOM_AUTO_CREATE,
+				InitialChannelValue: sdkmath.NewInt(1000),
+				MaxPercentSend:      sdkmath.NewInt(10),
+				MaxPercentRecv:      sdkmath.NewInt(10),
+				DurationHours:       0,
+			},
+			err: "duration can not be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err == "" {
+				require.NoError(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+
+				require.Equal(t, tc.msg.Type(), types.TypeMsgSetNewDenomPolicy, "type")
+				require.Equal(t, tc.msg.Route(), types.ModuleName, "route")
+			} else {
+				require.ErrorContains(t, tc.msg.ValidateBasic(), tc.err, "test: %v", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Returns the default new denom policy, under which IBC denoms without a
+// rate limit are allowed through
+func DefaultNewDenomPolicy() NewDenomPolicy {
+	return NewDenomPolicy{
+		Action: NEW_DENOM_ALLOW,
+		Quota: Quota{
+			MaxPercentSend: sdkmath.ZeroInt(),
+			MaxPercentRecv: sdkmath.ZeroInt(),
+			DurationHours:  0,
+		},
+		InitialChannelValue: sdkmath.ZeroInt(),
+	}
+}
+
+// Validates the policy action, the initial channel value if new denoms are rate limited,
+// and, if rate limits will be auto-created, the quota template
+func (p NewDenomPolicy) Validate() error {
+	if _, ok := NewDenomAction_name[int32(p.Action)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid new denom action (%d)", p.Action)
+	}
+
+	if p.Action == NEW_DENOM_ALLOW {
+		return nil
+	}
+
+	// Without an initial channel value, the rate limits of denoms that have no supply yet
+	// could not be enforced (auto-created) or added by governance (blocked)
+	if p.InitialChannelValue.IsNil() || !p.InitialChannelValue.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"initial channel value must be greater than 0, Provided: %v", p.InitialChannelValue)
+	}
+
+	// The quota is only used when creating rate limits
+	if p.Action != NEW_DENOM_AUTO_CREATE {
+		return nil
+	}
+
+	return p.Quota.Validate()
+}
+
+// Validates the quota thresholds and duration, using the same rules as MsgAddRateLimit
+func (q Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.GT(sdkmath.NewInt(100)) || q.MaxPercentSend.LT(sdkmath.ZeroInt()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"max-percent-send percent must be between 0 and 100 (inclusively), Provided: %v", q.MaxPercentSend)
+	}
+
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.GT(sdkmath.NewInt(100)) || q.MaxPercentRecv.LT(sdkmath.ZeroInt()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"max-percent-recv percent must be between 0 and 100 (inclusively), Provided: %v", q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentRecv.IsZero() && q.MaxPercentSend.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"either the max send or max receive threshold must be greater than 0")
+	}
+
+	if q.DurationHours == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
+	}
+
+	return nil
+}
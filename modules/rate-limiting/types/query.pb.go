@@ -474,6 +474,253 @@ func (m *QueryAllBlacklistedDenomsResponse) GetDenoms() []string {
 	return nil
 }
 
+// Queries all blacklisted denom patterns
+type QueryAllBlacklistedDenomPatternsRequest struct {
+}
+
+func (m *QueryAllBlacklistedDenomPatternsRequest) Reset() {
+	*m = QueryAllBlacklistedDenomPatternsRequest{}
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlacklistedDenomPatternsRequest) ProtoMessage()    {}
+func (*QueryAllBlacklistedDenomPatternsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{10}
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlacklistedDenomPatternsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlacklistedDenomPatternsRequest.Merge(m, src)
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlacklistedDenomPatternsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlacklistedDenomPatternsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBlacklistedDenomPatternsRequest proto.InternalMessageInfo
+
+type QueryAllBlacklistedDenomPatternsResponse struct {
+	Patterns []DenomBlacklistPattern `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns"`
+}
+
+func (m *QueryAllBlacklistedDenomPatternsResponse) Reset() {
+	*m = QueryAllBlacklistedDenomPatternsResponse{}
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlacklistedDenomPatternsResponse) ProtoMessage()    {}
+func (*QueryAllBlacklistedDenomPatternsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{11}
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlacklistedDenomPatternsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlacklistedDenomPatternsResponse.Merge(m, src)
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlacklistedDenomPatternsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBlacklistedDenomPatternsResponse proto.InternalMessageInfo
+
+func (m *QueryAllBlacklistedDenomPatternsResponse) GetPatterns() []DenomBlacklistPattern {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+// Queries the policy applied to IBC denoms received without a rate limit
+type QueryNewDenomPolicyRequest struct {
+}
+
+func (m *QueryNewDenomPolicyRequest) Reset()         { *m = QueryNewDenomPolicyRequest{} }
+func (m *QueryNewDenomPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNewDenomPolicyRequest) ProtoMessage()    {}
+func (*QueryNewDenomPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{12}
+}
+func (m *QueryNewDenomPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNewDenomPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNewDenomPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNewDenomPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNewDenomPolicyRequest.Merge(m, src)
+}
+func (m *QueryNewDenomPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNewDenomPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNewDenomPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNewDenomPolicyRequest proto.InternalMessageInfo
+
+type QueryNewDenomPolicyResponse struct {
+	Policy NewDenomPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryNewDenomPolicyResponse) Reset()         { *m = QueryNewDenomPolicyResponse{} }
+func (m *QueryNewDenomPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNewDenomPolicyResponse) ProtoMessage()    {}
+func (*QueryNewDenomPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{13}
+}
+func (m *QueryNewDenomPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNewDenomPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNewDenomPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNewDenomPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNewDenomPolicyResponse.Merge(m, src)
+}
+func (m *QueryNewDenomPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNewDenomPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNewDenomPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNewDenomPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryNewDenomPolicyResponse) GetPolicy() NewDenomPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return NewDenomPolicy{}
+}
+
+// Queries all rate limits that were automatically created from the new denom policy
+type QueryAllAutoCreatedRateLimitsRequest struct {
+}
+
+func (m *QueryAllAutoCreatedRateLimitsRequest) Reset()         { *m = QueryAllAutoCreatedRateLimitsRequest{} }
+func (m *QueryAllAutoCreatedRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAutoCreatedRateLimitsRequest) ProtoMessage()    {}
+func (*QueryAllAutoCreatedRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{14}
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAutoCreatedRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAutoCreatedRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAutoCreatedRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAutoCreatedRateLimitsRequest proto.InternalMessageInfo
+
+type QueryAllAutoCreatedRateLimitsResponse struct {
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) Reset()         { *m = QueryAllAutoCreatedRateLimitsResponse{} }
+func (m *QueryAllAutoCreatedRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAutoCreatedRateLimitsResponse) ProtoMessage()    {}
+func (*QueryAllAutoCreatedRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d909918e357d6d0b, []int{15}
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAutoCreatedRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAutoCreatedRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAutoCreatedRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAutoCreatedRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 // Queries all whitelisted address pairs
 type QueryAllWhitelistedAddressesRequest struct {
 }
@@ -482,7 +729,7 @@ func (m *QueryAllWhitelistedAddressesRequest) Reset()         { *m = QueryAllWhi
 func (m *QueryAllWhitelistedAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesRequest) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{10}
+	return fileDescriptor_d909918e357d6d0b, []int{16}
 }
 func (m *QueryAllWhitelistedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +766,7 @@ func (m *QueryAllWhitelistedAddressesResponse) Reset()         { *m = QueryAllWh
 func (m *QueryAllWhitelistedAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesResponse) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d909918e357d6d0b, []int{11}
+	return fileDescriptor_d909918e357d6d0b, []int{17}
 }
 func (m *QueryAllWhitelistedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -566,6 +813,12 @@ func init() {
 	proto.RegisterType((*QueryRateLimitsByChannelOrClientIdResponse)(nil), "ratelimit.v1.QueryRateLimitsByChannelOrClientIdResponse")
 	proto.RegisterType((*QueryAllBlacklistedDenomsRequest)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsRequest")
 	proto.RegisterType((*QueryAllBlacklistedDenomsResponse)(nil), "ratelimit.v1.QueryAllBlacklistedDenomsResponse")
+	proto.RegisterType((*QueryAllBlacklistedDenomPatternsRequest)(nil), "ratelimit.v1.QueryAllBlacklistedDenomPatternsRequest")
+	proto.RegisterType((*QueryAllBlacklistedDenomPatternsResponse)(nil), "ratelimit.v1.QueryAllBlacklistedDenomPatternsResponse")
+	proto.RegisterType((*QueryNewDenomPolicyRequest)(nil), "ratelimit.v1.QueryNewDenomPolicyRequest")
+	proto.RegisterType((*QueryNewDenomPolicyResponse)(nil), "ratelimit.v1.QueryNewDenomPolicyResponse")
+	proto.RegisterType((*QueryAllAutoCreatedRateLimitsRequest)(nil), "ratelimit.v1.QueryAllAutoCreatedRateLimitsRequest")
+	proto.RegisterType((*QueryAllAutoCreatedRateLimitsResponse)(nil), "ratelimit.v1.QueryAllAutoCreatedRateLimitsResponse")
 	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesRequest")
 	proto.RegisterType((*QueryAllWhitelistedAddressesResponse)(nil), "ratelimit.v1.QueryAllWhitelistedAddressesResponse")
 }
@@ -573,53 +826,66 @@ func init() {
 func init() { proto.RegisterFile("ratelimit/v1/query.proto", fileDescriptor_d909918e357d6d0b) }
 
 var fileDescriptor_d909918e357d6d0b = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x4e, 0xd4, 0x4c,
-	0x14, 0xdf, 0xf2, 0x7d, 0xf0, 0x7d, 0x3b, 0xc0, 0x85, 0xe3, 0x0a, 0x4b, 0xd5, 0x05, 0x0b, 0x46,
-	0x34, 0xd9, 0x8e, 0x0b, 0xf1, 0x4f, 0x44, 0x89, 0x2c, 0xc6, 0x04, 0x43, 0x02, 0x56, 0x12, 0x13,
-	0x42, 0x6c, 0xa6, 0xed, 0x64, 0x77, 0x62, 0xb7, 0x53, 0x3a, 0x5d, 0xc8, 0xc6, 0x70, 0xe3, 0x13,
-	0x90, 0xf8, 0x00, 0xbe, 0x83, 0xd7, 0x3e, 0x00, 0x97, 0x24, 0xde, 0x78, 0x65, 0x0c, 0x18, 0x9f,
-	0xc3, 0x74, 0xda, 0x6d, 0x2d, 0xb4, 0xcb, 0x42, 0xb8, 0xdb, 0xf6, 0xfc, 0xce, 0xef, 0xfc, 0x7e,
-	0xa7, 0xe7, 0x9c, 0x2c, 0x28, 0x7b, 0xd8, 0x27, 0x36, 0x6d, 0x51, 0x1f, 0xed, 0xd4, 0xd0, 0x76,
-	0x9b, 0x78, 0x1d, 0xd5, 0xf5, 0x98, 0xcf, 0xe0, 0x48, 0x1c, 0x51, 0x77, 0x6a, 0x72, 0xa9, 0xc1,
-	0x1a, 0x4c, 0x04, 0x50, 0xf0, 0x2b, 0xc4, 0xc8, 0x37, 0x1a, 0x8c, 0x35, 0x6c, 0x82, 0xb0, 0x4b,
-	0x11, 0x76, 0x1c, 0xe6, 0x63, 0x9f, 0x32, 0x87, 0x77, 0xa3, 0x29, 0xee, 0x84, 0x4e, 0x44, 0x95,
-	0xeb, 0x60, 0xe2, 0x75, 0x50, 0x6e, 0xc9, 0xb6, 0x35, 0xec, 0x93, 0xd5, 0x20, 0xc4, 0x35, 0xb2,
-	0xdd, 0x26, 0xdc, 0x57, 0xb6, 0x80, 0x9c, 0x15, 0xe4, 0x2e, 0x73, 0x38, 0x81, 0x8b, 0x60, 0x38,
-	0x60, 0xd3, 0x05, 0x1d, 0x2f, 0x4b, 0x53, 0xff, 0xcc, 0x0e, 0xcf, 0x8d, 0xab, 0x7f, 0x0b, 0x56,
-	0xe3, 0xb4, 0xfa, 0xbf, 0x07, 0x3f, 0x26, 0x0b, 0x1a, 0xf0, 0x62, 0x1e, 0xe5, 0x1d, 0xb8, 0x26,
-	0xd8, 0x63, 0x4c, 0x54, 0x16, 0x96, 0xc0, 0xa0, 0x45, 0x1c, 0xd6, 0x2a, 0x4b, 0x53, 0xd2, 0x6c,
-	0x51, 0x0b, 0x1f, 0x20, 0x02, 0x25, 0xb3, 0x89, 0x1d, 0x87, 0xd8, 0x3a, 0xf3, 0x74, 0xd3, 0xa6,
-	0xc4, 0xf1, 0x75, 0x6a, 0x95, 0x07, 0x04, 0xe8, 0x4a, 0x14, 0x5b, 0xf3, 0x96, 0x45, 0x64, 0xc5,
-	0x52, 0xd6, 0xc1, 0xd8, 0x49, 0xfe, 0x48, 0xf9, 0x43, 0x00, 0x12, 0xe5, 0xa2, 0x4a, 0xbe, 0x70,
-	0xad, 0x18, 0x4b, 0x56, 0x9e, 0x82, 0xc9, 0x34, 0x23, 0xaf, 0x77, 0x96, 0x9b, 0x98, 0x3a, 0x2b,
-	0x56, 0x57, 0xfb, 0x04, 0xf8, 0xdf, 0x0c, 0xde, 0x04, 0xca, 0x42, 0xf9, 0xff, 0x99, 0x21, 0x42,
-	0x31, 0xc0, 0x54, 0x7e, 0xf6, 0x25, 0xf5, 0x74, 0x0b, 0xdc, 0xcd, 0xaa, 0x91, 0xee, 0x4c, 0x57,
-	0x6b, 0x5e, 0x47, 0xa5, 0xbc, 0x8e, 0xda, 0xe0, 0x5e, 0x3f, 0xec, 0x97, 0xe4, 0x45, 0x89, 0xfa,
-	0xb5, 0x64, 0xdb, 0x75, 0x1b, 0x9b, 0xef, 0x6d, 0xca, 0x7d, 0x62, 0xbd, 0x08, 0x86, 0x21, 0x9e,
-	0xd0, 0x05, 0x70, 0xab, 0x07, 0x26, 0x12, 0x32, 0x06, 0x86, 0xc4, 0x08, 0x85, 0x1a, 0x8a, 0x5a,
-	0xf4, 0xa4, 0xdc, 0x06, 0xd3, 0xdd, 0xe4, 0xb7, 0x4d, 0x1a, 0xa8, 0x0a, 0x92, 0x97, 0x2c, 0xcb,
-	0x23, 0x9c, 0x93, 0xb8, 0xc6, 0x2e, 0x98, 0xe9, 0x0d, 0x8b, 0xca, 0xac, 0x81, 0x51, 0x1c, 0xbe,
-	0xd4, 0x5d, 0x4c, 0xbd, 0xae, 0xe3, 0x99, 0xb4, 0xe3, 0xd3, 0x14, 0xeb, 0x98, 0x7a, 0x91, 0xfd,
-	0x11, 0x9c, 0xbc, 0xe2, 0x73, 0xfb, 0x45, 0x30, 0x28, 0x2a, 0xc3, 0xcf, 0x12, 0x18, 0x4d, 0x2d,
-	0x21, 0xbc, 0x93, 0x66, 0xcd, 0xdd, 0x61, 0x79, 0xf6, 0x6c, 0x60, 0xa8, 0x5f, 0x59, 0xf8, 0xf8,
-	0xed, 0xd7, 0xa7, 0x81, 0x07, 0x70, 0x1e, 0xbd, 0xf1, 0x3d, 0x6a, 0x91, 0xea, 0x2a, 0x36, 0x38,
-	0xa2, 0x86, 0x59, 0x0d, 0x18, 0xaa, 0x82, 0x82, 0x3a, 0x8d, 0xe4, 0x84, 0x24, 0xbf, 0x38, 0xfc,
-	0x22, 0x81, 0x62, 0xcc, 0x09, 0xa7, 0x33, 0x8a, 0x9e, 0x5c, 0x73, 0x79, 0xa6, 0x37, 0x28, 0x52,
-	0xb5, 0x29, 0x54, 0x6d, 0x40, 0xed, 0xfc, 0xaa, 0xd0, 0x87, 0xac, 0xf1, 0xde, 0x43, 0x46, 0x47,
-	0x0f, 0x4f, 0xca, 0x57, 0x09, 0x5c, 0xcd, 0xd8, 0x46, 0x58, 0xed, 0xa5, 0xec, 0xd4, 0xce, 0xcb,
-	0x6a, 0xbf, 0xf0, 0xc8, 0xd2, 0x4b, 0x61, 0xe9, 0x39, 0x5c, 0xbc, 0x40, 0xa3, 0x85, 0x27, 0x71,
-	0x5e, 0xf6, 0xe0, 0x6f, 0x09, 0xdc, 0xec, 0xb9, 0x8a, 0xf0, 0xd1, 0xd9, 0xca, 0x32, 0x4f, 0x83,
-	0xfc, 0xf8, 0xfc, 0x89, 0x91, 0x39, 0x4d, 0x98, 0x5b, 0x85, 0xaf, 0x2e, 0x6a, 0xee, 0xf4, 0x07,
-	0x0b, 0xbe, 0x53, 0x29, 0x6b, 0xc3, 0xa1, 0x9a, 0x3d, 0xdc, 0x79, 0xe7, 0x42, 0x46, 0x7d, 0xe3,
-	0x23, 0x37, 0xcb, 0xc2, 0xcd, 0x33, 0xb8, 0xd0, 0xb7, 0x1b, 0x23, 0xe1, 0x0a, 0xa7, 0x8c, 0xc3,
-	0x03, 0x09, 0x8c, 0xe7, 0x1c, 0x0f, 0x58, 0xcb, 0x56, 0xd4, 0xe3, 0x1e, 0xc9, 0x73, 0xe7, 0x49,
-	0xb9, 0xf0, 0xc8, 0xed, 0x26, 0x74, 0x3a, 0xee, 0xf2, 0xd5, 0x37, 0x0e, 0x8e, 0x2a, 0xd2, 0xe1,
-	0x51, 0x45, 0xfa, 0x79, 0x54, 0x91, 0xf6, 0x8f, 0x2b, 0x85, 0xc3, 0xe3, 0x4a, 0xe1, 0xfb, 0x71,
-	0xa5, 0xb0, 0xf9, 0xa4, 0x41, 0xfd, 0x66, 0xdb, 0x50, 0x4d, 0xd6, 0x42, 0x26, 0xe3, 0x2d, 0x16,
-	0xd2, 0x63, 0xd7, 0xe5, 0xa8, 0xc5, 0xac, 0xb6, 0x4d, 0x38, 0x4a, 0xd7, 0xda, 0xa9, 0xdd, 0x47,
-	0x7e, 0xc7, 0x25, 0xdc, 0x18, 0x12, 0xff, 0x45, 0xe6, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3d,
-	0xc8, 0x4b, 0xe5, 0x07, 0x09, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x0b, 0x0d, 0xcd, 0x6b, 0x83, 0xc4, 0x10, 0xda, 0xad, 0x13, 0xb6, 0xc1, 0x49, 0x69,
+	0x82, 0x94, 0x1d, 0x92, 0xa8, 0x05, 0x1a, 0xa8, 0xba, 0xbb, 0x14, 0x51, 0x88, 0x68, 0x58, 0x2a,
+	0x21, 0xaa, 0x0a, 0x6b, 0xd6, 0x1e, 0x6d, 0x46, 0x78, 0x3d, 0x8e, 0x67, 0x36, 0xd1, 0xaa, 0xea,
+	0x85, 0x5f, 0x80, 0xc4, 0x0f, 0xe0, 0xca, 0x99, 0x33, 0x3f, 0x20, 0x17, 0xa4, 0x4a, 0x5c, 0xe0,
+	0x82, 0x50, 0x82, 0xe0, 0x6f, 0x20, 0x8f, 0xc7, 0xde, 0x38, 0xb1, 0x1d, 0x6f, 0x94, 0x9b, 0x3d,
+	0xef, 0xbd, 0xef, 0x7d, 0xdf, 0xf3, 0xec, 0xf7, 0xb4, 0x50, 0x0b, 0x89, 0xa4, 0x1e, 0xeb, 0x33,
+	0x89, 0x77, 0x57, 0xf1, 0xce, 0x80, 0x86, 0xc3, 0x46, 0x10, 0x72, 0xc9, 0xd1, 0x95, 0x34, 0xd2,
+	0xd8, 0x5d, 0x35, 0x67, 0x7a, 0xbc, 0xc7, 0x55, 0x00, 0x47, 0x4f, 0x71, 0x8e, 0x39, 0xd7, 0xe3,
+	0xbc, 0xe7, 0x51, 0x4c, 0x02, 0x86, 0x89, 0xef, 0x73, 0x49, 0x24, 0xe3, 0xbe, 0x48, 0xa2, 0x19,
+	0xec, 0x11, 0x9c, 0x8a, 0x5a, 0xb3, 0x70, 0xfd, 0xcb, 0xa8, 0x5d, 0xd3, 0xf3, 0x3a, 0x44, 0xd2,
+	0xcd, 0x28, 0x24, 0x3a, 0x74, 0x67, 0x40, 0x85, 0xb4, 0x9e, 0x82, 0x99, 0x17, 0x14, 0x01, 0xf7,
+	0x05, 0x45, 0xf7, 0xe0, 0x72, 0x84, 0x66, 0x2b, 0x38, 0x51, 0x33, 0xe6, 0x5f, 0x5a, 0xba, 0xbc,
+	0x76, 0xad, 0x71, 0x94, 0x70, 0x23, 0x2d, 0x6b, 0xbd, 0xbc, 0xff, 0xd7, 0x8d, 0x89, 0x0e, 0x84,
+	0x29, 0x8e, 0xf5, 0x2d, 0xbc, 0xa1, 0xd0, 0xd3, 0x1c, 0xdd, 0x16, 0xcd, 0xc0, 0x45, 0x97, 0xfa,
+	0xbc, 0x5f, 0x33, 0xe6, 0x8d, 0xa5, 0xa9, 0x4e, 0xfc, 0x82, 0x30, 0xcc, 0x38, 0xdb, 0xc4, 0xf7,
+	0xa9, 0x67, 0xf3, 0xd0, 0x76, 0x3c, 0x46, 0x7d, 0x69, 0x33, 0xb7, 0x76, 0x41, 0x25, 0xbd, 0xa6,
+	0x63, 0x8f, 0xc2, 0xb6, 0x8a, 0x3c, 0x74, 0xad, 0x2d, 0xb8, 0x7a, 0x1c, 0x5f, 0x33, 0xbf, 0x03,
+	0x30, 0x62, 0xae, 0xba, 0x14, 0x13, 0xef, 0x4c, 0xa5, 0x94, 0xad, 0x0f, 0xe1, 0x46, 0x16, 0x51,
+	0xb4, 0x86, 0xed, 0x6d, 0xc2, 0xfc, 0x87, 0x6e, 0xc2, 0xfd, 0x3a, 0x5c, 0x72, 0xa2, 0x93, 0x88,
+	0x59, 0x4c, 0xff, 0x15, 0x27, 0xce, 0xb0, 0xba, 0x30, 0x5f, 0x5c, 0x7d, 0x4e, 0x33, 0x7d, 0x0a,
+	0xcb, 0x79, 0x3d, 0xb2, 0x93, 0x49, 0xb8, 0x16, 0x4d, 0xd4, 0x28, 0x9a, 0xa8, 0x07, 0xef, 0x54,
+	0x41, 0x3f, 0x27, 0x2d, 0x96, 0x9e, 0x57, 0xd3, 0xf3, 0x5a, 0x1e, 0x71, 0xbe, 0xf3, 0x98, 0x90,
+	0xd4, 0xfd, 0x38, 0xba, 0x0c, 0xe9, 0x0d, 0xdd, 0x80, 0xb7, 0x4a, 0x72, 0x34, 0x91, 0xab, 0x30,
+	0xa9, 0xae, 0x50, 0xcc, 0x61, 0xaa, 0xa3, 0xdf, 0xac, 0x65, 0xb8, 0x55, 0x54, 0xbc, 0x45, 0xa4,
+	0xa4, 0xa1, 0x9f, 0xf6, 0xd9, 0x81, 0xa5, 0xd3, 0x53, 0x75, 0xbb, 0x07, 0x70, 0x29, 0xd0, 0x67,
+	0x5a, 0xf4, 0x42, 0x56, 0xb4, 0x2a, 0x4b, 0x61, 0x74, 0xbd, 0x1e, 0x40, 0x5a, 0x6a, 0xcd, 0xe9,
+	0x1f, 0xdf, 0x17, 0x74, 0x2f, 0xee, 0xc3, 0x3d, 0xe6, 0x0c, 0x13, 0x42, 0xdf, 0xc0, 0x6c, 0x6e,
+	0x54, 0x73, 0xb8, 0x0b, 0x93, 0x81, 0x3a, 0xd1, 0xb7, 0x7b, 0x2e, 0xcb, 0x20, 0x5b, 0xa5, 0x5b,
+	0xeb, 0x0a, 0xeb, 0x6d, 0x58, 0x4c, 0xb4, 0x36, 0x07, 0x92, 0xb7, 0x43, 0x4a, 0x24, 0x75, 0x4f,
+	0xba, 0x43, 0x0f, 0x6e, 0x9e, 0x92, 0x77, 0x4e, 0x17, 0xe1, 0x26, 0x2c, 0x24, 0x8d, 0xbe, 0xde,
+	0x66, 0x92, 0xc6, 0xc3, 0x6f, 0xba, 0x6e, 0x48, 0x85, 0xa0, 0x29, 0x9f, 0x3d, 0x58, 0x2c, 0x4f,
+	0xd3, 0x74, 0x1e, 0xc1, 0x34, 0x89, 0x0f, 0xed, 0x80, 0xb0, 0x30, 0x21, 0xb4, 0x98, 0x25, 0x74,
+	0x12, 0x62, 0x8b, 0xb0, 0x50, 0xb3, 0xbb, 0x42, 0x46, 0x47, 0x62, 0xed, 0xbf, 0x69, 0xb8, 0xa8,
+	0x3a, 0xa3, 0x9f, 0x0c, 0x98, 0xce, 0x98, 0x25, 0xba, 0x95, 0x45, 0x2d, 0xf4, 0x5a, 0x73, 0xe9,
+	0xf4, 0xc4, 0x98, 0xbf, 0xb5, 0xf1, 0xfd, 0xef, 0xff, 0xfc, 0x78, 0xe1, 0x36, 0x5a, 0xc7, 0x5f,
+	0xc9, 0x90, 0xb9, 0x74, 0x65, 0x93, 0x74, 0x05, 0x66, 0x5d, 0x67, 0x25, 0x42, 0x58, 0x51, 0x10,
+	0xcc, 0xef, 0x8d, 0xac, 0x7e, 0xf4, 0x24, 0xd0, 0x2f, 0x06, 0x4c, 0xa5, 0x98, 0x68, 0x21, 0xa7,
+	0xe9, 0x71, 0x3b, 0x36, 0x17, 0xcb, 0x93, 0x34, 0xab, 0x27, 0x8a, 0xd5, 0x63, 0xd4, 0x19, 0x9f,
+	0x15, 0x7e, 0x96, 0x67, 0x43, 0xcf, 0x71, 0x77, 0x68, 0xc7, 0xd6, 0xff, 0xab, 0x01, 0xaf, 0xe7,
+	0xb8, 0x26, 0x5a, 0x29, 0x63, 0x76, 0xc2, 0x9b, 0xcd, 0x46, 0xd5, 0x74, 0x2d, 0xe9, 0x13, 0x25,
+	0xe9, 0x3e, 0xba, 0x77, 0x86, 0x41, 0xe3, 0x67, 0xc9, 0x1a, 0x78, 0x8e, 0xfe, 0x35, 0xe0, 0xcd,
+	0x52, 0xcb, 0x44, 0xef, 0x9d, 0xce, 0x2c, 0xd7, 0xc2, 0xcd, 0xf7, 0xc7, 0x2f, 0xd4, 0xe2, 0x3a,
+	0x4a, 0xdc, 0x26, 0xfa, 0xec, 0xac, 0xe2, 0x4e, 0x7e, 0xb0, 0xe8, 0x3b, 0xcd, 0xe4, 0x39, 0x31,
+	0x6a, 0xe4, 0x5f, 0xee, 0x22, 0x5b, 0x37, 0x71, 0xe5, 0x7c, 0xad, 0xa6, 0xad, 0xd4, 0x7c, 0x84,
+	0x36, 0x2a, 0xab, 0xe9, 0x8e, 0xb0, 0xe2, 0x5b, 0x26, 0xd0, 0x9f, 0x06, 0xcc, 0x96, 0x18, 0x3c,
+	0xba, 0x5d, 0x8d, 0xd5, 0xb1, 0xdd, 0x61, 0xde, 0x19, 0xb7, 0x4c, 0x6b, 0xfa, 0x5c, 0x69, 0x7a,
+	0x80, 0xda, 0x67, 0xd7, 0x64, 0x27, 0xdb, 0x04, 0xfd, 0x6c, 0xc0, 0xab, 0x59, 0xd7, 0x47, 0x79,
+	0x8e, 0x93, 0xbb, 0x6c, 0xcc, 0xe5, 0x0a, 0x99, 0x9a, 0x74, 0x53, 0x91, 0xde, 0x40, 0x1f, 0x54,
+	0x26, 0xed, 0xd3, 0xbd, 0x84, 0x6c, 0xcc, 0xeb, 0x37, 0x03, 0x6a, 0x45, 0x3b, 0x05, 0xad, 0xe5,
+	0x0f, 0xb3, 0x6c, 0x51, 0x99, 0xeb, 0x63, 0xd5, 0x68, 0x21, 0x9f, 0x2a, 0x21, 0x2d, 0x74, 0xbf,
+	0xb2, 0x10, 0x32, 0x90, 0xdc, 0x76, 0x62, 0x40, 0xfb, 0x88, 0xe5, 0xee, 0x1b, 0x70, 0xad, 0x60,
+	0x27, 0xa1, 0xd5, 0x7c, 0x6a, 0x25, 0x6b, 0xce, 0x5c, 0x1b, 0xa7, 0xe4, 0xcc, 0x4e, 0xb6, 0x37,
+	0x82, 0xb3, 0x49, 0x82, 0xd7, 0x7a, 0xbc, 0x7f, 0x50, 0x37, 0x5e, 0x1c, 0xd4, 0x8d, 0xbf, 0x0f,
+	0xea, 0xc6, 0x0f, 0x87, 0xf5, 0x89, 0x17, 0x87, 0xf5, 0x89, 0x3f, 0x0e, 0xeb, 0x13, 0x4f, 0xee,
+	0xf6, 0x98, 0xdc, 0x1e, 0x74, 0x1b, 0x0e, 0xef, 0x63, 0x87, 0x8b, 0x3e, 0x8f, 0xe1, 0x49, 0x10,
+	0x08, 0xdc, 0xe7, 0xee, 0xc0, 0xa3, 0x02, 0x67, 0x7b, 0xed, 0xae, 0xbe, 0x8b, 0xe5, 0x30, 0xa0,
+	0xa2, 0x3b, 0xa9, 0xfe, 0x8a, 0xac, 0xff, 0x3f, 0x00, 0x2a, 0x36, 0xac, 0x00, 0x06, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -646,6 +912,12 @@ type QueryClient interface {
 	RateLimitsByChannelOrClientId(ctx context.Context, in *QueryRateLimitsByChannelOrClientIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelOrClientIdResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all blacklisted denom patterns
+	AllBlacklistedDenomPatterns(ctx context.Context, in *QueryAllBlacklistedDenomPatternsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomPatternsResponse, error)
+	// Queries the policy applied to IBC denoms received without a rate limit
+	NewDenomPolicy(ctx context.Context, in *QueryNewDenomPolicyRequest, opts ...grpc.CallOption) (*QueryNewDenomPolicyResponse, error)
+	// Queries all rate limits that were automatically created from the new denom policy
+	AllAutoCreatedRateLimits(ctx context.Context, in *QueryAllAutoCreatedRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllAutoCreatedRateLimitsResponse, error)
 	// Queries all whitelisted address pairs
 	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
 }
@@ -703,6 +975,33 @@ func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlac
 	return out, nil
 }
 
+func (c *queryClient) AllBlacklistedDenomPatterns(ctx context.Context, in *QueryAllBlacklistedDenomPatternsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomPatternsResponse, error) {
+	out := new(QueryAllBlacklistedDenomPatternsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllBlacklistedDenomPatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NewDenomPolicy(ctx context.Context, in *QueryNewDenomPolicyRequest, opts ...grpc.CallOption) (*QueryNewDenomPolicyResponse, error) {
+	out := new(QueryNewDenomPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/NewDenomPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllAutoCreatedRateLimits(ctx context.Context, in *QueryAllAutoCreatedRateLimitsRequest, opts ...grpc.CallOption) (*QueryAllAutoCreatedRateLimitsResponse, error) {
+	out := new(QueryAllAutoCreatedRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllAutoCreatedRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error) {
 	out := new(QueryAllWhitelistedAddressesResponse)
 	err := c.cc.Invoke(ctx, "/ratelimit.v1.Query/AllWhitelistedAddresses", in, out, opts...)
@@ -726,6 +1025,12 @@ type QueryServer interface {
 	RateLimitsByChannelOrClientId(context.Context, *QueryRateLimitsByChannelOrClientIdRequest) (*QueryRateLimitsByChannelOrClientIdResponse, error)
 	// Queries all blacklisted denoms
 	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
+	// Queries all blacklisted denom patterns
+	AllBlacklistedDenomPatterns(context.Context, *QueryAllBlacklistedDenomPatternsRequest) (*QueryAllBlacklistedDenomPatternsResponse, error)
+	// Queries the policy applied to IBC denoms received without a rate limit
+	NewDenomPolicy(context.Context, *QueryNewDenomPolicyRequest) (*QueryNewDenomPolicyResponse, error)
+	// Queries all rate limits that were automatically created from the new denom policy
+	AllAutoCreatedRateLimits(context.Context, *QueryAllAutoCreatedRateLimitsRequest) (*QueryAllAutoCreatedRateLimitsResponse, error)
 	// Queries all whitelisted address pairs
 	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
 }
@@ -749,6 +1054,15 @@ func (*UnimplementedQueryServer) RateLimitsByChannelOrClientId(ctx context.Conte
 func (*UnimplementedQueryServer) AllBlacklistedDenoms(ctx context.Context, req *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
 }
+func (*UnimplementedQueryServer) AllBlacklistedDenomPatterns(ctx context.Context, req *QueryAllBlacklistedDenomPatternsRequest) (*QueryAllBlacklistedDenomPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenomPatterns not implemented")
+}
+func (*UnimplementedQueryServer) NewDenomPolicy(ctx context.Context, req *QueryNewDenomPolicyRequest) (*QueryNewDenomPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewDenomPolicy not implemented")
+}
+func (*UnimplementedQueryServer) AllAutoCreatedRateLimits(ctx context.Context, req *QueryAllAutoCreatedRateLimitsRequest) (*QueryAllAutoCreatedRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllAutoCreatedRateLimits not implemented")
+}
 func (*UnimplementedQueryServer) AllWhitelistedAddresses(ctx context.Context, req *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllWhitelistedAddresses not implemented")
 }
@@ -847,6 +1161,60 @@ func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllBlacklistedDenomPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedDenomPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBlacklistedDenomPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllBlacklistedDenomPatterns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBlacklistedDenomPatterns(ctx, req.(*QueryAllBlacklistedDenomPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NewDenomPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNewDenomPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NewDenomPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/NewDenomPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NewDenomPolicy(ctx, req.(*QueryNewDenomPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllAutoCreatedRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAutoCreatedRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllAutoCreatedRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Query/AllAutoCreatedRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllAutoCreatedRateLimits(ctx, req.(*QueryAllAutoCreatedRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllWhitelistedAddressesRequest)
 	if err := dec(in); err != nil {
@@ -865,6 +1233,7 @@ func _Query_AllWhitelistedAddresses_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ratelimit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -889,6 +1258,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllBlacklistedDenoms",
 			Handler:    _Query_AllBlacklistedDenoms_Handler,
 		},
+		{
+			MethodName: "AllBlacklistedDenomPatterns",
+			Handler:    _Query_AllBlacklistedDenomPatterns_Handler,
+		},
+		{
+			MethodName: "NewDenomPolicy",
+			Handler:    _Query_NewDenomPolicy_Handler,
+		},
+		{
+			MethodName: "AllAutoCreatedRateLimits",
+			Handler:    _Query_AllAutoCreatedRateLimits_Handler,
+		},
 		{
 			MethodName: "AllWhitelistedAddresses",
 			Handler:    _Query_AllWhitelistedAddresses_Handler,
@@ -1219,7 +1600,7 @@ func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBlacklistedDenomPatternsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1229,12 +1610,12 @@ func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBlacklistedDenomPatternsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBlacklistedDenomPatternsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1242,7 +1623,7 @@ func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllBlacklistedDenomPatternsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1252,20 +1633,20 @@ func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllBlacklistedDenomPatternsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllBlacklistedDenomPatternsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AddressPairs) > 0 {
-		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Patterns) > 0 {
+		for iNdEx := len(m.Patterns) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Patterns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1279,34 +1660,210 @@ func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryNewDenomPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllRateLimitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryNewDenomPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllRateLimitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNewDenomPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RateLimits) > 0 {
-		for _, e := range m.RateLimits {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNewDenomPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNewDenomPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNewDenomPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAutoCreatedRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAutoCreatedRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAutoCreatedRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressPairs) > 0 {
+		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1424,6 +1981,74 @@ func (m *QueryAllBlacklistedDenomsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllBlacklistedDenomPatternsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBlacklistedDenomPatternsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Patterns) > 0 {
+		for _, e := range m.Patterns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNewDenomPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNewDenomPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAutoCreatedRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllAutoCreatedRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAllWhitelistedAddressesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2252,6 +2877,407 @@ func (m *QueryAllBlacklistedDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllBlacklistedDenomPatternsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomPatternsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomPatternsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedDenomPatternsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomPatternsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedDenomPatternsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patterns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patterns = append(m.Patterns, DenomBlacklistPattern{})
+			if err := m.Patterns[len(m.Patterns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNewDenomPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNewDenomPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNewDenomPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNewDenomPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNewDenomPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNewDenomPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAutoCreatedRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAutoCreatedRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAutoCreatedRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAutoCreatedRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAutoCreatedRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAutoCreatedRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllWhitelistedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
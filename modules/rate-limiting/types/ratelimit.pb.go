@@ -6,6 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
@@ -55,6 +57,76 @@ func (PacketDirection) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a3afe8dd489c3bd2, []int{0}
 }
 
+// BlacklistPatternType defines how a blacklist pattern is matched against
+// the full denom trace of a transfer (e.g. transfer/channel-5/uatom)
+type BlacklistPatternType int32
+
+const (
+	// Matches every voucher with the given base denom (e.g. uatom),
+	// regardless of the path it took
+	BLACKLIST_BASE_DENOM BlacklistPatternType = 0
+	// Matches every voucher whose trace path starts with the given
+	// port/channel hops (e.g. transfer/channel-5)
+	BLACKLIST_TRACE_PREFIX BlacklistPatternType = 1
+	// Matches the full denom trace against a glob, where '*' matches any
+	// sequence of characters and '?' matches a single character
+	// (e.g. transfer/*/uatom)
+	BLACKLIST_GLOB BlacklistPatternType = 2
+)
+
+var BlacklistPatternType_name = map[int32]string{
+	0: "BLACKLIST_BASE_DENOM",
+	1: "BLACKLIST_TRACE_PREFIX",
+	2: "BLACKLIST_GLOB",
+}
+
+var BlacklistPatternType_value = map[string]int32{
+	"BLACKLIST_BASE_DENOM":   0,
+	"BLACKLIST_TRACE_PREFIX": 1,
+	"BLACKLIST_GLOB":         2,
+}
+
+func (x BlacklistPatternType) String() string {
+	return proto.EnumName(BlacklistPatternType_name, int32(x))
+}
+
+func (BlacklistPatternType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{1}
+}
+
+// NewDenomAction defines what happens the first time an IBC denom is
+// received on a channel or client that does not have a rate limit
+type NewDenomAction int32
+
+const (
+	// The transfer is allowed without a rate limit
+	NEW_DENOM_ALLOW NewDenomAction = 0
+	// A rate limit is created from the policy's quota
+	NEW_DENOM_AUTO_CREATE NewDenomAction = 1
+	// The transfer is rejected until a rate limit is added by governance
+	NEW_DENOM_BLOCK NewDenomAction = 2
+)
+
+var NewDenomAction_name = map[int32]string{
+	0: "NEW_DENOM_ALLOW",
+	1: "NEW_DENOM_AUTO_CREATE",
+	2: "NEW_DENOM_BLOCK",
+}
+
+var NewDenomAction_value = map[string]int32{
+	"NEW_DENOM_ALLOW":       0,
+	"NEW_DENOM_AUTO_CREATE": 1,
+	"NEW_DENOM_BLOCK":       2,
+}
+
+func (x NewDenomAction) String() string {
+	return proto.EnumName(NewDenomAction_name, int32(x))
+}
+
+func (NewDenomAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{2}
+}
+
 // Path holds the denom and channelID that define the rate limited route
 type Path struct {
 	Denom             string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -276,6 +348,18 @@ func (m *RateLimit) GetFlow() *Flow {
 type WhitelistedAddressPair struct {
 	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Optional block height at which the pair is removed from the whitelist
+	// If zero, the pair does not expire by height
+	ExpirationHeight int64 `protobuf:"varint,3,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// Optional block time at which the pair is removed from the whitelist
+	// If not set, the pair does not expire by time
+	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// Optional per-denom cap on the cumulative amount that can bypass the quota
+	// Each whitelisted transfer is deducted from the cap of its denom, and once
+	// every denom is used up, the pair is removed from the whitelist
+	// Denoms without a cap are not whitelisted for the pair
+	// If not set, the amount is unlimited for every denom
+	RemainingAmountCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=remaining_amount_cap,json=remainingAmountCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_amount_cap"`
 }
 
 func (m *WhitelistedAddressPair) Reset()         { *m = WhitelistedAddressPair{} }
@@ -325,6 +409,239 @@ func (m *WhitelistedAddressPair) GetReceiver() string {
 	return ""
 }
 
+func (m *WhitelistedAddressPair) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *WhitelistedAddressPair) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *WhitelistedAddressPair) GetRemainingAmountCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmountCap
+	}
+	return nil
+}
+
+// PendingWhitelistedTransfer records the amount that an outbound transfer
+// deducted from a whitelisted pair's amount cap, so that it can be restored
+// if the packet fails or times out
+type PendingWhitelistedTransfer struct {
+	ChannelOrClientId string      `protobuf:"bytes,1,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	Sequence          uint64      `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender            string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver          string      `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount            types1.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// The expiration of the pair when the transfer was sent, used to restore
+	// the pair if the transfer used up its cap
+	ExpirationHeight int64      `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	ExpirationTime   *time.Time `protobuf:"bytes,7,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+}
+
+func (m *PendingWhitelistedTransfer) Reset()         { *m = PendingWhitelistedTransfer{} }
+func (m *PendingWhitelistedTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingWhitelistedTransfer) ProtoMessage()    {}
+func (*PendingWhitelistedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{5}
+}
+func (m *PendingWhitelistedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWhitelistedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWhitelistedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingWhitelistedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWhitelistedTransfer.Merge(m, src)
+}
+func (m *PendingWhitelistedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWhitelistedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWhitelistedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingWhitelistedTransfer proto.InternalMessageInfo
+
+func (m *PendingWhitelistedTransfer) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *PendingWhitelistedTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingWhitelistedTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingWhitelistedTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *PendingWhitelistedTransfer) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *PendingWhitelistedTransfer) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *PendingWhitelistedTransfer) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+// DenomBlacklistPattern blocks all transfers whose denom trace matches
+// the pattern, as opposed to the exact (hashed) denom blacklist
+type DenomBlacklistPattern struct {
+	MatchType BlacklistPatternType `protobuf:"varint,1,opt,name=match_type,json=matchType,proto3,enum=ratelimit.v1.BlacklistPatternType" json:"match_type,omitempty"`
+	Pattern   string               `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (m *DenomBlacklistPattern) Reset()         { *m = DenomBlacklistPattern{} }
+func (m *DenomBlacklistPattern) String() string { return proto.CompactTextString(m) }
+func (*DenomBlacklistPattern) ProtoMessage()    {}
+func (*DenomBlacklistPattern) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{6}
+}
+func (m *DenomBlacklistPattern) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBlacklistPattern) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBlacklistPattern.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBlacklistPattern) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBlacklistPattern.Merge(m, src)
+}
+func (m *DenomBlacklistPattern) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBlacklistPattern) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBlacklistPattern.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBlacklistPattern proto.InternalMessageInfo
+
+func (m *DenomBlacklistPattern) GetMatchType() BlacklistPatternType {
+	if m != nil {
+		return m.MatchType
+	}
+	return BLACKLIST_BASE_DENOM
+}
+
+func (m *DenomBlacklistPattern) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+// NewDenomPolicy is an optional governance-set template for IBC denoms
+// that are received without an existing rate limit
+type NewDenomPolicy struct {
+	Action NewDenomAction `protobuf:"varint,1,opt,name=action,proto3,enum=ratelimit.v1.NewDenomAction" json:"action,omitempty"`
+	// Quota used for auto-created rate limits
+	// Only relevant when the action is NEW_DENOM_AUTO_CREATE
+	Quota Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+	// InitialChannelValue is used as the channel value of rate limits on IBC
+	// denoms that have no supply yet (e.g. before the first voucher is minted),
+	// so that their quota is enforced from the first packet
+	// Required unless the action is NEW_DENOM_ALLOW
+	InitialChannelValue cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=initial_channel_value,json=initialChannelValue,proto3,customtype=cosmossdk.io/math.Int" json:"initial_channel_value"`
+}
+
+func (m *NewDenomPolicy) Reset()         { *m = NewDenomPolicy{} }
+func (m *NewDenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*NewDenomPolicy) ProtoMessage()    {}
+func (*NewDenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3afe8dd489c3bd2, []int{7}
+}
+func (m *NewDenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewDenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewDenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NewDenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewDenomPolicy.Merge(m, src)
+}
+func (m *NewDenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewDenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewDenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewDenomPolicy proto.InternalMessageInfo
+
+func (m *NewDenomPolicy) GetAction() NewDenomAction {
+	if m != nil {
+		return m.Action
+	}
+	return NEW_DENOM_ALLOW
+}
+
+func (m *NewDenomPolicy) GetQuota() Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return Quota{}
+}
+
 type HourEpoch struct {
 	EpochNumber      uint64        `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	Duration         time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
@@ -336,7 +653,7 @@ func (m *HourEpoch) Reset()         { *m = HourEpoch{} }
 func (m *HourEpoch) String() string { return proto.CompactTextString(m) }
 func (*HourEpoch) ProtoMessage()    {}
 func (*HourEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3afe8dd489c3bd2, []int{5}
+	return fileDescriptor_a3afe8dd489c3bd2, []int{8}
 }
 func (m *HourEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,61 +712,93 @@ func (m *HourEpoch) GetEpochStartHeight() int64 {
 
 func init() {
 	proto.RegisterEnum("ratelimit.v1.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterEnum("ratelimit.v1.BlacklistPatternType", BlacklistPatternType_name, BlacklistPatternType_value)
+	proto.RegisterEnum("ratelimit.v1.NewDenomAction", NewDenomAction_name, NewDenomAction_value)
 	proto.RegisterType((*Path)(nil), "ratelimit.v1.Path")
 	proto.RegisterType((*Quota)(nil), "ratelimit.v1.Quota")
 	proto.RegisterType((*Flow)(nil), "ratelimit.v1.Flow")
 	proto.RegisterType((*RateLimit)(nil), "ratelimit.v1.RateLimit")
 	proto.RegisterType((*WhitelistedAddressPair)(nil), "ratelimit.v1.WhitelistedAddressPair")
+	proto.RegisterType((*PendingWhitelistedTransfer)(nil), "ratelimit.v1.PendingWhitelistedTransfer")
+	proto.RegisterType((*DenomBlacklistPattern)(nil), "ratelimit.v1.DenomBlacklistPattern")
+	proto.RegisterType((*NewDenomPolicy)(nil), "ratelimit.v1.NewDenomPolicy")
 	proto.RegisterType((*HourEpoch)(nil), "ratelimit.v1.HourEpoch")
 }
 
 func init() { proto.RegisterFile("ratelimit/v1/ratelimit.proto", fileDescriptor_a3afe8dd489c3bd2) }
 
 var fileDescriptor_a3afe8dd489c3bd2 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x42, 0xe1, 0x0b, 0xb3, 0xfc, 0xd8, 0xef, 0x7c, 0xf9, 0x92, 0x75, 0xa3, 0x5d, 0xdc,
-	0x44, 0x83, 0x06, 0x5a, 0xc1, 0x18, 0xa3, 0x37, 0x16, 0x56, 0x21, 0x22, 0xae, 0x05, 0x31, 0xf1,
-	0xd2, 0xcc, 0xb6, 0x43, 0x3b, 0xa1, 0xed, 0xd4, 0x99, 0x69, 0x81, 0xb3, 0x89, 0xf1, 0xc8, 0xd1,
-	0xbb, 0xff, 0x85, 0x27, 0x8f, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe0, 0xe6, 0x5f, 0x61, 0x66, 0xda,
-	0x02, 0xa2, 0x89, 0x78, 0xeb, 0x7b, 0x9f, 0x1f, 0xf3, 0xe6, 0xbd, 0x37, 0x05, 0x57, 0x19, 0x12,
-	0x38, 0x24, 0x11, 0x11, 0x56, 0x36, 0x67, 0x9d, 0x06, 0x66, 0xc2, 0xa8, 0xa0, 0x70, 0xe4, 0x2c,
-	0x91, 0xcd, 0x35, 0x26, 0x7c, 0xea, 0x53, 0x05, 0x58, 0xf2, 0x2b, 0xe7, 0x34, 0x0c, 0x9f, 0x52,
-	0x3f, 0xc4, 0x96, 0x8a, 0x7a, 0xe9, 0x96, 0xe5, 0xa5, 0x0c, 0x09, 0x42, 0xe3, 0x02, 0x6f, 0x5e,
-	0xc4, 0x05, 0x89, 0x30, 0x17, 0x28, 0x4a, 0x72, 0x42, 0xeb, 0x29, 0xd0, 0xbb, 0x48, 0x04, 0x70,
-	0x02, 0x0c, 0x78, 0x38, 0xa6, 0x51, 0x5d, 0x9b, 0xd2, 0xa6, 0x87, 0xed, 0x3c, 0x80, 0x16, 0x98,
-	0x70, 0x03, 0x14, 0xc7, 0x38, 0x74, 0x28, 0x73, 0xdc, 0x90, 0xe0, 0x58, 0x38, 0xc4, 0xab, 0xf7,
-	0x29, 0xd2, 0xbf, 0x05, 0xf6, 0x8c, 0x2d, 0x2a, 0x64, 0xc5, 0x6b, 0x7d, 0xd2, 0xc0, 0xc0, 0xf3,
-	0x94, 0x0a, 0x04, 0x1f, 0x83, 0x5a, 0x84, 0x76, 0x9d, 0x04, 0x33, 0x57, 0x8a, 0x38, 0x8e, 0xbd,
-	0xdc, 0xbb, 0x7d, 0xed, 0xe0, 0xa8, 0x59, 0xf9, 0x72, 0xd4, 0xfc, 0xdf, 0xa5, 0x3c, 0xa2, 0x9c,
-	0x7b, 0xdb, 0x26, 0xa1, 0x56, 0x84, 0x44, 0x60, 0xae, 0xc4, 0xc2, 0x1e, 0x8b, 0xd0, 0x6e, 0x37,
-	0x57, 0xad, 0xe3, 0xd8, 0xbb, 0x68, 0xc4, 0xb0, 0x9b, 0xe5, 0xe7, 0xff, 0x85, 0x91, 0x8d, 0xdd,
-	0x0c, 0xde, 0x00, 0x63, 0x65, 0x77, 0x9c, 0x80, 0xa6, 0x8c, 0xd7, 0xfb, 0xa7, 0xb4, 0x69, 0xdd,
-	0x1e, 0x2d, 0xb3, 0xcb, 0x32, 0xd9, 0xfa, 0xa8, 0x01, 0xfd, 0x51, 0x48, 0x77, 0xe0, 0x3d, 0x30,
-	0x48, 0xe2, 0xad, 0x90, 0xee, 0x5c, 0xae, 0xee, 0x82, 0x0c, 0xef, 0x83, 0x7f, 0x68, 0x2a, 0x94,
-	0xee, 0x52, 0x65, 0x96, 0x6c, 0xd8, 0x06, 0xa3, 0x65, 0xb3, 0x33, 0x14, 0xa6, 0x58, 0x95, 0xf7,
-	0x47, 0xf9, 0x48, 0xa1, 0xd9, 0x94, 0x92, 0xd6, 0x5b, 0x0d, 0x0c, 0xdb, 0x48, 0xe0, 0x55, 0xb9,
-	0x36, 0xf0, 0x26, 0xd0, 0x13, 0x24, 0x02, 0x55, 0x7f, 0x75, 0x1e, 0x9a, 0xe7, 0x17, 0xca, 0x94,
-	0x63, 0xb7, 0x15, 0x0e, 0x6f, 0x81, 0x81, 0xd7, 0x72, 0x68, 0xaa, 0xe0, 0xea, 0xfc, 0x7f, 0x3f,
-	0x13, 0xd5, 0x3c, 0xed, 0x9c, 0x21, 0x2d, 0xd5, 0xd5, 0xfa, 0x7f, 0x67, 0x29, 0xdb, 0x66, 0x2b,
-	0xbc, 0xb5, 0x0a, 0x26, 0x5f, 0x06, 0x44, 0x62, 0x5c, 0x60, 0x6f, 0xc1, 0xf3, 0x18, 0xe6, 0xbc,
-	0x8b, 0x08, 0x83, 0x93, 0x60, 0x50, 0x2e, 0x03, 0x66, 0xc5, 0xaa, 0x15, 0x11, 0x6c, 0x80, 0x21,
-	0x86, 0x5d, 0x4c, 0x32, 0xcc, 0x8a, 0xfd, 0x3a, 0x8d, 0x5b, 0x6f, 0xfa, 0xc0, 0xb0, 0x9c, 0x4e,
-	0x27, 0xa1, 0x6e, 0x00, 0xaf, 0x83, 0x11, 0x2c, 0x3f, 0x9c, 0x38, 0x8d, 0x7a, 0x85, 0x8f, 0x6e,
-	0x57, 0x55, 0x6e, 0x4d, 0xa5, 0xe0, 0x0b, 0x30, 0x54, 0x4e, 0xb5, 0xb8, 0xd4, 0x15, 0x33, 0x7f,
-	0x0a, 0x66, 0xf9, 0x14, 0xcc, 0xa5, 0x82, 0xd0, 0x36, 0x64, 0x87, 0xbf, 0x1f, 0x35, 0x61, 0x29,
-	0x99, 0xa1, 0x11, 0x11, 0x38, 0x4a, 0xc4, 0xde, 0xfb, 0xaf, 0x4d, 0xcd, 0x3e, 0xb5, 0x82, 0x6b,
-	0xa0, 0x96, 0x9f, 0xcc, 0x05, 0x62, 0xc2, 0x91, 0x8f, 0xa9, 0xe8, 0x44, 0xe3, 0x17, 0xfb, 0x8d,
-	0xf2, 0xa5, 0xb5, 0x87, 0xa4, 0xff, 0xbe, 0x74, 0x1a, 0x53, 0xea, 0x75, 0x29, 0x96, 0x30, 0x9c,
-	0x01, 0xf0, 0xbc, 0x5f, 0x80, 0x89, 0x1f, 0x88, 0xba, 0x3e, 0xa5, 0x4d, 0xf7, 0xdb, 0xb5, 0x33,
-	0xee, 0xb2, 0xca, 0xdf, 0x7e, 0x00, 0xc6, 0xbb, 0xc8, 0xdd, 0xc6, 0x62, 0x89, 0x30, 0xec, 0xaa,
-	0x82, 0xc6, 0x41, 0xb5, 0xbb, 0xb0, 0xf8, 0xa4, 0xb3, 0xe1, 0xac, 0x77, 0xd6, 0x96, 0x6a, 0x95,
-	0x73, 0x09, 0xbb, 0xb3, 0xb8, 0x59, 0xd3, 0x1a, 0xfa, 0xbb, 0x0f, 0x46, 0xa5, 0xbd, 0x71, 0x70,
-	0x6c, 0x68, 0x87, 0xc7, 0x86, 0xf6, 0xed, 0xd8, 0xd0, 0xf6, 0x4f, 0x8c, 0xca, 0xe1, 0x89, 0x51,
-	0xf9, 0x7c, 0x62, 0x54, 0x5e, 0x3d, 0xf4, 0x89, 0x08, 0xd2, 0x9e, 0xe9, 0xd2, 0xc8, 0xca, 0x37,
-	0xcc, 0x22, 0x3d, 0x77, 0x16, 0x25, 0x09, 0xb7, 0x22, 0xea, 0xa5, 0x21, 0xe6, 0xea, 0xcf, 0x34,
-	0xab, 0xa6, 0x4c, 0x62, 0xdf, 0xca, 0xe6, 0xee, 0x58, 0x62, 0x2f, 0xc1, 0xbc, 0x37, 0xa8, 0x2e,
-	0x7b, 0xf7, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x4d, 0x7c, 0x33, 0xc8, 0x04, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xc1, 0x4f, 0x1b, 0xc7,
+	0x17, 0xf6, 0xda, 0x8b, 0x81, 0x81, 0x18, 0x67, 0x30, 0xc8, 0x58, 0xf9, 0xd9, 0xfc, 0x2c, 0xb5,
+	0xa2, 0x69, 0xd8, 0x0d, 0xb4, 0x55, 0xd4, 0xde, 0x6c, 0xe3, 0x24, 0x08, 0xc7, 0x76, 0x16, 0x27,
+	0x54, 0xed, 0x61, 0x35, 0xde, 0x1d, 0xec, 0x11, 0xbb, 0x3b, 0x9b, 0xdd, 0x59, 0x03, 0x87, 0x9e,
+	0x2a, 0x55, 0x3d, 0xe6, 0xd8, 0x7b, 0x6f, 0xfd, 0x13, 0x7a, 0xaa, 0xd4, 0x4b, 0xd4, 0x43, 0x95,
+	0x63, 0xd5, 0x03, 0xa9, 0xe0, 0xd6, 0xbf, 0xa2, 0x9a, 0xd9, 0x59, 0x63, 0x08, 0x34, 0x34, 0x27,
+	0x76, 0xde, 0xfb, 0xbe, 0x37, 0xcf, 0xef, 0xfb, 0xde, 0x08, 0x70, 0x27, 0x40, 0x0c, 0x3b, 0xc4,
+	0x25, 0x4c, 0x1f, 0x6d, 0xe8, 0xe3, 0x83, 0xe6, 0x07, 0x94, 0x51, 0x38, 0x7f, 0x1e, 0x18, 0x6d,
+	0x94, 0xca, 0x16, 0x0d, 0x5d, 0x1a, 0xea, 0x7d, 0x14, 0x62, 0x7d, 0xb4, 0xd1, 0xc7, 0x0c, 0x6d,
+	0xe8, 0x16, 0x25, 0x5e, 0x8c, 0x2e, 0x15, 0x06, 0x74, 0x40, 0xc5, 0xa7, 0xce, 0xbf, 0x64, 0xb4,
+	0x3c, 0xa0, 0x74, 0xe0, 0x60, 0x5d, 0x9c, 0xfa, 0xd1, 0xbe, 0x6e, 0x47, 0x01, 0x62, 0x84, 0x26,
+	0xac, 0xca, 0xe5, 0x3c, 0x23, 0x2e, 0x0e, 0x19, 0x72, 0xfd, 0x18, 0x50, 0x7d, 0x02, 0xd4, 0x2e,
+	0x62, 0x43, 0x58, 0x00, 0x53, 0x36, 0xf6, 0xa8, 0x5b, 0x54, 0x56, 0x95, 0xb5, 0x59, 0x23, 0x3e,
+	0x40, 0x1d, 0x14, 0xac, 0x21, 0xf2, 0x3c, 0xec, 0x98, 0x34, 0x30, 0x2d, 0x87, 0x60, 0x8f, 0x99,
+	0xc4, 0x2e, 0xa6, 0x05, 0xe8, 0xb6, 0xcc, 0x75, 0x82, 0x86, 0xc8, 0x6c, 0xdb, 0xd5, 0x5f, 0x14,
+	0x30, 0xf5, 0x34, 0xa2, 0x0c, 0xc1, 0x47, 0x20, 0xef, 0xa2, 0x23, 0xd3, 0xc7, 0x81, 0xc5, 0x49,
+	0x21, 0xf6, 0xec, 0xb8, 0x76, 0xfd, 0x7f, 0xaf, 0x4e, 0x2a, 0xa9, 0x3f, 0x4f, 0x2a, 0x4b, 0xf1,
+	0x2f, 0x0e, 0xed, 0x03, 0x8d, 0x50, 0xdd, 0x45, 0x6c, 0xa8, 0x6d, 0x7b, 0xcc, 0xc8, 0xb9, 0xe8,
+	0xa8, 0x1b, 0xb3, 0x76, 0xb1, 0x67, 0x5f, 0x2e, 0x14, 0x60, 0x6b, 0x54, 0x4c, 0xff, 0xc7, 0x42,
+	0x06, 0xb6, 0x46, 0xf0, 0x03, 0x90, 0x4b, 0xa6, 0x63, 0x0e, 0x69, 0x14, 0x84, 0xc5, 0xcc, 0xaa,
+	0xb2, 0xa6, 0x1a, 0xb7, 0x92, 0xe8, 0x63, 0x1e, 0xac, 0xfe, 0xac, 0x00, 0xf5, 0xa1, 0x43, 0x0f,
+	0xe1, 0x67, 0x20, 0x4b, 0xbc, 0x7d, 0x87, 0x1e, 0xde, 0xac, 0x6f, 0x09, 0x86, 0x0f, 0xc0, 0x34,
+	0x8d, 0x98, 0xe0, 0xdd, 0xa8, 0xcd, 0x04, 0x0d, 0xeb, 0xe0, 0x56, 0x32, 0xec, 0x11, 0x72, 0x22,
+	0x5c, 0xcc, 0xdc, 0x84, 0x3e, 0x2f, 0x39, 0xcf, 0x39, 0xa5, 0xfa, 0x9d, 0x02, 0x66, 0x0d, 0xc4,
+	0x70, 0x8b, 0xdb, 0x0a, 0x7e, 0x08, 0x54, 0x1f, 0xb1, 0xa1, 0xe8, 0x7f, 0x6e, 0x13, 0x6a, 0x93,
+	0x86, 0xd3, 0xb8, 0xec, 0x86, 0xc8, 0xc3, 0x8f, 0xc0, 0xd4, 0x0b, 0x2e, 0x9a, 0x68, 0x78, 0x6e,
+	0x73, 0xf1, 0x22, 0x50, 0xe8, 0x69, 0xc4, 0x08, 0x5e, 0x52, 0xfc, 0xb4, 0xcc, 0x55, 0x25, 0xf9,
+	0xd8, 0x0c, 0x91, 0xaf, 0xfe, 0x9a, 0x06, 0xcb, 0x7b, 0x43, 0xc2, 0x93, 0x21, 0xc3, 0x76, 0xcd,
+	0xb6, 0x03, 0x1c, 0x86, 0x5d, 0x44, 0x02, 0xb8, 0x0c, 0xb2, 0xdc, 0x0d, 0x38, 0x90, 0x5e, 0x93,
+	0x27, 0x58, 0x02, 0x33, 0x01, 0xb6, 0x30, 0x19, 0xe1, 0x40, 0x1a, 0x6c, 0x7c, 0x86, 0x1f, 0x83,
+	0xdb, 0xf8, 0xc8, 0x27, 0x89, 0x7a, 0x98, 0x0c, 0x86, 0x4c, 0xf4, 0x90, 0x31, 0xf2, 0xe7, 0x89,
+	0xc7, 0x22, 0x0e, 0xb7, 0xc1, 0xc2, 0x04, 0x98, 0x3b, 0xbe, 0xa8, 0x8a, 0x76, 0x4b, 0x5a, 0xbc,
+	0x0e, 0x5a, 0xb2, 0x0e, 0x5a, 0x2f, 0x59, 0x87, 0xba, 0xfa, 0xf2, 0x4d, 0x45, 0x31, 0x72, 0xe7,
+	0x44, 0x9e, 0x82, 0xdf, 0x80, 0x42, 0x80, 0x5d, 0x44, 0x3c, 0xe2, 0x0d, 0x4c, 0xe4, 0xd2, 0xc8,
+	0x63, 0xa6, 0x85, 0xfc, 0xe2, 0xd4, 0x6a, 0x66, 0x6d, 0x6e, 0x73, 0x45, 0x8b, 0x35, 0xd1, 0xf8,
+	0xd2, 0x6a, 0x72, 0x69, 0xb5, 0x06, 0x25, 0x5e, 0xfd, 0x3e, 0x57, 0xed, 0xa7, 0x37, 0x95, 0xb5,
+	0x01, 0x61, 0xc3, 0xa8, 0xaf, 0x59, 0xd4, 0xd5, 0xe5, 0x86, 0xc7, 0x7f, 0xd6, 0x43, 0xfb, 0x40,
+	0x67, 0xc7, 0x3e, 0x0e, 0x05, 0x21, 0x34, 0xe0, 0xf8, 0xa2, 0x9a, 0xb8, 0xa7, 0x81, 0xfc, 0xea,
+	0xef, 0x69, 0x50, 0xea, 0x62, 0xcf, 0x26, 0xde, 0x60, 0x62, 0x98, 0xbd, 0x00, 0x79, 0xe1, 0x3e,
+	0x0e, 0xae, 0x5d, 0x4f, 0xe5, 0x9a, 0xf5, 0xe4, 0x23, 0x0e, 0xf1, 0x8b, 0x08, 0x7b, 0x16, 0x16,
+	0x23, 0x56, 0x8d, 0xf1, 0x79, 0x42, 0x96, 0xcc, 0xb5, 0xb2, 0xa8, 0x97, 0x64, 0x79, 0x00, 0xb2,
+	0xf1, 0x50, 0x8a, 0x53, 0xab, 0xca, 0xbf, 0x0f, 0x44, 0xe5, 0x03, 0x31, 0x24, 0xfc, 0x6a, 0x3d,
+	0xb3, 0x37, 0xd7, 0x73, 0xfa, 0xfd, 0xf4, 0xac, 0x32, 0xb0, 0xb4, 0xc5, 0x5f, 0xb6, 0xba, 0x83,
+	0xac, 0x03, 0x3e, 0xcd, 0x2e, 0x62, 0x0c, 0x07, 0x1e, 0xac, 0x01, 0xe0, 0x22, 0x66, 0x0d, 0x4d,
+	0x2e, 0x89, 0x18, 0x60, 0x6e, 0xb3, 0x7a, 0xd1, 0xdd, 0x97, 0x39, 0xbd, 0x63, 0x1f, 0x1b, 0xb3,
+	0x82, 0xc5, 0x3f, 0x61, 0x11, 0x4c, 0xfb, 0x71, 0x46, 0xda, 0x37, 0x39, 0x56, 0x7f, 0x53, 0x40,
+	0xae, 0x8d, 0x0f, 0xc5, 0xcd, 0x5d, 0xea, 0x10, 0xeb, 0x18, 0x7e, 0x0a, 0xb2, 0xc8, 0xe2, 0x6d,
+	0xc9, 0xbb, 0xee, 0x5c, 0xbc, 0x2b, 0x41, 0xd7, 0x04, 0xc6, 0x90, 0x58, 0xa8, 0xbf, 0x7b, 0x51,
+	0xe5, 0xa0, 0xe5, 0xba, 0x3e, 0x05, 0x4b, 0xc4, 0x23, 0x8c, 0x20, 0xc7, 0x7c, 0x8f, 0xb7, 0x65,
+	0x51, 0x72, 0x1b, 0x93, 0x4f, 0xcc, 0xb7, 0x69, 0x30, 0xcb, 0x5f, 0xca, 0xa6, 0x4f, 0xad, 0x21,
+	0xfc, 0x3f, 0x98, 0xc7, 0xfc, 0xc3, 0xf4, 0x22, 0xb7, 0x2f, 0x57, 0x5a, 0x35, 0xe6, 0x44, 0xac,
+	0x2d, 0x42, 0xf0, 0x19, 0x98, 0x49, 0x5e, 0x58, 0xd9, 0xf7, 0xca, 0x5b, 0xba, 0x6d, 0x49, 0x40,
+	0xbd, 0xcc, 0x3b, 0xfa, 0xfb, 0xa4, 0x02, 0x13, 0xca, 0x3d, 0xea, 0x12, 0x86, 0x5d, 0x9f, 0x1d,
+	0xff, 0xc0, 0x05, 0x1d, 0x97, 0x82, 0x6d, 0x90, 0x8f, 0x6f, 0x0e, 0x19, 0x0a, 0x58, 0x6c, 0x8b,
+	0xcc, 0x3b, 0x6d, 0x31, 0xc3, 0xeb, 0x4b, 0x6b, 0x70, 0xf6, 0x2e, 0x27, 0x8b, 0x55, 0xbf, 0x07,
+	0xe0, 0x64, 0x3d, 0xe9, 0x49, 0x55, 0x7a, 0x72, 0x8c, 0x8d, 0x3d, 0x79, 0xf7, 0x73, 0xb0, 0xd0,
+	0x45, 0xd6, 0x01, 0x66, 0x5b, 0x24, 0xc0, 0xb1, 0x38, 0x0b, 0x60, 0xae, 0x5b, 0x6b, 0xec, 0x34,
+	0x7b, 0xe6, 0x6e, 0xb3, 0xbd, 0x95, 0x4f, 0x4d, 0x04, 0x8c, 0x66, 0xe3, 0x79, 0x5e, 0x29, 0xa9,
+	0xdf, 0xff, 0x58, 0x4e, 0xdd, 0xdd, 0x07, 0x85, 0xab, 0xac, 0x04, 0x8b, 0xa0, 0x50, 0x6f, 0xd5,
+	0x1a, 0x3b, 0xad, 0xed, 0xdd, 0x9e, 0x59, 0xaf, 0xed, 0x36, 0xcd, 0xad, 0x66, 0xbb, 0xf3, 0x24,
+	0x9f, 0x82, 0x25, 0xb0, 0x7c, 0x9e, 0xe9, 0x19, 0xb5, 0x46, 0xd3, 0xec, 0x1a, 0xcd, 0x87, 0xdb,
+	0x5f, 0xe6, 0x15, 0x08, 0x41, 0xee, 0x3c, 0xf7, 0xa8, 0xd5, 0xa9, 0xe7, 0xd3, 0xf2, 0x9e, 0xaf,
+	0x41, 0xee, 0xa2, 0x8d, 0xe0, 0x22, 0x58, 0x68, 0x37, 0xf7, 0xe2, 0xb2, 0x66, 0xad, 0xd5, 0xea,
+	0xec, 0xe5, 0x53, 0x70, 0x05, 0x2c, 0x4d, 0x04, 0x9f, 0xf5, 0x3a, 0x66, 0xc3, 0x68, 0xd6, 0x7a,
+	0xcd, 0xbc, 0x72, 0x11, 0x5f, 0x6f, 0x75, 0x1a, 0x3b, 0x49, 0xf1, 0x7a, 0xef, 0xd5, 0x69, 0x59,
+	0x79, 0x7d, 0x5a, 0x56, 0xfe, 0x3a, 0x2d, 0x2b, 0x2f, 0xcf, 0xca, 0xa9, 0xd7, 0x67, 0xe5, 0xd4,
+	0x1f, 0x67, 0xe5, 0xd4, 0x57, 0x5f, 0xbc, 0xfd, 0xe2, 0x91, 0xbe, 0xb5, 0x8e, 0x7c, 0x3f, 0xd4,
+	0x5d, 0x6a, 0x47, 0x0e, 0x0e, 0xc5, 0xbf, 0x42, 0xeb, 0xc2, 0xb7, 0xc4, 0x1b, 0xe8, 0xa3, 0x8d,
+	0xfb, 0xf1, 0x4b, 0xd8, 0xcf, 0x0a, 0xc5, 0x3e, 0xf9, 0x67, 0x00, 0x1f, 0xae, 0xcd, 0x68, 0x39,
+	0x09, 0x00, 0x00,
 }
 
 func (m *Path) Marshal() (dAtA []byte, err error) {
@@ -669,6 +1018,35 @@ func (m *WhitelistedAddressPair) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemainingAmountCap) > 0 {
+		for iNdEx := len(m.RemainingAmountCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmountCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatelimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpirationTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintRatelimit(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -686,7 +1064,7 @@ func (m *WhitelistedAddressPair) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
+func (m *PendingWhitelistedTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -696,78 +1074,235 @@ func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HourEpoch) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingWhitelistedTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HourEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingWhitelistedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EpochStartHeight != 0 {
-		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochStartHeight))
+	if m.ExpirationTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintRatelimit(dAtA, i, uint64(n5))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x3a
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
-	if err4 != nil {
-		return 0, err4
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	i -= n4
-	i = encodeVarintRatelimit(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
 	}
-	i -= n5
-	i = encodeVarintRatelimit(dAtA, i, uint64(n5))
 	i--
-	dAtA[i] = 0x12
-	if m.EpochNumber != 0 {
-		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochNumber))
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Receiver)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRatelimit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomBlacklistPattern) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Path) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DenomBlacklistPattern) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBlacklistPattern) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovRatelimit(uint64(l))
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChannelOrClientId)
-	if l > 0 {
-		n += 1 + l + sovRatelimit(uint64(l))
+	if m.MatchType != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.MatchType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Quota) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NewDenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *NewDenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewDenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InitialChannelValue.Size()
+		i -= size
+		if _, err := m.InitialChannelValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HourEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HourEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HourEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintRatelimit(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintRatelimit(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatelimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatelimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Path) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.MaxPercentSend.Size()
 	n += 1 + l + sovRatelimit(uint64(l))
@@ -829,6 +1364,84 @@ func (m *WhitelistedAddressPair) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRatelimit(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovRatelimit(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if len(m.RemainingAmountCap) > 0 {
+		for _, e := range m.RemainingAmountCap {
+			l = e.Size()
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingWhitelistedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRatelimit(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovRatelimit(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomBlacklistPattern) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchType != 0 {
+		n += 1 + sovRatelimit(uint64(m.MatchType))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *NewDenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovRatelimit(uint64(m.Action))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.InitialChannelValue.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
 	return n
 }
 
@@ -1511,6 +2124,585 @@ func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmountCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmountCap = append(m.RemainingAmountCap, types1.Coin{})
+			if err := m.RemainingAmountCap[len(m.RemainingAmountCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWhitelistedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWhitelistedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWhitelistedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBlacklistPattern) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBlacklistPattern: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBlacklistPattern: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchType", wireType)
+			}
+			m.MatchType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchType |= BlacklistPatternType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewDenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewDenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewDenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= NewDenomAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
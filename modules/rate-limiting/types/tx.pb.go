@@ -468,6 +468,117 @@ func (m *MsgResetRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResetRateLimitResponse proto.InternalMessageInfo
 
+// Gov tx to set the policy for IBC denoms received without a rate limit
+type MsgSetNewDenomPolicy struct {
+	// Authority defines the x/gov module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Action taken the first time an IBC denom is received on a channel
+	// or client without a rate limit
+	Action NewDenomAction `protobuf:"varint,2,opt,name=action,proto3,enum=ratelimit.v1.NewDenomAction" json:"action,omitempty"`
+	// MaxPercentSend defines the threshold for outflows on auto-created rate limits
+	// The threshold is defined as a percentage (e.g. 10 indicates 10%)
+	MaxPercentSend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send"`
+	// MaxPercentRecv defines the threshold for inflows on auto-created rate limits
+	// The threshold is defined as a percentage (e.g. 10 indicates 10%)
+	MaxPercentRecv cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_recv"`
+	// DurationHours specifies the number of hours before auto-created rate
+	// limits are reset (e.g. 24 indicates that the rate limit is reset each day)
+	DurationHours uint64 `protobuf:"varint,5,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// InitialChannelValue is used as the channel value of rate limits on IBC
+	// denoms that have no supply yet
+	InitialChannelValue cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=initial_channel_value,json=initialChannelValue,proto3,customtype=cosmossdk.io/math.Int" json:"initial_channel_value"`
+}
+
+func (m *MsgSetNewDenomPolicy) Reset()         { *m = MsgSetNewDenomPolicy{} }
+func (m *MsgSetNewDenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNewDenomPolicy) ProtoMessage()    {}
+func (*MsgSetNewDenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{8}
+}
+func (m *MsgSetNewDenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNewDenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNewDenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNewDenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNewDenomPolicy.Merge(m, src)
+}
+func (m *MsgSetNewDenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNewDenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNewDenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNewDenomPolicy proto.InternalMessageInfo
+
+func (m *MsgSetNewDenomPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetNewDenomPolicy) GetAction() NewDenomAction {
+	if m != nil {
+		return m.Action
+	}
+	return NEW_DENOM_ALLOW
+}
+
+func (m *MsgSetNewDenomPolicy) GetDurationHours() uint64 {
+	if m != nil {
+		return m.DurationHours
+	}
+	return 0
+}
+
+type MsgSetNewDenomPolicyResponse struct {
+}
+
+func (m *MsgSetNewDenomPolicyResponse) Reset()         { *m = MsgSetNewDenomPolicyResponse{} }
+func (m *MsgSetNewDenomPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNewDenomPolicyResponse) ProtoMessage()    {}
+func (*MsgSetNewDenomPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_415b1435b4efaad0, []int{9}
+}
+func (m *MsgSetNewDenomPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNewDenomPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNewDenomPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNewDenomPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNewDenomPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetNewDenomPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNewDenomPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNewDenomPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetNewDenomPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddRateLimit)(nil), "ratelimit.v1.MsgAddRateLimit")
 	proto.RegisterType((*MsgAddRateLimitResponse)(nil), "ratelimit.v1.MsgAddRateLimitResponse")
@@ -477,50 +588,60 @@ func init() {
 	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "ratelimit.v1.MsgRemoveRateLimitResponse")
 	proto.RegisterType((*MsgResetRateLimit)(nil), "ratelimit.v1.MsgResetRateLimit")
 	proto.RegisterType((*MsgResetRateLimitResponse)(nil), "ratelimit.v1.MsgResetRateLimitResponse")
+	proto.RegisterType((*MsgSetNewDenomPolicy)(nil), "ratelimit.v1.MsgSetNewDenomPolicy")
+	proto.RegisterType((*MsgSetNewDenomPolicyResponse)(nil), "ratelimit.v1.MsgSetNewDenomPolicyResponse")
 }
 
 func init() { proto.RegisterFile("ratelimit/v1/tx.proto", fileDescriptor_415b1435b4efaad0) }
 
 var fileDescriptor_415b1435b4efaad0 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xce, 0xa6, 0x2f, 0xd0, 0xa1, 0xff, 0xf6, 0xdf, 0x25, 0xa5, 0x9b, 0x6d, 0xbb, 0x2d, 0x81,
-	0x62, 0x29, 0x66, 0xc7, 0x2a, 0x78, 0xe8, 0xad, 0xf5, 0xa0, 0x05, 0x83, 0xb2, 0xad, 0x97, 0x82,
-	0x2c, 0x9b, 0x9d, 0x61, 0x33, 0x98, 0x99, 0x59, 0x76, 0x26, 0x21, 0xbd, 0x89, 0x47, 0x4f, 0x7e,
-	0x94, 0x1c, 0xfc, 0x04, 0xe2, 0xa1, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x49, 0x0e, 0xf9, 0x08, 0x5e,
-	0x14, 0x64, 0x5f, 0xf2, 0xd2, 0xd9, 0x6a, 0x10, 0x2f, 0x3d, 0x78, 0x09, 0x99, 0xe7, 0xf7, 0xfc,
-	0x9e, 0xf9, 0x3d, 0xf3, 0xec, 0xee, 0x80, 0xd5, 0xc8, 0x93, 0xb8, 0x49, 0x28, 0x91, 0xb0, 0xbd,
-	0x0f, 0x65, 0xc7, 0x0e, 0x23, 0x2e, 0xb9, 0xbe, 0x38, 0x82, 0xed, 0xf6, 0xbe, 0xb9, 0xe2, 0x51,
-	0xc2, 0x38, 0x4c, 0x7e, 0x53, 0x82, 0xb9, 0xe6, 0x73, 0x41, 0xb9, 0x80, 0x54, 0x04, 0x71, 0x23,
-	0x15, 0x41, 0x56, 0x28, 0xa7, 0x05, 0x37, 0x59, 0xc1, 0x74, 0x91, 0x95, 0x4a, 0x01, 0x0f, 0x78,
-	0x8a, 0xc7, 0xff, 0x52, 0xb4, 0xf2, 0xad, 0x08, 0x96, 0x6b, 0x22, 0x38, 0x44, 0xc8, 0xf1, 0x24,
-	0x7e, 0x1a, 0xef, 0xa9, 0x3f, 0x04, 0x0b, 0x5e, 0x4b, 0x36, 0x78, 0x44, 0xe4, 0xb9, 0xa1, 0x6d,
-	0x6b, 0xbb, 0x0b, 0x47, 0xc6, 0xa7, 0xf7, 0xd5, 0x52, 0x26, 0x77, 0x88, 0x50, 0x84, 0x85, 0x38,
-	0x91, 0x11, 0x61, 0x81, 0x33, 0xa6, 0xea, 0x25, 0x30, 0x87, 0x30, 0xe3, 0xd4, 0x28, 0xc6, 0x3d,
-	0x4e, 0xba, 0xd0, 0x21, 0x28, 0xf9, 0x0d, 0x8f, 0x31, 0xdc, 0x74, 0x79, 0xe4, 0xfa, 0x4d, 0x82,
-	0x99, 0x74, 0x09, 0x32, 0x66, 0x12, 0xd2, 0x4a, 0x56, 0x7b, 0x16, 0x3d, 0x4a, 0x2a, 0xc7, 0x48,
-	0x7f, 0x0c, 0xfe, 0xa7, 0x5e, 0xc7, 0x0d, 0x71, 0xe4, 0xc7, 0x54, 0x81, 0x19, 0x32, 0x66, 0x93,
-	0x29, 0x36, 0x2f, 0xae, 0xb6, 0x0a, 0x5f, 0xae, 0xb6, 0x56, 0xd3, 0x49, 0x04, 0x7a, 0x65, 0x13,
-	0x0e, 0xa9, 0x27, 0x1b, 0xf6, 0x31, 0x93, 0xce, 0x12, 0xf5, 0x3a, 0xcf, 0xd3, 0xae, 0x13, 0xcc,
-	0x72, 0x42, 0x11, 0xf6, 0xdb, 0xc6, 0xdc, 0x1f, 0x0a, 0x39, 0xd8, 0x6f, 0xeb, 0x3b, 0x60, 0x09,
-	0xb5, 0x22, 0x4f, 0x12, 0xce, 0xdc, 0x06, 0x6f, 0x45, 0xc2, 0x98, 0xdf, 0xd6, 0x76, 0x67, 0x9d,
-	0xff, 0x86, 0xe8, 0x93, 0x18, 0x3c, 0xb8, 0xfb, 0x66, 0xd0, 0xdd, 0x1b, 0x9f, 0xc7, 0xdb, 0x41,
-	0x77, 0xaf, 0x3c, 0x0e, 0x58, 0x39, 0xe5, 0x4a, 0x19, 0xac, 0x29, 0x90, 0x83, 0x45, 0xc8, 0x99,
-	0xc0, 0x95, 0x1f, 0x45, 0xa0, 0xd7, 0x44, 0xf0, 0x22, 0x44, 0x9e, 0xc4, 0xff, 0x72, 0xf9, 0xdb,
-	0x5c, 0x60, 0x3e, 0x97, 0x8d, 0x6b, 0xb9, 0x28, 0x07, 0x5d, 0xd9, 0x00, 0x66, 0x1e, 0x1d, 0xa5,
-	0xf3, 0x51, 0x4b, 0xd2, 0x71, 0x30, 0xe5, 0xed, 0x5b, 0x93, 0xce, 0x74, 0x93, 0xca, 0xbc, 0x99,
-	0x49, 0x05, 0x1d, 0x99, 0xfc, 0xa0, 0x81, 0x95, 0xa4, 0x2c, 0xb0, 0xbc, 0x35, 0x1e, 0xed, 0xbc,
-	0xc7, 0x75, 0xc5, 0xe3, 0xe4, 0xb8, 0x95, 0x75, 0x50, 0xce, 0x81, 0x43, 0x87, 0xf7, 0xbf, 0x17,
-	0xc1, 0x4c, 0x4d, 0x04, 0xfa, 0x29, 0x58, 0xbc, 0xf6, 0xf5, 0xdb, 0xb4, 0x27, 0xbf, 0xbe, 0xb6,
-	0xf2, 0x8e, 0x9a, 0x3b, 0xbf, 0x2d, 0x0f, 0xd5, 0xf5, 0x97, 0x60, 0x59, 0x7d, 0x7d, 0xb7, 0x73,
-	0x9d, 0x0a, 0xc3, 0xdc, 0x9d, 0xc6, 0x98, 0x94, 0x57, 0x9f, 0xbf, 0xbc, 0xbc, 0xc2, 0xb8, 0x41,
-	0xfe, 0x17, 0xe9, 0xeb, 0x67, 0x60, 0x49, 0x49, 0x7e, 0xeb, 0x86, 0xde, 0x49, 0x82, 0x79, 0x67,
-	0x0a, 0x61, 0xa8, 0x6d, 0xce, 0xbd, 0x1e, 0x74, 0xf7, 0xb4, 0xa3, 0xd3, 0x8b, 0x9e, 0xa5, 0x5d,
-	0xf6, 0x2c, 0xed, 0x6b, 0xcf, 0xd2, 0xde, 0xf5, 0xad, 0xc2, 0x65, 0xdf, 0x2a, 0x7c, 0xee, 0x5b,
-	0x85, 0xb3, 0x83, 0x80, 0xc8, 0x46, 0xab, 0x6e, 0xfb, 0x9c, 0x66, 0x37, 0x18, 0x24, 0x75, 0xbf,
-	0xea, 0x85, 0xa1, 0x80, 0x94, 0xa3, 0x56, 0x13, 0x0b, 0x18, 0xef, 0x55, 0x4d, 0x36, 0x23, 0x2c,
-	0xbe, 0x02, 0xef, 0x41, 0x79, 0x1e, 0x62, 0x51, 0x9f, 0x4f, 0x6e, 0xb5, 0x07, 0x3f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xbd, 0x94, 0x2f, 0x09, 0x59, 0x07, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x09, 0x89, 0xc4, 0x88, 0x1b, 0x6e, 0x7c, 0x83, 0x70, 0x4c, 0x30, 0xc8, 0x12, 0xba,
+	0x51, 0x74, 0xb1, 0x2f, 0xb4, 0xea, 0x82, 0x1d, 0x50, 0xa9, 0x45, 0x6a, 0x5a, 0x6a, 0x68, 0x17,
+	0x48, 0x95, 0x35, 0xd8, 0x23, 0x67, 0x54, 0xdb, 0x63, 0x79, 0x26, 0x29, 0xec, 0xaa, 0x2e, 0xbb,
+	0xea, 0xa3, 0xb0, 0xe8, 0x13, 0xb4, 0x5d, 0xb0, 0x44, 0x5d, 0x55, 0x5d, 0xa0, 0x16, 0x16, 0x3c,
+	0x42, 0x57, 0x95, 0x2a, 0x8f, 0x9d, 0x3f, 0x3b, 0x6a, 0xfa, 0xc3, 0x82, 0x45, 0x37, 0x51, 0xe6,
+	0x3b, 0xdf, 0xf9, 0x9b, 0x2f, 0x67, 0x72, 0xc0, 0x6c, 0x08, 0x19, 0x72, 0xb1, 0x87, 0x99, 0xde,
+	0x59, 0xd5, 0xd9, 0xa1, 0x16, 0x84, 0x84, 0x11, 0x71, 0xba, 0x07, 0x6b, 0x9d, 0x55, 0xb9, 0x0c,
+	0x3d, 0xec, 0x13, 0x9d, 0x7f, 0xc6, 0x04, 0x79, 0xce, 0x22, 0xd4, 0x23, 0x54, 0xf7, 0xa8, 0x13,
+	0x39, 0x7a, 0xd4, 0x49, 0x0c, 0xd5, 0xd8, 0x60, 0xf2, 0x93, 0x1e, 0x1f, 0x12, 0x53, 0xc5, 0x21,
+	0x0e, 0x89, 0xf1, 0xe8, 0x5b, 0x82, 0xd6, 0x86, 0x2a, 0xe8, 0xe7, 0xe5, 0x56, 0xf5, 0xcb, 0x04,
+	0x98, 0x69, 0x52, 0x67, 0xc3, 0xb6, 0x0d, 0xc8, 0xd0, 0xbd, 0xc8, 0x22, 0xde, 0x02, 0x53, 0xb0,
+	0xcd, 0x5a, 0x24, 0xc4, 0xec, 0x48, 0x12, 0x96, 0x84, 0xfa, 0xd4, 0xa6, 0xf4, 0xfe, 0xf5, 0x4a,
+	0x25, 0x49, 0xb6, 0x61, 0xdb, 0x21, 0xa2, 0x74, 0x97, 0x85, 0xd8, 0x77, 0x8c, 0x3e, 0x55, 0xac,
+	0x80, 0x82, 0x8d, 0x7c, 0xe2, 0x49, 0x13, 0x91, 0x8f, 0x11, 0x1f, 0x44, 0x1d, 0x54, 0xac, 0x16,
+	0xf4, 0x7d, 0xe4, 0x9a, 0x24, 0x34, 0x2d, 0x17, 0x23, 0x9f, 0x99, 0xd8, 0x96, 0xf2, 0x9c, 0x54,
+	0x4e, 0x6c, 0x0f, 0xc2, 0x2d, 0x6e, 0xd9, 0xb6, 0xc5, 0x3b, 0xe0, 0x6f, 0x0f, 0x1e, 0x9a, 0x01,
+	0x0a, 0xad, 0x88, 0x4a, 0x91, 0x6f, 0x4b, 0x93, 0xbc, 0x8a, 0x85, 0x93, 0xb3, 0xc5, 0xdc, 0xc7,
+	0xb3, 0xc5, 0xd9, 0xb8, 0x12, 0x6a, 0x3f, 0xd5, 0x30, 0xd1, 0x3d, 0xc8, 0x5a, 0xda, 0xb6, 0xcf,
+	0x8c, 0x92, 0x07, 0x0f, 0x77, 0x62, 0xaf, 0x5d, 0xe4, 0x67, 0x02, 0x85, 0xc8, 0xea, 0x48, 0x85,
+	0x9f, 0x0c, 0x64, 0x20, 0xab, 0x23, 0x2e, 0x83, 0x92, 0xdd, 0x0e, 0x21, 0xc3, 0xc4, 0x37, 0x5b,
+	0xa4, 0x1d, 0x52, 0xa9, 0xb8, 0x24, 0xd4, 0x27, 0x8d, 0xbf, 0xba, 0xe8, 0xdd, 0x08, 0x5c, 0xff,
+	0xef, 0xc5, 0xe5, 0x71, 0xa3, 0x7f, 0x1f, 0x2f, 0x2f, 0x8f, 0x1b, 0xd5, 0xfe, 0xe5, 0xa7, 0x6e,
+	0x59, 0xad, 0x82, 0xb9, 0x14, 0x64, 0x20, 0x1a, 0x10, 0x9f, 0x22, 0xf5, 0xeb, 0x04, 0x10, 0x9b,
+	0xd4, 0x79, 0x14, 0xd8, 0x90, 0xa1, 0x3f, 0xba, 0xfc, 0xae, 0x2e, 0x7a, 0x56, 0x97, 0xda, 0x90,
+	0x2e, 0xa9, 0x8b, 0x56, 0x6b, 0x40, 0xce, 0xa2, 0x3d, 0x75, 0xde, 0x09, 0x5c, 0x1d, 0x03, 0x79,
+	0xa4, 0x73, 0x6d, 0xd4, 0x19, 0xdf, 0x64, 0xaa, 0xde, 0xa4, 0xc9, 0x14, 0xda, 0x6b, 0xf2, 0x8d,
+	0x00, 0xca, 0xdc, 0x4c, 0x11, 0xbb, 0x36, 0x3d, 0x6a, 0xd9, 0x1e, 0xe7, 0x53, 0x3d, 0x0e, 0x96,
+	0xab, 0xce, 0x83, 0x6a, 0x06, 0xec, 0x75, 0xf8, 0x36, 0x0f, 0x2a, 0x4d, 0xea, 0xec, 0x22, 0x76,
+	0x1f, 0x3d, 0xbb, 0x1d, 0x15, 0xb4, 0x43, 0x5c, 0x6c, 0x1d, 0xfd, 0x72, 0x93, 0x37, 0x41, 0x11,
+	0x5a, 0xd1, 0xaf, 0x8e, 0x77, 0x59, 0x5a, 0xab, 0x69, 0x83, 0x8f, 0xbc, 0xd6, 0xcd, 0xb2, 0xc1,
+	0x39, 0x46, 0xc2, 0x1d, 0x39, 0x55, 0xf9, 0xab, 0x9a, 0xaa, 0xc9, 0xab, 0x99, 0xaa, 0xc2, 0x88,
+	0xa9, 0x12, 0x1f, 0x82, 0x59, 0xec, 0x63, 0x86, 0xa1, 0x6b, 0x76, 0x55, 0xec, 0x40, 0xb7, 0x8d,
+	0xa4, 0xe2, 0x8f, 0x24, 0xfd, 0x27, 0xf1, 0xdd, 0x8a, 0x5d, 0x1f, 0x47, 0x9e, 0xeb, 0xab, 0x59,
+	0x7d, 0x95, 0x21, 0x7d, 0x33, 0x62, 0xa9, 0x0a, 0xa8, 0x8d, 0xc2, 0xbb, 0x2a, 0xaf, 0x7d, 0xce,
+	0x83, 0x7c, 0x93, 0x3a, 0xe2, 0x1e, 0x98, 0x1e, 0xfa, 0x8f, 0x5b, 0x18, 0x16, 0x27, 0xf5, 0x12,
+	0xcb, 0xcb, 0xdf, 0x35, 0x77, 0xa3, 0x8b, 0x4f, 0xc0, 0x4c, 0xfa, 0x91, 0x5e, 0xca, 0x78, 0xa6,
+	0x18, 0x72, 0x7d, 0x1c, 0x63, 0x30, 0x7c, 0xfa, 0x95, 0xc9, 0x86, 0x4f, 0x31, 0xe4, 0xfa, 0x38,
+	0x46, 0x2f, 0xfc, 0x3e, 0x28, 0xa5, 0xe6, 0x7b, 0x71, 0x84, 0xef, 0x20, 0x41, 0xfe, 0x77, 0x0c,
+	0xa1, 0x17, 0xdb, 0x02, 0xe5, 0xec, 0x64, 0xa9, 0x19, 0xef, 0x0c, 0x47, 0x6e, 0x8c, 0xe7, 0x74,
+	0x93, 0xc8, 0x85, 0xe7, 0x97, 0xc7, 0x0d, 0x61, 0x73, 0xef, 0xe4, 0x5c, 0x11, 0x4e, 0xcf, 0x15,
+	0xe1, 0xd3, 0xb9, 0x22, 0xbc, 0xba, 0x50, 0x72, 0xa7, 0x17, 0x4a, 0xee, 0xc3, 0x85, 0x92, 0xdb,
+	0x5f, 0x77, 0x30, 0x6b, 0xb5, 0x0f, 0x34, 0x8b, 0x78, 0xc9, 0xaa, 0xa4, 0xe3, 0x03, 0x6b, 0x05,
+	0x06, 0x01, 0xd5, 0x3d, 0x62, 0xb7, 0x5d, 0x44, 0xf9, 0x46, 0xb4, 0xc2, 0xf3, 0x61, 0x3f, 0xda,
+	0xb5, 0xfe, 0xd7, 0xd9, 0x51, 0x80, 0xe8, 0x41, 0x91, 0x2f, 0x48, 0x37, 0xbe, 0x0d, 0x00, 0xa6,
+	0x73, 0x02, 0x91, 0xc2, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -543,6 +664,8 @@ type MsgClient interface {
 	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
 	// Gov tx to reset the flow on a rate limit
 	ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, opts ...grpc.CallOption) (*MsgResetRateLimitResponse, error)
+	// Gov tx to set the policy for IBC denoms received without a rate limit
+	SetNewDenomPolicy(ctx context.Context, in *MsgSetNewDenomPolicy, opts ...grpc.CallOption) (*MsgSetNewDenomPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -589,6 +712,15 @@ func (c *msgClient) ResetRateLimit(ctx context.Context, in *MsgResetRateLimit, o
 	return out, nil
 }
 
+func (c *msgClient) SetNewDenomPolicy(ctx context.Context, in *MsgSetNewDenomPolicy, opts ...grpc.CallOption) (*MsgSetNewDenomPolicyResponse, error) {
+	out := new(MsgSetNewDenomPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ratelimit.v1.Msg/SetNewDenomPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Gov tx to add a new rate limit
@@ -599,6 +731,8 @@ type MsgServer interface {
 	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
 	// Gov tx to reset the flow on a rate limit
 	ResetRateLimit(context.Context, *MsgResetRateLimit) (*MsgResetRateLimitResponse, error)
+	// Gov tx to set the policy for IBC denoms received without a rate limit
+	SetNewDenomPolicy(context.Context, *MsgSetNewDenomPolicy) (*MsgSetNewDenomPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -617,6 +751,9 @@ func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemo
 func (*UnimplementedMsgServer) ResetRateLimit(ctx context.Context, req *MsgResetRateLimit) (*MsgResetRateLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
 }
+func (*UnimplementedMsgServer) SetNewDenomPolicy(ctx context.Context, req *MsgSetNewDenomPolicy) (*MsgSetNewDenomPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNewDenomPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -694,6 +831,25 @@ func _Msg_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetNewDenomPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNewDenomPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNewDenomPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ratelimit.v1.Msg/SetNewDenomPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNewDenomPolicy(ctx, req.(*MsgSetNewDenomPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ratelimit.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -714,6 +870,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetRateLimit",
 			Handler:    _Msg_ResetRateLimit_Handler,
 		},
+		{
+			MethodName: "SetNewDenomPolicy",
+			Handler:    _Msg_SetNewDenomPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ratelimit/v1/tx.proto",
@@ -1037,6 +1197,99 @@ func (m *MsgResetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetNewDenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNewDenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNewDenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InitialChannelValue.Size()
+		i -= size
+		if _, err := m.InitialChannelValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DurationHours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNewDenomPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNewDenomPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNewDenomPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1182,6 +1435,40 @@ func (m *MsgResetRateLimitResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetNewDenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DurationHours != 0 {
+		n += 1 + sovTx(uint64(m.DurationHours))
+	}
+	l = m.InitialChannelValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetNewDenomPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2146,6 +2433,278 @@ func (m *MsgResetRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetNewDenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNewDenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNewDenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= NewDenomAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNewDenomPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNewDenomPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNewDenomPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Checks whether the whitelisted pair has passed either its expiration height or time
+func (w WhitelistedAddressPair) IsExpired(blockTime time.Time, blockHeight int64) bool {
+	if w.ExpirationHeight != 0 && blockHeight >= w.ExpirationHeight {
+		return true
+	}
+	if w.ExpirationTime != nil && !blockTime.Before(*w.ExpirationTime) {
+		return true
+	}
+	return false
+}
+
+// Validates the optional expiration and amount cap on a whitelisted pair
+func (w WhitelistedAddressPair) Validate() error {
+	if w.Sender == "" || w.Receiver == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "whitelisted address pair must have a sender and receiver")
+	}
+	if w.ExpirationHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "whitelist expiration height (%d) cannot be negative", w.ExpirationHeight)
+	}
+	if len(w.RemainingAmountCap) > 0 && !w.RemainingAmountCap.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "whitelist amount cap (%v) must be sorted and strictly positive", w.RemainingAmountCap)
+	}
+	return nil
+}
+
+// Validates a pending whitelisted transfer from genesis
+func (p PendingWhitelistedTransfer) Validate() error {
+	if p.ChannelOrClientId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pending whitelisted transfer must have a channel or client id")
+	}
+	if p.Sender == "" || p.Receiver == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pending whitelisted transfer must have a sender and receiver")
+	}
+	if !p.Amount.IsValid() || !p.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pending whitelisted transfer amount (%v) must be positive", p.Amount)
+	}
+	return nil
+}